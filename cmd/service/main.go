@@ -317,10 +317,15 @@ func initServices(ctx context.Context, cfg *config.Config) (*services, error) {
 	snapshotService := snapshot.NewService(&snapshot.Config{
 		Host: cfg.SnapshotHost,
 	})
-	orbiterService, err := orbiter.NewService()
+	orbiterService, err := orbiter.NewService(&orbiter.Config{
+		ChainSource:    cfg.OrbiterChainSource,
+		MakerSource:    cfg.OrbiterMakerSource,
+		ReloadInterval: cfg.OrbiterReloadInterval,
+	})
 	if err != nil {
 		return nil, err
 	}
+	go orbiterService.Run(ctx)
 
 	starknetNewClient, err := starknet.NewClient(&starknet.ClientConfig{
 		HttpCli:     &http.Client{},
@@ -346,7 +351,7 @@ func initServices(ctx context.Context, cfg *config.Config) (*services, error) {
 		helperService:        v1.NewHelperService(settingsService, profileRepository, userRepository, payService, statRepository, processRepository, tgBot),
 		withdrawerService:    v1.NewWithdrawerService(withdrawerRepository, userRepository, profileRepository, starknetNewClient),
 		flowService:          v1.NewFlowService(flowRepository),
-		processService:       v1.NewProcessService(processRepository, dispatcher, flowRepository, settingsService),
+		processService:       v1.NewProcessService(processRepository, dispatcher, flowRepository, profileRepository, settingsService),
 		settingsService:      v1.NewSettingsService(settingsService),
 		swap1inchService:     v1.NewSwap1inchService(),
 		processRepository:    processRepository,
@@ -0,0 +1,385 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/aave/addressesprovider"
+	"github.com/hardstylez72/cry/internal/defi/contracts/aave/oracle"
+	"github.com/hardstylez72/cry/internal/defi/contracts/aave/pool"
+	"github.com/hardstylez72/cry/internal/defi/contracts/aave/wethgateway"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// Aave v3 https://docs.aave.com/developers/deployed-contracts/v3-mainnet
+type Aave struct {
+	Pool        common.Address
+	WETHGateway common.Address
+}
+
+// aaveSafeHealthFactor health factor (bps) the position has to keep after withdrawal
+var aaveSafeHealthFactor = big.NewInt(15000)
+
+func (c *EtheriumClient) Lend(ctx context.Context, req *LendReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
+	if req.Protocol != v1.LendProtocol_Aave {
+		return nil, errors.New("unsupported lend protocol: " + req.Protocol.String())
+	}
+
+	switch taskType {
+	case v1.TaskType_LendSupply:
+		return c.AaveSupply(ctx, req)
+	case v1.TaskType_LendWithdraw:
+		return c.AaveWithdraw(ctx, req)
+	default:
+		return nil, errors.New("unsupported task type: " + taskType.String())
+	}
+}
+
+func (c *EtheriumClient) LendWithdrawable(ctx context.Context, req *LendWithdrawableReq) (*big.Int, error) {
+	if req.Protocol != v1.LendProtocol_Aave {
+		return nil, errors.New("unsupported lend protocol: " + req.Protocol.String())
+	}
+
+	aave, err := c.aave()
+	if err != nil {
+		return nil, err
+	}
+
+	asset, err := c.aaveAsset(ctx, aave, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.aaveWithdrawable(ctx, aave, asset, req.WalletAddress)
+}
+
+func (c *EtheriumClient) AaveSupply(ctx context.Context, req *LendReq) (*bozdo.DefaultRes, error) {
+
+	result := &bozdo.DefaultRes{}
+
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	aave, err := c.aave()
+	if err != nil {
+		return nil, err
+	}
+
+	call := &contractCall{To: aave.Pool, Value: big.NewInt(0)}
+
+	if req.Token == c.Cfg.MainToken {
+		gatewayAbi, err := wethgateway.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		call.To = aave.WETHGateway
+		call.Value = req.Amount
+		call.Data, err = gatewayAbi.Pack("depositETH", aave.Pool, tr.WalletAddr, uint16(0))
+		if err != nil {
+			return nil, errors.Wrap(err, "gatewayAbi.Pack")
+		}
+	} else {
+		asset, ok := c.Cfg.TokenMap[req.Token]
+		if !ok {
+			return nil, ErrTokenNotSupportedFn(req.Token)
+		}
+
+		limitTx, err := c.TokenLimitChecker(ctx, &TokenLimitCheckerReq{
+			Token:       req.Token,
+			Wallet:      tr,
+			Amount:      req.Amount,
+			SpenderAddr: aave.Pool,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "TokenLimitChecker")
+		}
+		if limitTx.LimitExtended {
+			result.ApproveTx = c.NewTx(limitTx.ApproveTx.Hash(), CodeApprove, nil)
+		}
+
+		poolAbi, err := pool.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		call.Data, err = poolAbi.Pack("supply", asset, req.Amount, tr.WalletAddr, uint16(0))
+		if err != nil {
+			return nil, errors.Wrap(err, "poolAbi.Pack")
+		}
+	}
+
+	ecost, tx, err := c.sendContractCall(ctx, tr, call, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = "aave supply"
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeLend, nil)
+
+	return result, nil
+}
+
+func (c *EtheriumClient) AaveWithdraw(ctx context.Context, req *LendReq) (*bozdo.DefaultRes, error) {
+
+	result := &bozdo.DefaultRes{}
+
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	aave, err := c.aave()
+	if err != nil {
+		return nil, err
+	}
+
+	asset, err := c.aaveAsset(ctx, aave, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawable, err := c.aaveWithdrawable(ctx, aave, asset, tr.WalletAddr)
+	if err != nil {
+		return nil, err
+	}
+	if req.Amount.Cmp(withdrawable) > 0 {
+		return nil, errors.New("amount exceeds health factor safe withdrawal of " + withdrawable.String())
+	}
+
+	call := &contractCall{To: aave.Pool, Value: big.NewInt(0)}
+
+	if req.Token == c.Cfg.MainToken {
+		reserve, err := c.aaveReserve(ctx, aave, asset)
+		if err != nil {
+			return nil, err
+		}
+
+		// gateway burns aWETH on behalf of the wallet
+		approveTx, err := c.approveTokenAddress(ctx, tr, reserve.ATokenAddress, aave.WETHGateway, req.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if approveTx != nil {
+			result.ApproveTx = c.NewTx(approveTx.Hash(), CodeApprove, nil)
+		}
+
+		gatewayAbi, err := wethgateway.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		call.To = aave.WETHGateway
+		call.Data, err = gatewayAbi.Pack("withdrawETH", aave.Pool, req.Amount, tr.WalletAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "gatewayAbi.Pack")
+		}
+	} else {
+		poolAbi, err := pool.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		call.Data, err = poolAbi.Pack("withdraw", asset, req.Amount, tr.WalletAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "poolAbi.Pack")
+		}
+	}
+
+	ecost, tx, err := c.sendContractCall(ctx, tr, call, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = "aave withdraw"
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeLend, nil)
+
+	return result, nil
+}
+
+func (c *EtheriumClient) aave() (*Aave, error) {
+	if c.Cfg.Dict == nil || c.Cfg.Dict.Aave.Pool == (common.Address{}) {
+		return nil, errors.New("aave is not supported in network: " + c.Cfg.Network.String())
+	}
+	return &c.Cfg.Dict.Aave, nil
+}
+
+// aaveAsset native token is lent as its wrapped version
+func (c *EtheriumClient) aaveAsset(ctx context.Context, aave *Aave, token v1.Token) (common.Address, error) {
+	if token == c.Cfg.MainToken {
+		gateway, err := wethgateway.NewStorageCaller(aave.WETHGateway, c.Cli)
+		if err != nil {
+			return common.Address{}, errors.Wrap(err, "wethgateway.NewStorageCaller")
+		}
+		addr, err := gateway.GetWETHAddress(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return common.Address{}, errors.Wrap(err, "gateway.GetWETHAddress")
+		}
+		return addr, nil
+	}
+
+	addr, ok := c.Cfg.TokenMap[token]
+	if !ok {
+		return common.Address{}, ErrTokenNotSupportedFn(token)
+	}
+	return addr, nil
+}
+
+func (c *EtheriumClient) aaveReserve(ctx context.Context, aave *Aave, asset common.Address) (*pool.DataTypesReserveData, error) {
+	caller, err := pool.NewStorageCaller(aave.Pool, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "pool.NewStorageCaller")
+	}
+	reserve, err := caller.GetReserveData(&bind.CallOpts{Context: ctx}, asset)
+	if err != nil {
+		return nil, errors.Wrap(err, "pool.GetReserveData")
+	}
+	return &reserve, nil
+}
+
+// aaveWithdrawable supplied balance of the asset limited by the amount that keeps health factor above aaveSafeHealthFactor
+func (c *EtheriumClient) aaveWithdrawable(ctx context.Context, aave *Aave, asset, user common.Address) (*big.Int, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	reserve, err := c.aaveReserve(ctx, aave, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	aToken, err := erc_20.NewStorageCaller(reserve.ATokenAddress, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.NewStorageCaller")
+	}
+	supplied, err := aToken.BalanceOf(opt, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "aToken.BalanceOf")
+	}
+
+	poolCaller, err := pool.NewStorageCaller(aave.Pool, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "pool.NewStorageCaller")
+	}
+	account, err := poolCaller.GetUserAccountData(opt, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "pool.GetUserAccountData")
+	}
+
+	if account.TotalDebtBase.Sign() == 0 || account.CurrentLiquidationThreshold.Sign() == 0 {
+		return supplied, nil
+	}
+
+	// collateral (in base currency) that has to stay to keep health factor safe
+	locked := new(big.Int).Mul(account.TotalDebtBase, aaveSafeHealthFactor)
+	locked.Div(locked, account.CurrentLiquidationThreshold)
+	if account.TotalCollateralBase.Cmp(locked) <= 0 {
+		return big.NewInt(0), nil
+	}
+	free := new(big.Int).Sub(account.TotalCollateralBase, locked)
+
+	price, err := c.aaveAssetPrice(ctx, poolCaller, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := erc_20.NewStorageCaller(asset, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.NewStorageCaller")
+	}
+	decimals, err := token.Decimals(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "token.Decimals")
+	}
+
+	free.Mul(free, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	free.Div(free, price)
+
+	if free.Cmp(supplied) < 0 {
+		return free, nil
+	}
+	return supplied, nil
+}
+
+func (c *EtheriumClient) aaveAssetPrice(ctx context.Context, poolCaller *pool.StorageCaller, asset common.Address) (*big.Int, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	providerAddr, err := poolCaller.ADDRESSESPROVIDER(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "pool.ADDRESSES_PROVIDER")
+	}
+	provider, err := addressesprovider.NewStorageCaller(providerAddr, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "addressesprovider.NewStorageCaller")
+	}
+	oracleAddr, err := provider.GetPriceOracle(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "provider.GetPriceOracle")
+	}
+	priceOracle, err := oracle.NewStorageCaller(oracleAddr, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "oracle.NewStorageCaller")
+	}
+	price, err := priceOracle.GetAssetPrice(opt, asset)
+	if err != nil {
+		return nil, errors.Wrap(err, "oracle.GetAssetPrice")
+	}
+	if price.Sign() == 0 {
+		return nil, errors.New("zero asset price")
+	}
+	return price, nil
+}
+
+// approveTokenAddress approves erc20 which is not in the token map, returns nil if the allowance is enough
+func (c *EtheriumClient) approveTokenAddress(ctx context.Context, tr *WalletTransactor, token, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	caller, err := erc_20.NewStorageCaller(token, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.NewStorageCaller")
+	}
+	allowance, err := caller.Allowance(&bind.CallOpts{Context: ctx}, tr.WalletAddr, spender)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.Allowance")
+	}
+	if allowance.Cmp(amount) >= 0 {
+		return nil, nil
+	}
+
+	transactor, err := erc_20.NewStorageTransactor(token, c.backend())
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.NewStorageTransactor")
+	}
+	opt, err := tr.TransactOpts(ctx, c.Cfg.networkId)
+	if err != nil {
+		return nil, errors.Wrap(err, "TransactOpts")
+	}
+	opt.Context = ctx
+
+	reserved, err := c.prepareSend(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	approve := math.MaxBig256
+	if ExactApprove(ctx) {
+		approve = amount
+	}
+	tx, err := transactor.Approve(opt, spender, approve)
+	reserved.Done(err)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.Approve")
+	}
+	return tx, nil
+}
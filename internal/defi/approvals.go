@@ -0,0 +1,297 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/bridge/across"
+	"github.com/hardstylez72/cry/internal/defi/bridge/hop"
+	"github.com/hardstylez72/cry/internal/defi/bridge/synapse"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// unlimitedAllowance allowance more than any balance could be, the max approvals of the dapps spent a bit are above it
+var unlimitedAllowance = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// Spender contract the tasks approve the tokens to
+type Spender struct {
+	Name    string
+	Address common.Address
+}
+
+type ApprovalsReq struct {
+	Wallet common.Address
+	// Tokens all the erc20 tokens of the network if empty
+	Tokens []Token
+	// Spenders the known spenders of the network and the registered ones if empty
+	Spenders []Spender
+	// Registered spenders the tasks of the profile registered, see RegisterSpender
+	Registered []Spender
+	// UnlimitedOnly the limited allowances are left out
+	UnlimitedOnly bool
+}
+
+type Approval struct {
+	Token     Token
+	Spender   Spender
+	Allowance *big.Int
+	Unlimited bool
+}
+
+type ApprovalsRes struct {
+	Approvals []*Approval
+}
+
+type RevokeApprovalReq struct {
+	Token        Token
+	Spender      common.Address
+	WalletPK     string
+	EstimateOnly bool
+	Gas          *bozdo.Gas
+}
+
+// MulticallApprovals non zero allowances of the tokens of the wallet to the spenders read in one request. The
+// spenders are named after the known and the registered ones
+func MulticallApprovals(ctx context.Context, src *BalanceSource, known []Spender, req *ApprovalsReq) (*ApprovalsRes, error) {
+	multicallABIsOnce.Do(loadMulticallABIs)
+	if multicallABIsErr != nil {
+		return nil, multicallABIsErr
+	}
+
+	known = Spenders(append(known, req.Registered...)...)
+	spenders := req.Spenders
+	if len(spenders) == 0 {
+		spenders = known
+	}
+	names := make(map[common.Address]string)
+	for _, s := range known {
+		names[s.Address] = s.Name
+	}
+
+	tokens := req.Tokens
+	if len(tokens) == 0 {
+		tokens = networkTokens(src.TokenMap, src.MainToken)
+	}
+
+	calls := make([]Call3, 0, len(tokens)*len(spenders))
+	approvals := make([]*Approval, 0, len(tokens)*len(spenders))
+	for _, token := range tokens {
+		addr, ok := src.TokenMap[token]
+		if !ok || token == src.MainToken {
+			continue
+		}
+		for _, spender := range spenders {
+			data, err := erc20ABI.Pack("allowance", req.Wallet, spender.Address)
+			if err != nil {
+				return nil, err
+			}
+			if spender.Name == "" {
+				spender.Name = names[spender.Address]
+			}
+			calls = append(calls, Call3{Target: addr, AllowFailure: true, CallData: data})
+			approvals = append(approvals, &Approval{Token: token, Spender: spender})
+		}
+	}
+
+	results, err := Multicall3(ctx, src.Caller, src.Multicall, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ApprovalsRes{Approvals: make([]*Approval, 0)}
+	for i, r := range results {
+		if !r.Success || len(r.ReturnData) < 32 {
+			continue
+		}
+		a := approvals[i]
+		a.Allowance = new(big.Int).SetBytes(r.ReturnData[:32])
+		a.Unlimited = a.Allowance.Cmp(unlimitedAllowance) >= 0
+		if a.Allowance.Sign() == 0 || (req.UnlimitedOnly && !a.Unlimited) {
+			continue
+		}
+		res.Approvals = append(res.Approvals, a)
+	}
+	return res, nil
+}
+
+// networkTokens erc20 tokens of the token map in the order of the enum
+func networkTokens(tokenMap map[Token]common.Address, mainToken Token) []Token {
+	tokens := make([]Token, 0, len(tokenMap))
+	for token := range tokenMap {
+		if token != mainToken {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i] < tokens[j] })
+	return tokens
+}
+
+// Spenders unique non zero spenders in the order they are given
+func Spenders(spenders ...Spender) []Spender {
+	res := make([]Spender, 0, len(spenders))
+	seen := make(map[common.Address]bool)
+	for _, s := range spenders {
+		if s.Address == (common.Address{}) || seen[s.Address] {
+			continue
+		}
+		seen[s.Address] = true
+		res = append(res, s)
+	}
+	return res
+}
+
+// SpenderRecorder keeps the spender the task of the profile approved the token of the network to
+type SpenderRecorder func(ctx context.Context, network v1.Network, s Spender) error
+
+type spenderRecorderKey struct{}
+
+// WithSpenderRecorder the spenders registered with the context are kept by the recorder
+func WithSpenderRecorder(ctx context.Context, r SpenderRecorder) context.Context {
+	return context.WithValue(ctx, spenderRecorderKey{}, r)
+}
+
+// RegisterSpender the task approves the token of the network to the spender that is not known ahead, it is kept
+// before the approval so the approvals of the profile list it since then
+func RegisterSpender(ctx context.Context, network v1.Network, s Spender) error {
+	r, ok := ctx.Value(spenderRecorderKey{}).(SpenderRecorder)
+	if !ok || s.Address == (common.Address{}) {
+		return nil
+	}
+	if err := r(ctx, network, s); err != nil {
+		return errors.Wrap(err, "register spender")
+	}
+	return nil
+}
+
+// TaskSpenders spenders of the api bridges of the network
+func TaskSpenders(network v1.Network) []Spender {
+	s := make([]Spender, 0)
+	if addr, ok := across.SpokePool[network]; ok {
+		s = append(s, Spender{Name: "Across spoke pool", Address: addr})
+	}
+	hops := make([]Spender, 0)
+	if network == v1.Network_Etherium {
+		for token, addr := range hop.L1Bridge {
+			hops = append(hops, Spender{Name: "Hop " + token.String() + " bridge", Address: addr})
+		}
+	}
+	for token, addr := range hop.AmmWrapper[network] {
+		hops = append(hops, Spender{Name: "Hop " + token.String() + " AMM wrapper", Address: addr})
+	}
+	sort.Slice(hops, func(i, j int) bool { return hops[i].Name < hops[j].Name })
+	s = append(s, hops...)
+	if addr, ok := synapse.Router[network]; ok {
+		s = append(s, Spender{Name: "Synapse router", Address: addr})
+	}
+	return s
+}
+
+// KnownSpenders contracts of the network the tasks approve the tokens to: the ones of the dapps, the official and the
+// api bridges
+func (c *EtheriumClient) KnownSpenders() []Spender {
+	s := make([]Spender, 0)
+	if d := c.Cfg.Dict; d != nil {
+		s = append(s,
+			Spender{Name: "Stargate router", Address: d.Stargate.StargateRouterAddress},
+			Spender{Name: "Stargate LP staking", Address: d.Stargate.LPStakingAddress},
+			Spender{Name: "TestNetBridge", Address: d.TestNetBridgeSwapAddress},
+			Spender{Name: "TraderJoe LB router", Address: d.TraderJoe.LBRouter},
+			Spender{Name: "Aave pool", Address: d.Aave.Pool},
+			Spender{Name: "Aave WETH gateway", Address: d.Aave.WETHGateway},
+		)
+	}
+	if addr, ok := c.Cfg.TokenMap[v1.Token_STG]; ok {
+		s = append(s, Spender{Name: "STG OFT", Address: addr})
+	}
+	if addr, ok := c.Cfg.TokenMap[v1.Token_veSTG]; ok {
+		s = append(s, Spender{Name: "veSTG", Address: addr})
+	}
+	if _, ok := c.Cfg.TokenMap[v1.Token_MAV]; ok {
+		s = append(s, Spender{Name: "Stargate MAV router", Address: stargateMavRouter})
+	}
+	s = append(s, officialBridgeSpenders(c.Cfg.Network)...)
+	s = append(s, TaskSpenders(c.Cfg.Network)...)
+	return Spenders(s...)
+}
+
+// Approvals outstanding approvals of the wallet read in one request
+func (c *EtheriumClient) Approvals(ctx context.Context, req *ApprovalsReq) (*ApprovalsRes, error) {
+	return MulticallApprovals(ctx, &BalanceSource{
+		Caller:    c.Cli,
+		Multicall: Multicall3Address,
+		Network:   c.Cfg.Network,
+		MainToken: c.Cfg.MainToken,
+		TokenMap:  c.Cfg.TokenMap,
+	}, c.KnownSpenders(), req)
+}
+
+// RevokeApproval sets the allowance of the token to the spender to zero
+func (c *EtheriumClient) RevokeApproval(ctx context.Context, req *RevokeApprovalReq) (*bozdo.DefaultRes, error) {
+
+	result := &bozdo.DefaultRes{}
+
+	addr, ok := c.Cfg.TokenMap[req.Token]
+	if !ok {
+		return nil, ErrTokenNotSupportedFn(req.Token)
+	}
+
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := RevokeData(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	ecost, tx, err := c.sendContractCall(ctx, tr, &contractCall{
+		To:    addr,
+		Value: big.NewInt(0),
+		Data:  data,
+	}, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = "revoke approval"
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeApprove, nil)
+
+	return result, nil
+}
+
+// RevokeData erc20 approve of zero to the spender
+func RevokeData(spender common.Address) ([]byte, error) {
+	abi, err := erc_20.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("approve", spender, big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrap(err, "approve pack")
+	}
+	return data, nil
+}
+
+type exactApproveKey struct{}
+
+// WithExactApprove the approvals made with the context are of the spent amount instead of the whole balance
+func WithExactApprove(ctx context.Context) context.Context {
+	return context.WithValue(ctx, exactApproveKey{}, true)
+}
+
+// ExactApprove the spent amount is approved only
+func ExactApprove(ctx context.Context) bool {
+	exact, _ := ctx.Value(exactApproveKey{}).(bool)
+	return exact
+}
@@ -21,16 +21,28 @@ var TokenAddress = map[defi.Token]common.Address{
 	v1.Token_USDT:  common.HexToAddress("0xFd086bC7CD5C481DCC9C85ebE478A1C0b69FCbb9"),
 	v1.Token_STG:   common.HexToAddress("0x6694340fc020c5e6b96567843da2df01b2ce1eb6"),
 	v1.Token_USDC:  common.HexToAddress("0xff970a61a04b1ca14834a43f5de4533ebddb5cc8"),
-	v1.Token_veSTG: common.HexToAddress("0xb0d502e938ed5f4df2e681fe6e419ff29631d62b"),
 	v1.Token_ETH:   common.HexToAddress("0x0000000000000000000000000000000000000000"),
+	v1.Token_veSTG: common.HexToAddress("0xfBd849E6007f9BC3CC2D6Eb159c045B8dc660268"),
+	v1.Token_FRAX:  common.HexToAddress("0x17FC002b466eEc40DaE837Fc4bE5c67993ddBd6F"),
+	v1.Token_LUSD:  common.HexToAddress("0x93b346b6BC2548dA6A1E7d98E9a421B42541425b"),
+	v1.Token_MAI:   common.HexToAddress("0x3F56e0c36d275367b8C502090EDF38289b3dEa0d"),
 }
 
 var Dict = defi.Dict{
 	Stargate: defi.Stargate{
 		StargateRouterAddress:    common.HexToAddress("0x53Bf833A5d6c4ddA888F69c22C88C9f356a41614"),
 		StargateRouterEthAddress: common.HexToAddress("0xbf22f0f184bCcbeA268dF387a49fF5238dD23E40"),
+		LPStakingAddress:         common.HexToAddress("0x9774558534036Ff2E236331546691b4eB70594b1"),
 	},
 	TestNetBridgeSwapAddress: common.HexToAddress("0x0A9f824C05A74F577A536A8A0c673183a872Dff4"),
+	TraderJoe: defi.TraderJoe{
+		LBRouter: common.HexToAddress("0xb4315e873dBcf96Ffd0acd8EA43f689D8c20fB30"),
+		LBQuoter: common.HexToAddress("0xd76019A16606FDa4651f636D9751f500Ed776250"),
+	},
+	Aave: defi.Aave{
+		Pool:        common.HexToAddress("0x794a61358D6845594F94dc1DB02A252b5b4814aD"),
+		WETHGateway: common.HexToAddress("0xB5Ee21786D28c5Ba61661550879475976B707099"),
+	},
 }
 
 type Client struct {
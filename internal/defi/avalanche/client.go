@@ -21,14 +21,25 @@ var TokenAddress = map[defi.Token]common.Address{
 	v1.Token_USDT:  common.HexToAddress("0x9702230A8Ea53601f5cD2dc00fDBc13d4dF4A8c7"),
 	v1.Token_STG:   common.HexToAddress("0x2F6F07CDcf3588944Bf4C42aC74ff24bF56e7590"),
 	v1.Token_USDC:  common.HexToAddress("0xB97EF9Ef8734C71904D8002F8b6Bc66Dd9c48a6E"),
-	v1.Token_veSTG: common.HexToAddress("0xB0D502E938ed5f4df2E681fE6E419ff29631d62b"),
 	v1.Token_AVAX:  common.HexToAddress("0x0000000000000000000000000000000000000000"),
+	v1.Token_veSTG: common.HexToAddress("0xCa0F57D295bbcE554DA2c07b005b7d6565a58fCE"),
+	v1.Token_FRAX:  common.HexToAddress("0xD24C2Ad096400B6FBcd2ad8B24E7acBc21A1da64"),
+	v1.Token_MAI:   common.HexToAddress("0x5c49b268c9841AFF1Cc3B0a418ff5c3442eE3F3b"),
 }
 
 var Dict = defi.Dict{
 	Stargate: defi.Stargate{
 		StargateRouterAddress:    common.HexToAddress("0x45A01E4e04F14f7A4a6702c74187c5F6222033cd"),
 		StargateRouterEthAddress: common.HexToAddress(""),
+		LPStakingAddress:         common.HexToAddress("0x8731d54E9D02c286767d56ac03e8037C07e01e98"),
+	},
+	TraderJoe: defi.TraderJoe{
+		LBRouter: common.HexToAddress("0xb4315e873dBcf96Ffd0acd8EA43f689D8c20fB30"),
+		LBQuoter: common.HexToAddress("0xd76019A16606FDa4651f636D9751f500Ed776250"),
+	},
+	Aave: defi.Aave{
+		Pool:        common.HexToAddress("0x794a61358D6845594F94dc1DB02A252b5b4814aD"),
+		WETHGateway: common.HexToAddress("0x2825cE5921538d17cc15Ae00a8B24fF759C6CDaE"),
 	},
 }
 
@@ -7,7 +7,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
-	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 )
 
 type GetBalanceReq struct {
@@ -39,15 +38,7 @@ func (c *EtheriumClient) getBalance(ctx context.Context, req *GetBalanceReq) (*G
 		if err != nil {
 			return nil, err
 		}
-
-		f, _ := WEIToEther(b).Float64()
-		return &GetBalanceRes{
-			Req:           req,
-			WEI:           b,
-			ETHER:         WEIToEther(b),
-			HumanReadable: WEIToEther(b).String(),
-			Float:         f,
-		}, nil
+		return balanceRes(req, b, c.Cfg.MainToken, c.Cfg.Network), nil
 	}
 
 	ta, ok := c.Cfg.TokenMap[req.Token]
@@ -69,19 +60,7 @@ func (c *EtheriumClient) getBalance(ctx context.Context, req *GetBalanceReq) (*G
 		return nil, err
 	}
 
-	f, _ := WEIToEther(b).Float64()
-	res := &GetBalanceRes{
-		Req:           req,
-		WEI:           b,
-		ETHER:         WeiToToken(b, req.Token),
-		HumanReadable: WeiToToken(b, req.Token).String(),
-		Float:         f,
-	}
-	if c.Cfg.Network == v1.Network_BinanaceBNB {
-		res.HumanReadable = WEIToEther(b).String()
-	}
-
-	return res, nil
+	return balanceRes(req, b, c.Cfg.MainToken, c.Cfg.Network), nil
 }
 
 func (c *EtheriumClient) TxViewFn(id string) string {
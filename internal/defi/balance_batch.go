@@ -0,0 +1,78 @@
+package defi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// balanceBatchWindow the balance reads of the wallets are collected for before they are made in one request
+	balanceBatchWindow  = 50 * time.Millisecond
+	balanceBatchTimeout = 30 * time.Second
+)
+
+type balanceBatch struct {
+	batcher BatchBalancer
+	wallets map[common.Address]bool
+	tokens  map[Token]bool
+
+	done chan struct{}
+	res  *GetBalancesRes
+	err  error
+}
+
+var (
+	balanceBatchesMu sync.Mutex
+	balanceBatches   = map[string]*balanceBatch{}
+)
+
+// BatchBalances balances of the tokens of the wallet read along with the other wallets requested with the same key
+// within balanceBatchWindow, so the profiles read at once take one request. The key tells apart the networks, the
+// endpoints and the proxies the wallets are not to be read together through
+func BatchBalances(ctx context.Context, key string, batcher BatchBalancer, wallet common.Address, tokens []Token) (*GetBalancesRes, error) {
+	balanceBatchesMu.Lock()
+	b, ok := balanceBatches[key]
+	if !ok {
+		b = &balanceBatch{
+			batcher: batcher,
+			wallets: map[common.Address]bool{},
+			tokens:  map[Token]bool{},
+			done:    make(chan struct{}),
+		}
+		balanceBatches[key] = b
+		time.AfterFunc(balanceBatchWindow, func() { b.run(key) })
+	}
+	b.wallets[wallet] = true
+	for _, t := range tokens {
+		b.tokens[t] = true
+	}
+	balanceBatchesMu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-b.done:
+		return b.res, b.err
+	}
+}
+
+func (b *balanceBatch) run(key string) {
+	balanceBatchesMu.Lock()
+	delete(balanceBatches, key)
+	req := &GetBalancesReq{}
+	for w := range b.wallets {
+		req.Wallets = append(req.Wallets, w)
+	}
+	for t := range b.tokens {
+		req.Tokens = append(req.Tokens, t)
+	}
+	balanceBatchesMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), balanceBatchTimeout)
+	defer cancel()
+	b.res, b.err = b.batcher.GetBalances(ctx, req)
+	close(b.done)
+}
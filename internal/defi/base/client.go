@@ -0,0 +1,81 @@
+package base
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// docs https://docs.base.org/network-information
+
+const (
+	MainNetURL = "https://mainnet.base.org"
+)
+
+// USDC is the bridged USDbC Stargate pool works with
+var TokenAddress = map[defi.Token]common.Address{
+	v1.Token_USDC: common.HexToAddress("0xd9aAEc86B65D86f6A7B5B1b0c42FFA531710b6CA"),
+	v1.Token_STG:  common.HexToAddress("0xE3B53AF74a4BF62Ae5511055290838050bf764Df"),
+	v1.Token_ETH:  common.HexToAddress("0x0000000000000000000000000000000000000000"),
+}
+
+var Dict = defi.Dict{
+	Stargate: defi.Stargate{
+		StargateRouterAddress:    common.HexToAddress("0x45f1A95A4D3f3836523F5c83673c797f4d4d263B"),
+		StargateRouterEthAddress: common.HexToAddress("0x50B6EbC2103BFEc165949CC946d739d5650d7ae4"),
+		LPStakingAddress:         common.HexToAddress("0x06Eb48763f117c7Be887296CDcdfad2E4092739C"),
+	},
+}
+
+type Client struct {
+	defi      *defi.EtheriumClient
+	NetworkId *big.Int
+}
+
+type ClientConfig struct {
+	HttpCli     *http.Client
+	RPCEndpoint string
+}
+
+func TxViewer(txId string) string {
+	return "https://basescan.org/tx/" + txId
+}
+
+func NewClient(c *ClientConfig) (*Client, error) {
+
+	config := &ClientConfig{
+		HttpCli: &http.Client{},
+	}
+	if c != nil {
+		config = c
+	}
+
+	ethcli, err := defi.NewEVMClient(&defi.ClientConfig{
+		Network:   v1.Network_Base,
+		MainToken: v1.Token_ETH,
+		MainNet:   c.RPCEndpoint,
+		TokenMap:  TokenAddress,
+		Dict:      &Dict,
+		Httpcli:   config.HttpCli,
+		TxViewFn:  TxViewer,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to connect to ethereum main: "+c.RPCEndpoint)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	networkId, err := ethcli.GetNetworkId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{defi: ethcli, NetworkId: networkId}, nil
+}
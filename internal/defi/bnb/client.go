@@ -20,17 +20,23 @@ const (
 )
 
 var TokenAddress = map[defi.Token]common.Address{
-	v1.Token_USDT: common.HexToAddress("0x55d398326f99059fF775485246999027B3197955"),
-	v1.Token_STG:  common.HexToAddress("0xB0D502E938ed5f4df2E681fE6E419ff29631d62b"),
-	v1.Token_USDC: common.HexToAddress("0x8AC76a51cc950d9822D68b83fE1Ad97B32Cd580d"),
-	v1.Token_BNB:  common.HexToAddress("0x0000000000000000000000000000000000000000"),
-	v1.Token_MAV:  common.HexToAddress("0xd691d9a68C887BDF34DA8c36f63487333ACfD103"),
+	v1.Token_USDT:  common.HexToAddress("0x55d398326f99059fF775485246999027B3197955"),
+	v1.Token_STG:   common.HexToAddress("0xB0D502E938ed5f4df2E681fE6E419ff29631d62b"),
+	v1.Token_USDC:  common.HexToAddress("0x8AC76a51cc950d9822D68b83fE1Ad97B32Cd580d"),
+	v1.Token_BNB:   common.HexToAddress("0x0000000000000000000000000000000000000000"),
+	v1.Token_MAV:   common.HexToAddress("0xd691d9a68C887BDF34DA8c36f63487333ACfD103"),
+	v1.Token_veSTG: common.HexToAddress("0xD4888870C8686c748232719051b677791dBDa26D"),
+	v1.Token_BUSD:  common.HexToAddress("0xe9e7CEA3DedcA5984780Bafc599bD69ADd087D56"),
+	v1.Token_USDD:  common.HexToAddress("0xd17479997F34dd9156Deef8F95A52D81D265be9c"),
+	v1.Token_MAI:   common.HexToAddress("0x3F56e0c36d275367b8C502090EDF38289b3dEa0d"),
+	v1.Token_METIS: common.HexToAddress("0xe552Fb52a4F19e44ef5A967632DBc320B0820639"),
 }
 
 var Dict = defi.Dict{
 	Stargate: defi.Stargate{
 		StargateRouterAddress:    common.HexToAddress("0x4a364f8c717cAAD9A442737Eb7b8A55cc6cf18D8"),
 		StargateRouterEthAddress: common.HexToAddress("not supported"),
+		LPStakingAddress:         common.HexToAddress("0x3052A0F6ab15b4AE1df39962d5DdEFacA86DaB47"),
 	},
 }
 
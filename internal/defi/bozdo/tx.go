@@ -21,6 +21,8 @@ const (
 	TxDetailKeyNativeBalanceBefore = "NativeBalanceBefore"
 	TxDetailKeyNativeBalanceAfter  = "NativeBalanceAfter"
 	TxDetailKeyTxFee               = "TxFee"
+	TxDetailKeyBridgeFee           = "BridgeFee"
+	TxDetailKeyPaymasterFee        = "PaymasterFee"
 )
 
 type TxDetail struct {
@@ -36,6 +38,7 @@ const (
 	CodeTransfer TxCode = "transfer"
 	CodeSwap     TxCode = "swap"
 	CodeBridge   TxCode = "bridge"
+	CodeLend     TxCode = "lend"
 )
 
 type Transaction struct {
@@ -70,10 +73,18 @@ func (g *Gas) RuleSet() bool {
 	return g != nil
 }
 
+// Speed priority fee of the network settings, normal one when the gas is not set
+func (g *Gas) Speed() v1.FeeSpeed {
+	if g == nil {
+		return v1.FeeSpeed_FeeNormal
+	}
+	return g.FeeSpeed
+}
+
 type TxType = string
 
 const TxTypeLegacy = "legacy"
-const TxTypeDynamic = "legacy"
+const TxTypeDynamic = "dynamic"
 const TxTypeStarkNet = "starkNet"
 const TxTypeOptimism = "optimism"
 
@@ -85,12 +96,16 @@ type EstimatedGasCost struct {
 	TotalGasWei *big.Int
 	Details     []TxDetail
 	ExtraFee    *big.Int
+	// BaseFee and PriorityFee of the dynamic fee tx, GasPrice is its fee cap
+	BaseFee     *big.Int
+	PriorityFee *big.Int
 }
 
 type Gas struct {
 	Network       v1.Network
 	GasMultiplier float64
 	MaxGas        big.Int
+	FeeSpeed      v1.FeeSpeed
 
 	GasBeforeMultiplier big.Int
 	GasLimit            big.Int
@@ -112,6 +127,22 @@ func NewProtocolFeeDetails(s *big.Int, network v1.Network, token v1.Token) TxDet
 	}
 }
 
+// NewBridgeFeeDetails fee the bridge takes from the transferred token, amount is in token units
+func NewBridgeFeeDetails(amount string, token v1.Token) TxDetail {
+	return TxDetail{
+		Key:   TxDetailKeyBridgeFee,
+		Value: amount + " " + token.String(),
+	}
+}
+
+// NewPaymasterFeeDetails max fee the paymaster takes in the token instead of the gas, amount is in token units
+func NewPaymasterFeeDetails(amount string, token v1.Token) TxDetail {
+	return TxDetail{
+		Key:   TxDetailKeyPaymasterFee,
+		Value: amount + " " + token.String(),
+	}
+}
+
 func NewLZFeeDetails(s *big.Int, network v1.Network, token v1.Token) TxDetail {
 	return TxDetail{
 		Key:   TxDetailKeyLayerZeroFee,
@@ -159,7 +190,8 @@ func CastUSD(wei *big.Int, network v1.Network, token v1.Token) string {
 	switch network {
 	case v1.Network_ZKSYNCERA, v1.Network_ZKSYNCLITE,
 		v1.Network_Etherium, v1.Network_ARBITRUM,
-		v1.Network_OPTIMISM, v1.Network_GOERLIETH:
+		v1.Network_OPTIMISM, v1.Network_GOERLIETH,
+		v1.Network_Base, v1.Network_Linea:
 		gasTokenPrice = pub.Price().ETH
 	case v1.Network_POLIGON:
 		gasTokenPrice = pub.Price().MATIC
@@ -167,6 +199,8 @@ func CastUSD(wei *big.Int, network v1.Network, token v1.Token) string {
 		gasTokenPrice = pub.Price().BNB
 	case v1.Network_AVALANCHE:
 		gasTokenPrice = pub.Price().AVAX
+	case v1.Network_Metis:
+		gasTokenPrice = pub.Price().METIS
 	}
 	amEth := WEIToEther(wei)
 	amUsd := EthToUsd(amEth, gasTokenPrice)
@@ -0,0 +1,137 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/bridge"
+	"github.com/hardstylez72/cry/internal/defi/bridge/across"
+	"github.com/hardstylez72/cry/internal/defi/bridge/hop"
+	"github.com/hardstylez72/cry/internal/defi/bridge/synapse"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/server/config"
+	"github.com/pkg/errors"
+)
+
+type bridgeTxMaker interface {
+	MakeTx(ctx context.Context, req *bridge.Req) (*bridge.Tx, error)
+}
+
+func newBridgeTxMaker(taskType v1.TaskType) (bridgeTxMaker, error) {
+	var acrossHost, hopHost, synapseHost string
+	if config.CFG != nil {
+		acrossHost = config.CFG.AcrossApiUrl
+		hopHost = config.CFG.HopApiUrl
+		synapseHost = config.CFG.SynapseApiUrl
+	}
+
+	switch taskType {
+	case v1.TaskType_AcrossBridge:
+		return across.NewService(&across.Config{Host: acrossHost}), nil
+	case v1.TaskType_HopBridge:
+		return hop.NewService(&hop.Config{Host: hopHost}), nil
+	case v1.TaskType_SynapseBridge:
+		return synapse.NewService(&synapse.Config{Host: synapseHost}), nil
+	default:
+		return nil, errors.New("unsupported task type: " + taskType.String())
+	}
+}
+
+// MakeBridgeTx quotes the bridge api of the task and builds the source chain tx, shared by evm and zksync clients
+func MakeBridgeTx(ctx context.Context, taskType v1.TaskType, req *DefaultBridgeReq, tokenMap map[Token]common.Address, mainToken Token, wallet common.Address) (*bridge.Tx, error) {
+	maker, err := newBridgeTxMaker(taskType)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &bridge.Req{
+		From:            req.FromNetwork,
+		To:              req.ToNetwork,
+		Token:           req.FromToken,
+		Native:          req.FromToken == mainToken,
+		Amount:          req.Amount,
+		AmountToken:     WeiToToken(req.Amount, req.FromToken).Text('f', -1),
+		Wallet:          wallet,
+		SlippagePercent: req.Slippage,
+	}
+	if !r.Native {
+		addr, ok := tokenMap[req.FromToken]
+		if !ok {
+			return nil, ErrTokenNotSupportedFn(req.FromToken)
+		}
+		r.TokenAddr = addr
+	}
+
+	tx, err := maker.MakeTx(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Fee != nil && tx.Fee.Sign() > 0 {
+		tx.Details = append(tx.Details, bozdo.NewBridgeFeeDetails(WeiToToken(tx.Fee, req.FromToken).Text('f', 6), req.FromToken))
+	}
+	return tx, nil
+}
+
+func (c *EtheriumClient) Bridge(ctx context.Context, req *DefaultBridgeReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
+
+	result := &bozdo.DefaultRes{}
+
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.FromNetwork != c.Cfg.Network {
+		return nil, errors.New("invalid source network: " + req.FromNetwork.String())
+	}
+
+	tx, err := MakeBridgeTx(ctx, taskType, req, c.Cfg.TokenMap, c.Cfg.MainToken, tr.WalletAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.Spender != bozdo.ZEROADDR {
+		if err := RegisterSpender(ctx, c.Cfg.Network, Spender{Name: taskType.String(), Address: tx.Spender}); err != nil {
+			return nil, err
+		}
+		limitTx, err := c.TokenLimitChecker(ctx, &TokenLimitCheckerReq{
+			Token:       req.FromToken,
+			Wallet:      tr,
+			Amount:      req.Amount,
+			SpenderAddr: tx.Spender,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "TokenLimitChecker")
+		}
+		if limitTx.LimitExtended {
+			result.ApproveTx = c.NewTx(limitTx.ApproveTx.Hash(), CodeApprove, nil)
+		}
+	}
+
+	value := tx.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	ecost, sent, err := c.sendContractCall(ctx, tr, &contractCall{
+		To:    tx.ContractAddr,
+		Value: value,
+		Data:  tx.Data,
+	}, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = taskType.String()
+	ecost.Details = append(ecost.Details, tx.Details...)
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(sent.Hash(), CodeContract, tx.Details)
+
+	return result, nil
+}
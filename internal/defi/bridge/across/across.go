@@ -0,0 +1,153 @@
+package across
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/bridge"
+	"github.com/hardstylez72/cry/internal/defi/contracts/across/spokepool"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// docs https://docs.across.to/reference/api
+
+const DefaultHost = "https://across.to"
+
+var SpokePool = map[v1.Network]common.Address{
+	v1.Network_Etherium:  common.HexToAddress("0x5c7BCd6E7De5423a257D81B442095A1a6ced35C5"),
+	v1.Network_OPTIMISM:  common.HexToAddress("0x6f26Bf09B1C792e3228e5467807a900A503c0281"),
+	v1.Network_POLIGON:   common.HexToAddress("0x9295ee1d8C5b022Be115A2AD3c30C72E34e7F096"),
+	v1.Network_ARBITRUM:  common.HexToAddress("0xe35e9842fceaCA96570B734083f4a58e8F7C5f2A"),
+	v1.Network_ZKSYNCERA: common.HexToAddress("0xE0B015E54d54fc84a6cB9B666099c46adE9335FF"),
+	v1.Network_Base:      common.HexToAddress("0x09aea4b2242abC8bb4BB78D537A67a245A7bEC64"),
+	v1.Network_Linea:     common.HexToAddress("0x7E63A5f1a8F0B4d0934B2f2327DAED3F6bb2ee75"),
+}
+
+// WETH origin token of eth deposits, the spoke pool wraps msg.value
+var WETH = map[v1.Network]common.Address{
+	v1.Network_Etherium:  common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"),
+	v1.Network_OPTIMISM:  common.HexToAddress("0x4200000000000000000000000000000000000006"),
+	v1.Network_ARBITRUM:  common.HexToAddress("0x82aF49447D8a07e3bd95BD0d56f35241523fBab1"),
+	v1.Network_ZKSYNCERA: common.HexToAddress("0x5AEa5775959fBC2557Cc8789bC1bf90A239D9a91"),
+	v1.Network_Base:      common.HexToAddress("0x4200000000000000000000000000000000000006"),
+	v1.Network_Linea:     common.HexToAddress("0xe5D7C2a44FfDDf6b295A15c148167daaAf5Cf34f"),
+}
+
+type Config struct {
+	Host string
+}
+
+type Service struct {
+	cli *http.Client
+	c   *Config
+}
+
+func NewService(c *Config) *Service {
+	if c.Host == "" {
+		c.Host = DefaultHost
+	}
+	return &Service{
+		cli: &http.Client{},
+		c:   c,
+	}
+}
+
+type SuggestedFees struct {
+	// RelayFeePct 1e18 is 100%
+	RelayFeePct    string `json:"relayFeePct"`
+	Timestamp      string `json:"timestamp"`
+	IsAmountTooLow bool   `json:"isAmountTooLow"`
+	TotalRelayFee  struct {
+		Pct   string `json:"pct"`
+		Total string `json:"total"`
+	} `json:"totalRelayFee"`
+}
+
+func (s *Service) SuggestedFees(ctx context.Context, token common.Address, from, to int64, amount *big.Int) (*SuggestedFees, error) {
+	q := url.Values{}
+	q.Set("token", token.String())
+	q.Set("originChainId", strconv.FormatInt(from, 10))
+	q.Set("destinationChainId", strconv.FormatInt(to, 10))
+	q.Set("amount", amount.String())
+
+	var res SuggestedFees
+	if err := bridge.GetJSON(ctx, s.cli, s.c.Host+"/api/suggested-fees?"+q.Encode(), &res); err != nil {
+		return nil, errors.Wrap(err, "across suggested-fees")
+	}
+	return &res, nil
+}
+
+// MakeTx quotes the relay fee and packs the spoke pool deposit
+func (s *Service) MakeTx(ctx context.Context, req *bridge.Req) (*bridge.Tx, error) {
+	from, to, err := req.Chains()
+	if err != nil {
+		return nil, err
+	}
+	pool, ok := SpokePool[req.From]
+	if !ok {
+		return nil, errors.New("across is not supported in " + req.From.String())
+	}
+	if _, ok := SpokePool[req.To]; !ok {
+		return nil, errors.New("across is not supported in " + req.To.String())
+	}
+
+	token := req.TokenAddr
+	if req.Native {
+		token, ok = WETH[req.From]
+		if !ok {
+			return nil, errors.New("across has no native token route in " + req.From.String())
+		}
+	}
+
+	fees, err := s.SuggestedFees(ctx, token, from, to, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if fees.IsAmountTooLow {
+		return nil, errors.New("amount is too low for across relayers")
+	}
+
+	relayFeePct, err := strconv.ParseInt(fees.RelayFeePct, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid relayFeePct: "+fees.RelayFeePct)
+	}
+	timestamp, err := strconv.ParseUint(fees.Timestamp, 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid timestamp: "+fees.Timestamp)
+	}
+	fee, ok := new(big.Int).SetString(fees.TotalRelayFee.Total, 10)
+	if !ok {
+		fee = big.NewInt(0)
+	}
+
+	a, err := spokepool.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := a.Pack("deposit", req.Wallet, token, req.Amount, big.NewInt(to), relayFeePct, uint32(timestamp), []byte{}, math.MaxBig256)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &bridge.Tx{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        big.NewInt(0),
+			ContractAddr: pool,
+		},
+		Fee: fee,
+	}
+	if req.Native {
+		tx.Value = req.Amount
+	} else {
+		tx.Spender = pool
+	}
+	return tx, nil
+}
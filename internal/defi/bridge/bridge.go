@@ -0,0 +1,91 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// ChainId evm chain id of the network, bridge apis identify chains by it
+var ChainId = map[v1.Network]int64{
+	v1.Network_Etherium:         1,
+	v1.Network_OPTIMISM:         10,
+	v1.Network_BinanaceBNB:      56,
+	v1.Network_POLIGON:          137,
+	v1.Network_ZKSYNCERA:        324,
+	v1.Network_Metis:            1088,
+	v1.Network_Base:             8453,
+	v1.Network_ARBITRUM:         42161,
+	v1.Network_AVALANCHE:        43114,
+	v1.Network_Linea:            59144,
+	v1.Network_GOERLIETH:        5,
+	v1.Network_ZKSYNCERATESTNET: 280,
+}
+
+type Req struct {
+	From  v1.Network
+	To    v1.Network
+	Token v1.Token
+	// TokenAddr erc20 of the source chain, not used for the native token
+	TokenAddr common.Address
+	Native    bool
+	Amount    *big.Int
+	// AmountToken Amount in token units for the apis that take it so
+	AmountToken string
+	Wallet      common.Address
+	// SlippagePercent e.g. "0.5"
+	SlippagePercent string
+}
+
+type Tx struct {
+	bozdo.TxData
+	// Spender has to be approved to pull the erc20, zero for the native token
+	Spender common.Address
+	// Fee bridge fee taken from the transferred amount
+	Fee *big.Int
+}
+
+func (r *Req) Chains() (from, to int64, err error) {
+	from, ok := ChainId[r.From]
+	if !ok {
+		return 0, 0, errors.New("unsupported network: " + r.From.String())
+	}
+	to, ok = ChainId[r.To]
+	if !ok {
+		return 0, 0, errors.New("unsupported network: " + r.To.String())
+	}
+	if from == to {
+		return 0, 0, errors.New("invalid chain, same chain")
+	}
+	return from, to, nil
+}
+
+// GetJSON bridge api call, non 200 response body is returned as the error
+func GetJSON(ctx context.Context, cli *http.Client, url string, out interface{}) error {
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := cli.Do(r)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.New(string(body))
+	}
+
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,191 @@
+package hop
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/bridge"
+	"github.com/hardstylez72/cry/internal/defi/contracts/hop/ammwrapper"
+	"github.com/hardstylez72/cry/internal/defi/contracts/hop/l1bridge"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// docs https://docs.hop.exchange/developer-docs/api/api
+
+const DefaultHost = "https://api.hop.exchange"
+
+var chainSlug = map[v1.Network]string{
+	v1.Network_Etherium: "ethereum",
+	v1.Network_OPTIMISM: "optimism",
+	v1.Network_ARBITRUM: "arbitrum",
+	v1.Network_POLIGON:  "polygon",
+	v1.Network_Base:     "base",
+}
+
+// L1Bridge ethereum bridges, they take the deposit without a swap
+var L1Bridge = map[v1.Token]common.Address{
+	v1.Token_ETH:  common.HexToAddress("0xb8901acB165ed027E32754E0FFe830802919727f"),
+	v1.Token_USDC: common.HexToAddress("0x3666f603Cc164936C1b87e207F36BEBa4AC5f18a"),
+	v1.Token_USDT: common.HexToAddress("0x3E4a3a4796d16c0Cd582C382691998f7c06420B6"),
+}
+
+// AmmWrapper l2 entry points swapping the canonical token to hToken and sending it
+var AmmWrapper = map[v1.Network]map[v1.Token]common.Address{
+	v1.Network_ARBITRUM: {
+		v1.Token_ETH:  common.HexToAddress("0x33ceb27b39d2Bb7D2e61F7564d3Df29344020417"),
+		v1.Token_USDC: common.HexToAddress("0xe22D2beDb3Eca35E6397e0C6D62857094aA26F52"),
+		v1.Token_USDT: common.HexToAddress("0xCB0a4177E0A60247C0ad18Be87f8eDfF6DD30283"),
+	},
+	v1.Network_OPTIMISM: {
+		v1.Token_ETH:  common.HexToAddress("0x86cA30bEF97fB651b8d866D45503684b90cb3312"),
+		v1.Token_USDC: common.HexToAddress("0x2ad09850b0CA4c7c1B33f5AcD6cBAbCaB5d6e796"),
+		v1.Token_USDT: common.HexToAddress("0x7D269D3E0d61A05a0bA976b7DBF8805bF844AF3F"),
+	},
+	v1.Network_POLIGON: {
+		v1.Token_USDC: common.HexToAddress("0x76b22b8C1079A44F1211D867D68b1eda76a635A7"),
+		v1.Token_USDT: common.HexToAddress("0x8741Ba6225A6BF91f9D73531A98A89807857a2B3"),
+	},
+	v1.Network_Base: {
+		v1.Token_ETH: common.HexToAddress("0x10541b07d8Ad2647Dc6cD67abd4c03575dade261"),
+	},
+}
+
+type Config struct {
+	Host string
+}
+
+type Service struct {
+	cli *http.Client
+	c   *Config
+}
+
+func NewService(c *Config) *Service {
+	if c.Host == "" {
+		c.Host = DefaultHost
+	}
+	return &Service{
+		cli: &http.Client{},
+		c:   c,
+	}
+}
+
+type Quote struct {
+	AmountOutMin            string `json:"amountOutMin"`
+	DestinationAmountOutMin string `json:"destinationAmountOutMin"`
+	BonderFee               string `json:"bonderFee"`
+	EstimatedRecieved       string `json:"estimatedRecieved"`
+	Deadline                int64  `json:"deadline"`
+	DestinationDeadline     int64  `json:"destinationDeadline"`
+}
+
+func (s *Service) Quote(ctx context.Context, req *bridge.Req) (*Quote, error) {
+	q := url.Values{}
+	q.Set("amount", req.Amount.String())
+	q.Set("token", req.Token.String())
+	q.Set("fromChain", chainSlug[req.From])
+	q.Set("toChain", chainSlug[req.To])
+	q.Set("slippage", req.SlippagePercent)
+
+	var res Quote
+	if err := bridge.GetJSON(ctx, s.cli, s.c.Host+"/v1/quote?"+q.Encode(), &res); err != nil {
+		return nil, errors.Wrap(err, "hop quote")
+	}
+	return &res, nil
+}
+
+// MakeTx deposits to the l1 bridge from ethereum and swaps and sends through the amm wrapper from l2
+func (s *Service) MakeTx(ctx context.Context, req *bridge.Req) (*bridge.Tx, error) {
+	_, to, err := req.Chains()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := chainSlug[req.From]; !ok {
+		return nil, errors.New("hop is not supported in " + req.From.String())
+	}
+	if _, ok := chainSlug[req.To]; !ok {
+		return nil, errors.New("hop is not supported in " + req.To.String())
+	}
+
+	quote, err := s.Quote(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	amountOutMin, err := parseInt(quote.AmountOutMin)
+	if err != nil {
+		return nil, err
+	}
+	bonderFee, err := parseInt(quote.BonderFee)
+	if err != nil {
+		return nil, err
+	}
+
+	var contract common.Address
+	var data []byte
+	if req.From == v1.Network_Etherium {
+		addr, ok := L1Bridge[req.Token]
+		if !ok {
+			return nil, errors.New("hop has no " + req.Token.String() + " bridge in " + req.From.String())
+		}
+		a, err := l1bridge.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		contract = addr
+		data, err = a.Pack("sendToL2", big.NewInt(to), req.Wallet, req.Amount, amountOutMin, big.NewInt(quote.Deadline), bozdo.ZEROADDR, big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		// no bonder between l1 and l2, the message is relayed by the rollup
+		bonderFee = big.NewInt(0)
+	} else {
+		addr, ok := AmmWrapper[req.From][req.Token]
+		if !ok {
+			return nil, errors.New("hop has no " + req.Token.String() + " bridge in " + req.From.String())
+		}
+		destinationAmountOutMin, err := parseInt(quote.DestinationAmountOutMin)
+		if err != nil {
+			return nil, err
+		}
+		a, err := ammwrapper.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		contract = addr
+		data, err = a.Pack("swapAndSend", big.NewInt(to), req.Wallet, req.Amount, bonderFee, amountOutMin, big.NewInt(quote.Deadline), destinationAmountOutMin, big.NewInt(quote.DestinationDeadline))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx := &bridge.Tx{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        big.NewInt(0),
+			ContractAddr: contract,
+		},
+		Fee: bonderFee,
+	}
+	if req.Native {
+		tx.Value = req.Amount
+	} else {
+		tx.Spender = contract
+	}
+	return tx, nil
+}
+
+func parseInt(s string) (*big.Int, error) {
+	if s == "" {
+		return big.NewInt(0), nil
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, errors.New("invalid hop quote amount: " + s)
+	}
+	return v, nil
+}
@@ -0,0 +1,261 @@
+package layerzero
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
+	"github.com/hardstylez72/cry/internal/defi/contracts/layerzero/oft"
+	"github.com/hardstylez72/cry/internal/defi/contracts/layerzero/oftv2"
+	"github.com/hardstylez72/cry/internal/defi/contracts/layerzero/onft"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// default destination gas of the send packet if the contract has no minDstGasLookup set
+var (
+	oftDstGas  = big.NewInt(200000)
+	onftDstGas = big.NewInt(400000)
+)
+
+// packetTypeSend LzApp packet type of the token transfer
+const packetTypeSend uint16 = 0
+
+type SendReq struct {
+	Kind       v1.LayerZeroContract
+	Contract   common.Address
+	DstChainId uint16
+	Wallet     common.Address
+	Network    v1.Network
+	MainToken  v1.Token
+	// Amount oft amount, balance of the token is used to resolve it
+	Amount *big.Int
+	// TokenId onft to send
+	TokenId *big.Int
+}
+
+type SendApprove struct {
+	Token   common.Address
+	Spender common.Address
+	Amount  *big.Int
+}
+
+type SendTx struct {
+	bozdo.TxData
+	// Approve proxy oft pulls the underlying token and has to be approved
+	Approve *SendApprove
+}
+
+// OFTToken erc20 the oft moves, the contract itself for a plain oft and the underlying one for a proxy
+func OFTToken(ctx context.Context, cli bind.ContractCaller, kind v1.LayerZeroContract, contract common.Address) (common.Address, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	var token common.Address
+	var err error
+	switch kind {
+	case v1.LayerZeroContract_OFT:
+		caller, cerr := oft.NewStorageCaller(contract, cli)
+		if cerr != nil {
+			return common.Address{}, errors.Wrap(cerr, "oft.NewStorageCaller")
+		}
+		token, err = caller.Token(opt)
+	case v1.LayerZeroContract_OFTV2:
+		caller, cerr := oftv2.NewStorageCaller(contract, cli)
+		if cerr != nil {
+			return common.Address{}, errors.Wrap(cerr, "oftv2.NewStorageCaller")
+		}
+		token, err = caller.Token(opt)
+	default:
+		return common.Address{}, errors.New("contract is not an oft: " + kind.String())
+	}
+	// early ofts have no token method
+	if err != nil || token == bozdo.ZEROADDR {
+		return contract, nil
+	}
+	return token, nil
+}
+
+// OFTBalance wallet balance of the token the oft moves
+func OFTBalance(ctx context.Context, cli bind.ContractCaller, kind v1.LayerZeroContract, contract, wallet common.Address) (*big.Int, error) {
+	token, err := OFTToken(ctx, cli, kind, contract)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := erc_20.NewStorageCaller(token, cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.NewStorageCaller")
+	}
+	balance, err := caller.BalanceOf(&bind.CallOpts{Context: ctx}, wallet)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.BalanceOf")
+	}
+	return balance, nil
+}
+
+// LastONFT the last onft of the wallet, the contract has to be enumerable
+func LastONFT(ctx context.Context, cli bind.ContractCaller, contract, wallet common.Address) (*big.Int, error) {
+	opt := &bind.CallOpts{Context: ctx}
+	caller, err := onft.NewStorageCaller(contract, cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "onft.NewStorageCaller")
+	}
+	balance, err := caller.BalanceOf(opt, wallet)
+	if err != nil {
+		return nil, errors.Wrap(err, "onft.BalanceOf")
+	}
+	if balance.Sign() == 0 {
+		return nil, errors.New("wallet has no nft of " + contract.String())
+	}
+	id, err := caller.TokenOfOwnerByIndex(opt, wallet, new(big.Int).Sub(balance, big.NewInt(1)))
+	if err != nil {
+		return nil, errors.Wrap(err, "nft is not enumerable, specify token id")
+	}
+	return id, nil
+}
+
+// MakeSendTx quotes estimateSendFee and packs sendFrom of an oft or onft contract
+func MakeSendTx(ctx context.Context, cli bind.ContractCaller, req *SendReq) (*SendTx, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	if req.DstChainId == 0 {
+		return nil, errors.New("destination chain id is not specified")
+	}
+
+	toAddress := req.Wallet.Bytes()
+	result := &SendTx{}
+
+	var fee *big.Int
+	var data []byte
+
+	switch req.Kind {
+	case v1.LayerZeroContract_ONFT:
+		if req.TokenId == nil {
+			return nil, errors.New("nft id is not specified")
+		}
+		caller, err := onft.NewStorageCaller(req.Contract, cli)
+		if err != nil {
+			return nil, errors.Wrap(err, "onft.NewStorageCaller")
+		}
+		owner, err := caller.OwnerOf(opt, req.TokenId)
+		if err != nil {
+			return nil, errors.Wrap(err, "onft.OwnerOf")
+		}
+		if owner != req.Wallet {
+			return nil, errors.New("nft " + req.TokenId.String() + " is not owned by the wallet")
+		}
+		lookup, lookupErr := caller.MinDstGasLookup(opt, req.DstChainId, packetTypeSend)
+		adapterParams, err := MakeLayerZeroAdapterParams(1, dstGas(lookup, lookupErr, onftDstGas))
+		if err != nil {
+			return nil, err
+		}
+		f, err := caller.EstimateSendFee(opt, req.DstChainId, toAddress, req.TokenId, false, adapterParams)
+		if err != nil {
+			return nil, errors.Wrap(err, "onft.EstimateSendFee")
+		}
+		fee = boostFee(f.NativeFee)
+
+		a, err := onft.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err = a.Pack("sendFrom", req.Wallet, req.DstChainId, toAddress, req.TokenId, req.Wallet, bozdo.ZEROADDR, adapterParams)
+		if err != nil {
+			return nil, err
+		}
+	case v1.LayerZeroContract_OFT:
+		if req.Amount == nil || req.Amount.Sign() == 0 {
+			return nil, errors.New("zero amount")
+		}
+		caller, err := oft.NewStorageCaller(req.Contract, cli)
+		if err != nil {
+			return nil, errors.Wrap(err, "oft.NewStorageCaller")
+		}
+		lookup, lookupErr := caller.MinDstGasLookup(opt, req.DstChainId, packetTypeSend)
+		adapterParams, err := MakeLayerZeroAdapterParams(1, dstGas(lookup, lookupErr, oftDstGas))
+		if err != nil {
+			return nil, err
+		}
+		f, err := caller.EstimateSendFee(opt, req.DstChainId, toAddress, req.Amount, false, adapterParams)
+		if err != nil {
+			return nil, errors.Wrap(err, "oft.EstimateSendFee")
+		}
+		fee = boostFee(f.NativeFee)
+
+		a, err := oft.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err = a.Pack("sendFrom", req.Wallet, req.DstChainId, toAddress, req.Amount, req.Wallet, bozdo.ZEROADDR, adapterParams)
+		if err != nil {
+			return nil, err
+		}
+	case v1.LayerZeroContract_OFTV2:
+		if req.Amount == nil || req.Amount.Sign() == 0 {
+			return nil, errors.New("zero amount")
+		}
+		caller, err := oftv2.NewStorageCaller(req.Contract, cli)
+		if err != nil {
+			return nil, errors.Wrap(err, "oftv2.NewStorageCaller")
+		}
+		lookup, lookupErr := caller.MinDstGasLookup(opt, req.DstChainId, packetTypeSend)
+		adapterParams, err := MakeLayerZeroAdapterParams(1, dstGas(lookup, lookupErr, oftDstGas))
+		if err != nil {
+			return nil, err
+		}
+		to := common.BytesToHash(toAddress)
+		f, err := caller.EstimateSendFee(opt, req.DstChainId, to, req.Amount, false, adapterParams)
+		if err != nil {
+			return nil, errors.Wrap(err, "oftv2.EstimateSendFee")
+		}
+		fee = boostFee(f.NativeFee)
+
+		a, err := oftv2.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err = a.Pack("sendFrom", req.Wallet, req.DstChainId, to, req.Amount, oftv2.ICommonOFTLzCallParams{
+			RefundAddress:     req.Wallet,
+			ZroPaymentAddress: bozdo.ZEROADDR,
+			AdapterParams:     adapterParams,
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unsupported layerzero contract: " + req.Kind.String())
+	}
+
+	if req.Kind != v1.LayerZeroContract_ONFT {
+		token, err := OFTToken(ctx, cli, req.Kind, req.Contract)
+		if err != nil {
+			return nil, err
+		}
+		if token != req.Contract {
+			result.Approve = &SendApprove{Token: token, Spender: req.Contract, Amount: req.Amount}
+		}
+	}
+
+	result.TxData = bozdo.TxData{
+		Data:         data,
+		Value:        fee,
+		ContractAddr: req.Contract,
+		Details:      []bozdo.TxDetail{bozdo.NewProtocolFeeDetails(fee, req.Network, req.MainToken)},
+	}
+
+	return result, nil
+}
+
+// dstGas destination gas configured by the contract owner or the default one
+func dstGas(configured *big.Int, err error, def *big.Int) *big.Int {
+	if err != nil || configured == nil || configured.Cmp(def) < 0 {
+		return def
+	}
+	return configured
+}
+
+func boostFee(fee *big.Int) *big.Int {
+	return bozdo.BigIntSum(fee, bozdo.Percent(fee, LayerZeroBoostPercent))
+}
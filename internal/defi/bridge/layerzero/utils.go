@@ -24,8 +24,10 @@ var LayerZeroChainMap = map[v1.Network]uint16{
 	v1.Network_OPTIMISM:    111,
 	v1.Network_GOERLIETH:   154,
 	v1.Network_ZKSYNCERA:   165,
+	v1.Network_Metis:       151,
+	v1.Network_Linea:       183,
+	v1.Network_Base:        184,
 	//SBNameFantom:        112,
-	//SBNameMetis:         151,
 }
 
 func MakeLayerZeroAdapterParams(a uint16, b *big.Int) ([]byte, error) {
@@ -0,0 +1,187 @@
+package synapse
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/bridge"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// docs https://docs.synapseprotocol.com/developers/rest-api
+
+const DefaultHost = "https://api.synapseprotocol.com"
+
+// Router SynapseRouter the bridge txs of the api go through, the spender of the bridged erc20
+var Router = map[v1.Network]common.Address{
+	v1.Network_Etherium:    common.HexToAddress("0x7E7A0e201FD38d3ADAA9523Da6C109a07118C96a"),
+	v1.Network_OPTIMISM:    common.HexToAddress("0x7E7A0e201FD38d3ADAA9523Da6C109a07118C96a"),
+	v1.Network_BinanaceBNB: common.HexToAddress("0x7E7A0e201FD38d3ADAA9523Da6C109a07118C96a"),
+	v1.Network_POLIGON:     common.HexToAddress("0x7E7A0e201FD38d3ADAA9523Da6C109a07118C96a"),
+	v1.Network_ARBITRUM:    common.HexToAddress("0x7E7A0e201FD38d3ADAA9523Da6C109a07118C96a"),
+	v1.Network_AVALANCHE:   common.HexToAddress("0x7E7A0e201FD38d3ADAA9523Da6C109a07118C96a"),
+	v1.Network_Base:        common.HexToAddress("0x7E7A0e201FD38d3ADAA9523Da6C109a07118C96a"),
+}
+
+type Config struct {
+	Host string
+}
+
+type Service struct {
+	cli *http.Client
+	c   *Config
+}
+
+func NewService(c *Config) *Service {
+	if c.Host == "" {
+		c.Host = DefaultHost
+	}
+	return &Service{
+		cli: &http.Client{},
+		c:   c,
+	}
+}
+
+type Quote struct {
+	MaxAmountOutStr    string `json:"maxAmountOutStr"`
+	BridgeFeeFormatted string `json:"bridgeFeeFormatted"`
+	RouterAddress      string `json:"routerAddress"`
+}
+
+type TxInfo struct {
+	Data  string `json:"data"`
+	To    string `json:"to"`
+	Value Value  `json:"value"`
+}
+
+// Value tx value, the api returns either a decimal string or an ethers BigNumber object
+type Value struct {
+	*big.Int
+}
+
+func (v *Value) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		var n struct {
+			Hex string `json:"hex"`
+		}
+		if err := json.Unmarshal(b, &n); err != nil {
+			return err
+		}
+		s = n.Hex
+	}
+
+	if s == "" {
+		v.Int = big.NewInt(0)
+		return nil
+	}
+	if strings.HasPrefix(s, "0x") {
+		i, err := hexutil.DecodeBig(s)
+		if err != nil {
+			return err
+		}
+		v.Int = i
+		return nil
+	}
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return errors.New("invalid synapse tx value: " + s)
+	}
+	v.Int = i
+	return nil
+}
+
+func (s *Service) params(req *bridge.Req) (url.Values, error) {
+	from, to, err := req.Chains()
+	if err != nil {
+		return nil, err
+	}
+	q := url.Values{}
+	q.Set("fromChain", strconv.FormatInt(from, 10))
+	q.Set("toChain", strconv.FormatInt(to, 10))
+	q.Set("fromToken", req.Token.String())
+	q.Set("toToken", req.Token.String())
+	q.Set("amount", req.AmountToken)
+	return q, nil
+}
+
+func (s *Service) Quote(ctx context.Context, req *bridge.Req) (*Quote, error) {
+	q, err := s.params(req)
+	if err != nil {
+		return nil, err
+	}
+	var res Quote
+	if err := bridge.GetJSON(ctx, s.cli, s.c.Host+"/bridge?"+q.Encode(), &res); err != nil {
+		return nil, errors.Wrap(err, "synapse bridge quote")
+	}
+	return &res, nil
+}
+
+// MakeTx takes the calldata built by the api for the best route
+func (s *Service) MakeTx(ctx context.Context, req *bridge.Req) (*bridge.Tx, error) {
+	quote, err := s.Quote(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if quote.MaxAmountOutStr == "" {
+		return nil, errors.New("synapse has no route for " + req.Token.String() + " " + req.From.String() + " -> " + req.To.String())
+	}
+
+	q, err := s.params(req)
+	if err != nil {
+		return nil, err
+	}
+	q.Set("destAddress", req.Wallet.String())
+
+	var infos []TxInfo
+	if err := bridge.GetJSON(ctx, s.cli, s.c.Host+"/bridgeTxInfo?"+q.Encode(), &infos); err != nil {
+		return nil, errors.Wrap(err, "synapse bridgeTxInfo")
+	}
+	if len(infos) == 0 {
+		return nil, errors.New("synapse returned no bridge tx")
+	}
+	info := infos[0]
+
+	data, err := hexutil.Decode(info.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid synapse tx data")
+	}
+	if !common.IsHexAddress(info.To) {
+		return nil, errors.New("invalid synapse tx address: " + info.To)
+	}
+	contract := common.HexToAddress(info.To)
+	// the api host is configurable, the tx is sent and the token approved only to the known router
+	router, ok := Router[req.From]
+	if !ok {
+		return nil, errors.New("synapse router is unknown in network: " + req.From.String())
+	}
+	if contract != router {
+		return nil, errors.New("synapse tx address is not the router: " + info.To)
+	}
+
+	value := info.Value.Int
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	tx := &bridge.Tx{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        value,
+			ContractAddr: contract,
+		},
+	}
+	if !req.Native {
+		tx.Spender = contract
+	}
+	return tx, nil
+}
@@ -0,0 +1,193 @@
+package defi
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+type TxOutcome int
+
+const (
+	TxMinedSuccess TxOutcome = iota
+	TxMinedReverted
+	// TxDropped the node does not know the tx and its nonce is not used
+	TxDropped
+	// TxReplaced another tx of the wallet with the same nonce is mined
+	TxReplaced
+)
+
+// defaultConfirmations blocks on top of the tx block it is not reorged out after
+var defaultConfirmations = map[v1.Network]uint64{
+	v1.Network_Etherium:    3,
+	v1.Network_GOERLIETH:   1,
+	v1.Network_POLIGON:     32,
+	v1.Network_BinanaceBNB: 5,
+	v1.Network_AVALANCHE:   1,
+	v1.Network_ARBITRUM:    1,
+	v1.Network_OPTIMISM:    1,
+	v1.Network_Base:        1,
+	v1.Network_Linea:       1,
+	v1.Network_Metis:       1,
+}
+
+const confirmPollInterval = 10 * time.Second
+
+// txDropMisses consecutive checks the node does not know the tx before it is dropped, the lagging endpoint of the
+// pool does not know the fresh tx yet
+const txDropMisses = 3
+
+type TxConfirmation struct {
+	Outcome TxOutcome
+	// Receipt of the mined tx, nil if it is dropped or replaced
+	Receipt *types.Receipt
+}
+
+// TxTrack what is known of the tracked tx. The caller keeps it between the waits, so the replaced tx is told from
+// the dropped one after the restart too
+type TxTrack struct {
+	// Sender and Nonce of the tx, set once the node returns the tx
+	Sender *common.Address
+	Nonce  *uint64
+	// Confirmations blocks on top of the tx block, the default of the network if zero
+	Confirmations uint64
+}
+
+// confirmations the tx of the network is final after
+func (c *EtheriumClient) confirmations(track *TxTrack) uint64 {
+	if track.Confirmations > 0 {
+		return track.Confirmations
+	}
+	if n, ok := defaultConfirmations[c.Cfg.Network]; ok {
+		return n
+	}
+	return 1
+}
+
+// TrackTx waits for the tx to be mined and confirmed. The receipt is checked again once the confirmations are
+// there, the tx reorged out of its block is waited for again. ErrTxPending is returned if the context ends while
+// the tx is pending, ErrTxNotConfirmed if it ends while the tx is mined but not confirmed
+func (c *EtheriumClient) TrackTx(ctx context.Context, txId common.Hash, track *TxTrack) (*TxConfirmation, error) {
+	if track == nil {
+		track = &TxTrack{}
+	}
+	ticker := time.NewTicker(confirmPollInterval)
+	defer ticker.Stop()
+
+	waitErr := ErrTxPending
+	misses := 0
+	for {
+		res, err := c.checkTx(ctx, txId, track)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, waitErr
+			}
+			return nil, err
+		}
+		if res.outcome != nil {
+			return res.outcome, nil
+		}
+		if res.lost {
+			misses++
+			if misses >= txDropMisses {
+				return &TxConfirmation{Outcome: TxDropped}, nil
+			}
+		} else {
+			misses = 0
+		}
+		waitErr = ErrTxPending
+		if res.mined {
+			waitErr = ErrTxNotConfirmed
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, waitErr
+		case <-ticker.C:
+		}
+	}
+}
+
+type txCheck struct {
+	// outcome of the tx, nil if it is not final yet
+	outcome *TxConfirmation
+	mined   bool
+	// lost the node does not know the tx
+	lost bool
+}
+
+func (c *EtheriumClient) checkTx(ctx context.Context, txId common.Hash, track *TxTrack) (*txCheck, error) {
+	tx, isPending, err := c.Cli.TransactionByHash(ctx, txId)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return c.lostTx(ctx, track)
+		}
+		return nil, errors.Wrap(err, "TransactionByHash")
+	}
+
+	if track.Sender == nil {
+		from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err == nil {
+			nonce := tx.Nonce()
+			track.Sender = &from
+			track.Nonce = &nonce
+		}
+	}
+
+	if isPending {
+		return &txCheck{}, nil
+	}
+
+	rec, err := c.Cli.TransactionReceipt(ctx, txId)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return &txCheck{}, nil
+		}
+		return nil, errors.Wrap(err, "TransactionReceipt")
+	}
+
+	head, err := c.Cli.BlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "BlockNumber")
+	}
+	if rec.BlockNumber.Uint64()+c.confirmations(track) > head+1 {
+		return &txCheck{mined: true}, nil
+	}
+
+	// the block of the receipt is still the one of the chain
+	block, err := c.Cli.HeaderByNumber(ctx, rec.BlockNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "HeaderByNumber")
+	}
+	if block.Hash() != rec.BlockHash {
+		return &txCheck{}, nil
+	}
+
+	outcome := TxMinedSuccess
+	if rec.Status == types.ReceiptStatusFailed {
+		outcome = TxMinedReverted
+	}
+	return &txCheck{outcome: &TxConfirmation{Outcome: outcome, Receipt: rec}}, nil
+}
+
+// lostTx the tx the node does not know is replaced if its nonce is used by the mined tx, it is lost otherwise and
+// is dropped once it stays lost
+func (c *EtheriumClient) lostTx(ctx context.Context, track *TxTrack) (*txCheck, error) {
+	if track.Sender == nil || track.Nonce == nil {
+		return &txCheck{lost: true}, nil
+	}
+
+	mined, err := c.Cli.NonceAt(ctx, *track.Sender, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "NonceAt")
+	}
+	if mined > *track.Nonce {
+		return &txCheck{outcome: &TxConfirmation{Outcome: TxReplaced}}, nil
+	}
+	return &txCheck{lost: true}, nil
+}
@@ -0,0 +1,94 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/nonce"
+	"github.com/pkg/errors"
+)
+
+type contractCall struct {
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// sendContractCall estimates the call and, unless estimateOnly, signs and sends it
+func (c *EtheriumClient) sendContractCall(ctx context.Context, tr *WalletTransactor, call *contractCall, gas *bozdo.Gas, estimateOnly bool) (*bozdo.EstimatedGasCost, *types.Transaction, error) {
+
+	gasPrice, err := c.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	fee, err := c.SuggestDynamicFee(ctx, gas.Speed())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	estimate, err := c.Cli.EstimateGas(ctx, ethereum.CallMsg{
+		From:  tr.WalletAddr,
+		To:    &call.To,
+		Value: call.Value,
+		Data:  call.Data,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(EstimateError(err), "EstimateGas")
+	}
+
+	e := estimatedGasCost(estimate, gasPrice, fee)
+
+	if estimateOnly {
+		return e, nil, nil
+	}
+
+	if gas.RuleSet() {
+		estimate = gas.GasLimit.Uint64()
+		gasPrice = &gas.GasPrice
+	}
+
+	reserved, err := nonce.Default.Reserve(ctx, c.Cfg.Network, tr.WalletAddr, c.Cli)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reserved.Done(errNotSent)
+
+	legacy := &types.LegacyTx{
+		Nonce:    reserved.Nonce,
+		GasPrice: gasPrice,
+		Gas:      estimate,
+		To:       &call.To,
+		Value:    call.Value,
+		Data:     call.Data,
+	}
+	tx := types.NewTx(legacy)
+	if fee != nil {
+		tx = dynamicFeeTx(c.Cfg.networkId, fee, gas, legacy)
+	}
+
+	if err := c.Simulate(ctx, CallMsg(tr.WalletAddr, tx)); err != nil {
+		return nil, nil, err
+	}
+
+	opt, err := tr.TransactOpts(ctx, c.Cfg.networkId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "TransactOpts")
+	}
+
+	tx, err = opt.Signer(tr.WalletAddr, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = c.Cli.SendTransaction(ctx, tx)
+	reserved.Done(err)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return e, tx, nil
+}
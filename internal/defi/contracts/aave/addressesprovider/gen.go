@@ -0,0 +1,4 @@
+package addressesprovider
+
+// https://arbiscan.io/address/0xa97684ead0e402dC232d5A977953DF7ECBaB3CDb#code
+//go:generate abigen --abi abi.json --pkg addressesprovider --type storage --out storage.go
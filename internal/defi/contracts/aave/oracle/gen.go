@@ -0,0 +1,4 @@
+package oracle
+
+// https://arbiscan.io/address/0xb56c2F0B653B2e0b10C9b928C8580Ac5Df02C7C7#code
+//go:generate abigen --abi abi.json --pkg oracle --type storage --out storage.go
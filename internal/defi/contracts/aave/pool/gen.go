@@ -0,0 +1,4 @@
+package pool
+
+// https://arbiscan.io/address/0x794a61358D6845594F94dc1DB02A252b5b4814aD#code
+//go:generate abigen --abi abi.json --pkg pool --type storage --out storage.go
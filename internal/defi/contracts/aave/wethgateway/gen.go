@@ -0,0 +1,4 @@
+package wethgateway
+
+// https://arbiscan.io/address/0xB5Ee21786D28c5Ba61661550879475976B707099#code
+//go:generate abigen --abi abi.json --pkg wethgateway --type storage --out storage.go
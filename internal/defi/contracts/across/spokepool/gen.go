@@ -0,0 +1,5 @@
+package spokepool
+
+// https://docs.across.to/reference/contract-addresses
+// legacy deposit kept by the v3 spoke pool, the output token is resolved to the equivalent of the origin one
+//go:generate abigen --abi abi.json --pkg spokepool --type storage --out storage.go
@@ -0,0 +1,4 @@
+package arbsys
+
+// ArbSys precompile 0x0000000000000000000000000000000000000064 of arbitrum
+//go:generate abigen --abi abi.json --pkg arbsys --type storage --out storage.go
@@ -0,0 +1,5 @@
+package inbox
+
+// https://docs.arbitrum.io/build-decentralized-apps/reference/useful-addresses
+// delayed inbox of arbitrum one on ethereum, depositEth credits the sender on l2
+//go:generate abigen --abi abi.json --pkg inbox --type storage --out storage.go
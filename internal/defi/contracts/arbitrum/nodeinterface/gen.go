@@ -0,0 +1,4 @@
+package nodeinterface
+
+// NodeInterface virtual contract 0x00000000000000000000000000000000000000C8, only callable through eth_call of arbitrum rpc
+//go:generate abigen --abi abi.json --pkg nodeinterface --type storage --out storage.go
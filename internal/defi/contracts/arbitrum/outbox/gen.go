@@ -0,0 +1,4 @@
+package outbox
+
+// outbox of arbitrum one on ethereum, executes l2 to l1 messages of the confirmed assertions
+//go:generate abigen --abi abi.json --pkg outbox --type storage --out storage.go
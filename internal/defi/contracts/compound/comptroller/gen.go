@@ -0,0 +1,4 @@
+package comptroller
+
+// https://explorer.zksync.io/address/0x0171cA5b372eb510245F5FA214F5582911934b3D#contract
+//go:generate abigen --abi abi.json --pkg comptroller --type storage --out storage.go
@@ -0,0 +1,4 @@
+package ctoken
+
+// https://explorer.zksync.io/address/0x1181D7BE04D80A8aE096641Ee1A87f7D557c6aeb#contract
+//go:generate abigen --abi abi.json --pkg ctoken --type storage --out storage.go
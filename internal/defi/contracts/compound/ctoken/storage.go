@@ -0,0 +1,358 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ctoken
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// StorageMetaData contains all meta data concerning the Storage contract.
+var StorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"exchangeRateStored\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"mintAmount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"redeemTokens\",\"type\":\"uint256\"}],\"name\":\"redeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"redeemAmount\",\"type\":\"uint256\"}],\"name\":\"redeemUnderlying\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"underlying\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// StorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use StorageMetaData.ABI instead.
+var StorageABI = StorageMetaData.ABI
+
+// Storage is an auto generated Go binding around an Ethereum contract.
+type Storage struct {
+	StorageCaller     // Read-only binding to the contract
+	StorageTransactor // Write-only binding to the contract
+	StorageFilterer   // Log filterer for contract events
+}
+
+// StorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StorageSession struct {
+	Contract     *Storage          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StorageCallerSession struct {
+	Contract *StorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StorageTransactorSession struct {
+	Contract     *StorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StorageRaw struct {
+	Contract *Storage // Generic contract binding to access the raw methods on
+}
+
+// StorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StorageCallerRaw struct {
+	Contract *StorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StorageTransactorRaw struct {
+	Contract *StorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStorage creates a new instance of Storage, bound to a specific deployed contract.
+func NewStorage(address common.Address, backend bind.ContractBackend) (*Storage, error) {
+	contract, err := bindStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{StorageCaller: StorageCaller{contract: contract}, StorageTransactor: StorageTransactor{contract: contract}, StorageFilterer: StorageFilterer{contract: contract}}, nil
+}
+
+// NewStorageCaller creates a new read-only instance of Storage, bound to a specific deployed contract.
+func NewStorageCaller(address common.Address, caller bind.ContractCaller) (*StorageCaller, error) {
+	contract, err := bindStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageCaller{contract: contract}, nil
+}
+
+// NewStorageTransactor creates a new write-only instance of Storage, bound to a specific deployed contract.
+func NewStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*StorageTransactor, error) {
+	contract, err := bindStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageTransactor{contract: contract}, nil
+}
+
+// NewStorageFilterer creates a new log filterer instance of Storage, bound to a specific deployed contract.
+func NewStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*StorageFilterer, error) {
+	contract, err := bindStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageFilterer{contract: contract}, nil
+}
+
+// bindStorage binds a generic wrapper to an already deployed contract.
+func bindStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.StorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_Storage *StorageCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_Storage *StorageSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _Storage.Contract.BalanceOf(&_Storage.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_Storage *StorageCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _Storage.Contract.BalanceOf(&_Storage.CallOpts, owner)
+}
+
+// ExchangeRateStored is a free data retrieval call binding the contract method 0x182df0f5.
+//
+// Solidity: function exchangeRateStored() view returns(uint256)
+func (_Storage *StorageCaller) ExchangeRateStored(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "exchangeRateStored")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExchangeRateStored is a free data retrieval call binding the contract method 0x182df0f5.
+//
+// Solidity: function exchangeRateStored() view returns(uint256)
+func (_Storage *StorageSession) ExchangeRateStored() (*big.Int, error) {
+	return _Storage.Contract.ExchangeRateStored(&_Storage.CallOpts)
+}
+
+// ExchangeRateStored is a free data retrieval call binding the contract method 0x182df0f5.
+//
+// Solidity: function exchangeRateStored() view returns(uint256)
+func (_Storage *StorageCallerSession) ExchangeRateStored() (*big.Int, error) {
+	return _Storage.Contract.ExchangeRateStored(&_Storage.CallOpts)
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_Storage *StorageCaller) Underlying(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "underlying")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_Storage *StorageSession) Underlying() (common.Address, error) {
+	return _Storage.Contract.Underlying(&_Storage.CallOpts)
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_Storage *StorageCallerSession) Underlying() (common.Address, error) {
+	return _Storage.Contract.Underlying(&_Storage.CallOpts)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() payable returns()
+func (_Storage *StorageTransactor) Mint(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "mint")
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() payable returns()
+func (_Storage *StorageSession) Mint() (*types.Transaction, error) {
+	return _Storage.Contract.Mint(&_Storage.TransactOpts)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() payable returns()
+func (_Storage *StorageTransactorSession) Mint() (*types.Transaction, error) {
+	return _Storage.Contract.Mint(&_Storage.TransactOpts)
+}
+
+// Mint0 is a paid mutator transaction binding the contract method 0xa0712d68.
+//
+// Solidity: function mint(uint256 mintAmount) returns(uint256)
+func (_Storage *StorageTransactor) Mint0(opts *bind.TransactOpts, mintAmount *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "mint0", mintAmount)
+}
+
+// Mint0 is a paid mutator transaction binding the contract method 0xa0712d68.
+//
+// Solidity: function mint(uint256 mintAmount) returns(uint256)
+func (_Storage *StorageSession) Mint0(mintAmount *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Mint0(&_Storage.TransactOpts, mintAmount)
+}
+
+// Mint0 is a paid mutator transaction binding the contract method 0xa0712d68.
+//
+// Solidity: function mint(uint256 mintAmount) returns(uint256)
+func (_Storage *StorageTransactorSession) Mint0(mintAmount *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Mint0(&_Storage.TransactOpts, mintAmount)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xdb006a75.
+//
+// Solidity: function redeem(uint256 redeemTokens) returns(uint256)
+func (_Storage *StorageTransactor) Redeem(opts *bind.TransactOpts, redeemTokens *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "redeem", redeemTokens)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xdb006a75.
+//
+// Solidity: function redeem(uint256 redeemTokens) returns(uint256)
+func (_Storage *StorageSession) Redeem(redeemTokens *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Redeem(&_Storage.TransactOpts, redeemTokens)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xdb006a75.
+//
+// Solidity: function redeem(uint256 redeemTokens) returns(uint256)
+func (_Storage *StorageTransactorSession) Redeem(redeemTokens *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Redeem(&_Storage.TransactOpts, redeemTokens)
+}
+
+// RedeemUnderlying is a paid mutator transaction binding the contract method 0x852a12e3.
+//
+// Solidity: function redeemUnderlying(uint256 redeemAmount) returns(uint256)
+func (_Storage *StorageTransactor) RedeemUnderlying(opts *bind.TransactOpts, redeemAmount *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "redeemUnderlying", redeemAmount)
+}
+
+// RedeemUnderlying is a paid mutator transaction binding the contract method 0x852a12e3.
+//
+// Solidity: function redeemUnderlying(uint256 redeemAmount) returns(uint256)
+func (_Storage *StorageSession) RedeemUnderlying(redeemAmount *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.RedeemUnderlying(&_Storage.TransactOpts, redeemAmount)
+}
+
+// RedeemUnderlying is a paid mutator transaction binding the contract method 0x852a12e3.
+//
+// Solidity: function redeemUnderlying(uint256 redeemAmount) returns(uint256)
+func (_Storage *StorageTransactorSession) RedeemUnderlying(redeemAmount *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.RedeemUnderlying(&_Storage.TransactOpts, redeemAmount)
+}
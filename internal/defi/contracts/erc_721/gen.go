@@ -0,0 +1,4 @@
+package erc_721
+
+// https://eips.ethereum.org/EIPS/eip-721
+//go:generate abigen --abi abi.json --pkg erc_721 --type storage --out storage.go
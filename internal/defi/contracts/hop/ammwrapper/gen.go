@@ -0,0 +1,4 @@
+package ammwrapper
+
+// https://github.com/hop-protocol/contracts/blob/master/contracts/bridges/L2_AmmWrapper.sol
+//go:generate abigen --abi abi.json --pkg ammwrapper --type storage --out storage.go
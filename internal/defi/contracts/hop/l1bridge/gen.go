@@ -0,0 +1,4 @@
+package l1bridge
+
+// https://github.com/hop-protocol/contracts/blob/master/contracts/bridges/L1_Bridge.sol
+//go:generate abigen --abi abi.json --pkg l1bridge --type storage --out storage.go
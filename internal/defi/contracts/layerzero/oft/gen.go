@@ -0,0 +1,4 @@
+package oft
+
+// https://github.com/LayerZero-Labs/solidity-examples/blob/main/contracts/token/oft/v1/OFT.sol
+//go:generate abigen --abi abi.json --pkg oft --type storage --out storage.go
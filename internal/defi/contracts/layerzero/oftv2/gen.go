@@ -0,0 +1,4 @@
+package oftv2
+
+// https://github.com/LayerZero-Labs/solidity-examples/blob/main/contracts/token/oft/v2/OFTV2.sol
+//go:generate abigen --abi abi.json --pkg oftv2 --type storage --out storage.go
@@ -0,0 +1,347 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package oftv2
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ICommonOFTLzCallParams is an auto generated low-level Go binding around an user-defined struct.
+type ICommonOFTLzCallParams struct {
+	RefundAddress     common.Address
+	ZroPaymentAddress common.Address
+	AdapterParams     []byte
+}
+
+// StorageMetaData contains all meta data concerning the Storage contract.
+var StorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"uint16\",\"name\":\"_dstChainId\",\"type\":\"uint16\"},{\"internalType\":\"bytes32\",\"name\":\"_toAddress\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_useZro\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"_adapterParams\",\"type\":\"bytes\"}],\"name\":\"estimateSendFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"nativeFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"zroFee\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"name\":\"minDstGasLookup\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"uint16\",\"name\":\"_dstChainId\",\"type\":\"uint16\"},{\"internalType\":\"bytes32\",\"name\":\"_toAddress\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"refundAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"zroPaymentAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"adapterParams\",\"type\":\"bytes\"}],\"internalType\":\"structICommonOFT.LzCallParams\",\"name\":\"_callParams\",\"type\":\"tuple\"}],\"name\":\"sendFrom\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sharedDecimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"token\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// StorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use StorageMetaData.ABI instead.
+var StorageABI = StorageMetaData.ABI
+
+// Storage is an auto generated Go binding around an Ethereum contract.
+type Storage struct {
+	StorageCaller     // Read-only binding to the contract
+	StorageTransactor // Write-only binding to the contract
+	StorageFilterer   // Log filterer for contract events
+}
+
+// StorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StorageSession struct {
+	Contract     *Storage          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StorageCallerSession struct {
+	Contract *StorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StorageTransactorSession struct {
+	Contract     *StorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StorageRaw struct {
+	Contract *Storage // Generic contract binding to access the raw methods on
+}
+
+// StorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StorageCallerRaw struct {
+	Contract *StorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StorageTransactorRaw struct {
+	Contract *StorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStorage creates a new instance of Storage, bound to a specific deployed contract.
+func NewStorage(address common.Address, backend bind.ContractBackend) (*Storage, error) {
+	contract, err := bindStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{StorageCaller: StorageCaller{contract: contract}, StorageTransactor: StorageTransactor{contract: contract}, StorageFilterer: StorageFilterer{contract: contract}}, nil
+}
+
+// NewStorageCaller creates a new read-only instance of Storage, bound to a specific deployed contract.
+func NewStorageCaller(address common.Address, caller bind.ContractCaller) (*StorageCaller, error) {
+	contract, err := bindStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageCaller{contract: contract}, nil
+}
+
+// NewStorageTransactor creates a new write-only instance of Storage, bound to a specific deployed contract.
+func NewStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*StorageTransactor, error) {
+	contract, err := bindStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageTransactor{contract: contract}, nil
+}
+
+// NewStorageFilterer creates a new log filterer instance of Storage, bound to a specific deployed contract.
+func NewStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*StorageFilterer, error) {
+	contract, err := bindStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageFilterer{contract: contract}, nil
+}
+
+// bindStorage binds a generic wrapper to an already deployed contract.
+func bindStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.StorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transact(opts, method, params...)
+}
+
+// EstimateSendFee is a free data retrieval call binding the contract method 0x365260b4.
+//
+// Solidity: function estimateSendFee(uint16 _dstChainId, bytes32 _toAddress, uint256 _amount, bool _useZro, bytes _adapterParams) view returns(uint256 nativeFee, uint256 zroFee)
+func (_Storage *StorageCaller) EstimateSendFee(opts *bind.CallOpts, _dstChainId uint16, _toAddress [32]byte, _amount *big.Int, _useZro bool, _adapterParams []byte) (struct {
+	NativeFee *big.Int
+	ZroFee    *big.Int
+}, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "estimateSendFee", _dstChainId, _toAddress, _amount, _useZro, _adapterParams)
+
+	outstruct := new(struct {
+		NativeFee *big.Int
+		ZroFee    *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.NativeFee = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.ZroFee = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// EstimateSendFee is a free data retrieval call binding the contract method 0x365260b4.
+//
+// Solidity: function estimateSendFee(uint16 _dstChainId, bytes32 _toAddress, uint256 _amount, bool _useZro, bytes _adapterParams) view returns(uint256 nativeFee, uint256 zroFee)
+func (_Storage *StorageSession) EstimateSendFee(_dstChainId uint16, _toAddress [32]byte, _amount *big.Int, _useZro bool, _adapterParams []byte) (struct {
+	NativeFee *big.Int
+	ZroFee    *big.Int
+}, error) {
+	return _Storage.Contract.EstimateSendFee(&_Storage.CallOpts, _dstChainId, _toAddress, _amount, _useZro, _adapterParams)
+}
+
+// EstimateSendFee is a free data retrieval call binding the contract method 0x365260b4.
+//
+// Solidity: function estimateSendFee(uint16 _dstChainId, bytes32 _toAddress, uint256 _amount, bool _useZro, bytes _adapterParams) view returns(uint256 nativeFee, uint256 zroFee)
+func (_Storage *StorageCallerSession) EstimateSendFee(_dstChainId uint16, _toAddress [32]byte, _amount *big.Int, _useZro bool, _adapterParams []byte) (struct {
+	NativeFee *big.Int
+	ZroFee    *big.Int
+}, error) {
+	return _Storage.Contract.EstimateSendFee(&_Storage.CallOpts, _dstChainId, _toAddress, _amount, _useZro, _adapterParams)
+}
+
+// MinDstGasLookup is a free data retrieval call binding the contract method 0x8cfd8f5c.
+//
+// Solidity: function minDstGasLookup(uint16 , uint16 ) view returns(uint256)
+func (_Storage *StorageCaller) MinDstGasLookup(opts *bind.CallOpts, arg0 uint16, arg1 uint16) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "minDstGasLookup", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinDstGasLookup is a free data retrieval call binding the contract method 0x8cfd8f5c.
+//
+// Solidity: function minDstGasLookup(uint16 , uint16 ) view returns(uint256)
+func (_Storage *StorageSession) MinDstGasLookup(arg0 uint16, arg1 uint16) (*big.Int, error) {
+	return _Storage.Contract.MinDstGasLookup(&_Storage.CallOpts, arg0, arg1)
+}
+
+// MinDstGasLookup is a free data retrieval call binding the contract method 0x8cfd8f5c.
+//
+// Solidity: function minDstGasLookup(uint16 , uint16 ) view returns(uint256)
+func (_Storage *StorageCallerSession) MinDstGasLookup(arg0 uint16, arg1 uint16) (*big.Int, error) {
+	return _Storage.Contract.MinDstGasLookup(&_Storage.CallOpts, arg0, arg1)
+}
+
+// SharedDecimals is a free data retrieval call binding the contract method 0x857749b0.
+//
+// Solidity: function sharedDecimals() view returns(uint8)
+func (_Storage *StorageCaller) SharedDecimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "sharedDecimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// SharedDecimals is a free data retrieval call binding the contract method 0x857749b0.
+//
+// Solidity: function sharedDecimals() view returns(uint8)
+func (_Storage *StorageSession) SharedDecimals() (uint8, error) {
+	return _Storage.Contract.SharedDecimals(&_Storage.CallOpts)
+}
+
+// SharedDecimals is a free data retrieval call binding the contract method 0x857749b0.
+//
+// Solidity: function sharedDecimals() view returns(uint8)
+func (_Storage *StorageCallerSession) SharedDecimals() (uint8, error) {
+	return _Storage.Contract.SharedDecimals(&_Storage.CallOpts)
+}
+
+// Token is a free data retrieval call binding the contract method 0xfc0c546a.
+//
+// Solidity: function token() view returns(address)
+func (_Storage *StorageCaller) Token(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "token")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Token is a free data retrieval call binding the contract method 0xfc0c546a.
+//
+// Solidity: function token() view returns(address)
+func (_Storage *StorageSession) Token() (common.Address, error) {
+	return _Storage.Contract.Token(&_Storage.CallOpts)
+}
+
+// Token is a free data retrieval call binding the contract method 0xfc0c546a.
+//
+// Solidity: function token() view returns(address)
+func (_Storage *StorageCallerSession) Token() (common.Address, error) {
+	return _Storage.Contract.Token(&_Storage.CallOpts)
+}
+
+// SendFrom is a paid mutator transaction binding the contract method 0x695ef6bf.
+//
+// Solidity: function sendFrom(address _from, uint16 _dstChainId, bytes32 _toAddress, uint256 _amount, (address,address,bytes) _callParams) payable returns()
+func (_Storage *StorageTransactor) SendFrom(opts *bind.TransactOpts, _from common.Address, _dstChainId uint16, _toAddress [32]byte, _amount *big.Int, _callParams ICommonOFTLzCallParams) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "sendFrom", _from, _dstChainId, _toAddress, _amount, _callParams)
+}
+
+// SendFrom is a paid mutator transaction binding the contract method 0x695ef6bf.
+//
+// Solidity: function sendFrom(address _from, uint16 _dstChainId, bytes32 _toAddress, uint256 _amount, (address,address,bytes) _callParams) payable returns()
+func (_Storage *StorageSession) SendFrom(_from common.Address, _dstChainId uint16, _toAddress [32]byte, _amount *big.Int, _callParams ICommonOFTLzCallParams) (*types.Transaction, error) {
+	return _Storage.Contract.SendFrom(&_Storage.TransactOpts, _from, _dstChainId, _toAddress, _amount, _callParams)
+}
+
+// SendFrom is a paid mutator transaction binding the contract method 0x695ef6bf.
+//
+// Solidity: function sendFrom(address _from, uint16 _dstChainId, bytes32 _toAddress, uint256 _amount, (address,address,bytes) _callParams) payable returns()
+func (_Storage *StorageTransactorSession) SendFrom(_from common.Address, _dstChainId uint16, _toAddress [32]byte, _amount *big.Int, _callParams ICommonOFTLzCallParams) (*types.Transaction, error) {
+	return _Storage.Contract.SendFrom(&_Storage.TransactOpts, _from, _dstChainId, _toAddress, _amount, _callParams)
+}
@@ -0,0 +1,4 @@
+package onft
+
+// https://github.com/LayerZero-Labs/solidity-examples/blob/main/contracts/token/onft721/ONFT721.sol
+//go:generate abigen --abi abi.json --pkg onft --type storage --out storage.go
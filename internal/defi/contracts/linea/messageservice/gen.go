@@ -0,0 +1,5 @@
+package messageservice
+
+// https://docs.linea.build/developers/quickstart/info-contracts
+// message service of linea, LineaRollup on ethereum and L2MessageService on linea share the sending and claiming part
+//go:generate abigen --abi abi.json --pkg messageservice --type storage --out storage.go
@@ -0,0 +1,764 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package messageservice
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IL1MessageServiceClaimMessageWithProofParams is an auto generated low-level Go binding around an user-defined struct.
+type IL1MessageServiceClaimMessageWithProofParams struct {
+	Proof         [][32]byte
+	MessageNumber *big.Int
+	LeafIndex     uint32
+	From          common.Address
+	To            common.Address
+	Fee           *big.Int
+	Value         *big.Int
+	FeeRecipient  common.Address
+	MerkleRoot    [32]byte
+	Data          []byte
+}
+
+// StorageMetaData contains all meta data concerning the Storage contract.
+var StorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_fee\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_calldata\",\"type\":\"bytes\"}],\"name\":\"sendMessage\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"minimumFeeInWei\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_messageNumber\",\"type\":\"uint256\"}],\"name\":\"isMessageClaimed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256\",\"name\":\"messageNumber\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"leafIndex\",\"type\":\"uint32\"},{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"feeRecipient\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"merkleRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structIL1MessageService.ClaimMessageWithProofParams\",\"name\":\"_params\",\"type\":\"tuple\"}],\"name\":\"claimMessageWithProof\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"_fee\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"_nonce\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"bytes\",\"name\":\"_calldata\",\"type\":\"bytes\",\"indexed\":false},{\"internalType\":\"bytes32\",\"name\":\"_messageHash\",\"type\":\"bytes32\",\"indexed\":true}],\"name\":\"MessageSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"l2Block\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"L2MessagingBlockAnchored\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"l2MerkleRoot\",\"type\":\"bytes32\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"treeDepth\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"L2MerkleRootAdded\",\"type\":\"event\"}]",
+}
+
+// StorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use StorageMetaData.ABI instead.
+var StorageABI = StorageMetaData.ABI
+
+// Storage is an auto generated Go binding around an Ethereum contract.
+type Storage struct {
+	StorageCaller     // Read-only binding to the contract
+	StorageTransactor // Write-only binding to the contract
+	StorageFilterer   // Log filterer for contract events
+}
+
+// StorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StorageSession struct {
+	Contract     *Storage          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StorageCallerSession struct {
+	Contract *StorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StorageTransactorSession struct {
+	Contract     *StorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StorageRaw struct {
+	Contract *Storage // Generic contract binding to access the raw methods on
+}
+
+// StorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StorageCallerRaw struct {
+	Contract *StorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StorageTransactorRaw struct {
+	Contract *StorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStorage creates a new instance of Storage, bound to a specific deployed contract.
+func NewStorage(address common.Address, backend bind.ContractBackend) (*Storage, error) {
+	contract, err := bindStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{StorageCaller: StorageCaller{contract: contract}, StorageTransactor: StorageTransactor{contract: contract}, StorageFilterer: StorageFilterer{contract: contract}}, nil
+}
+
+// NewStorageCaller creates a new read-only instance of Storage, bound to a specific deployed contract.
+func NewStorageCaller(address common.Address, caller bind.ContractCaller) (*StorageCaller, error) {
+	contract, err := bindStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageCaller{contract: contract}, nil
+}
+
+// NewStorageTransactor creates a new write-only instance of Storage, bound to a specific deployed contract.
+func NewStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*StorageTransactor, error) {
+	contract, err := bindStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageTransactor{contract: contract}, nil
+}
+
+// NewStorageFilterer creates a new log filterer instance of Storage, bound to a specific deployed contract.
+func NewStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*StorageFilterer, error) {
+	contract, err := bindStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageFilterer{contract: contract}, nil
+}
+
+// bindStorage binds a generic wrapper to an already deployed contract.
+func bindStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.StorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transact(opts, method, params...)
+}
+
+// IsMessageClaimed is a free data retrieval call binding the contract method 0x9ee8b211.
+//
+// Solidity: function isMessageClaimed(uint256 _messageNumber) view returns(bool)
+func (_Storage *StorageCaller) IsMessageClaimed(opts *bind.CallOpts, _messageNumber *big.Int) (bool, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "isMessageClaimed", _messageNumber)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsMessageClaimed is a free data retrieval call binding the contract method 0x9ee8b211.
+//
+// Solidity: function isMessageClaimed(uint256 _messageNumber) view returns(bool)
+func (_Storage *StorageSession) IsMessageClaimed(_messageNumber *big.Int) (bool, error) {
+	return _Storage.Contract.IsMessageClaimed(&_Storage.CallOpts, _messageNumber)
+}
+
+// IsMessageClaimed is a free data retrieval call binding the contract method 0x9ee8b211.
+//
+// Solidity: function isMessageClaimed(uint256 _messageNumber) view returns(bool)
+func (_Storage *StorageCallerSession) IsMessageClaimed(_messageNumber *big.Int) (bool, error) {
+	return _Storage.Contract.IsMessageClaimed(&_Storage.CallOpts, _messageNumber)
+}
+
+// MinimumFeeInWei is a free data retrieval call binding the contract method 0x89945883.
+//
+// Solidity: function minimumFeeInWei() view returns(uint256)
+func (_Storage *StorageCaller) MinimumFeeInWei(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "minimumFeeInWei")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinimumFeeInWei is a free data retrieval call binding the contract method 0x89945883.
+//
+// Solidity: function minimumFeeInWei() view returns(uint256)
+func (_Storage *StorageSession) MinimumFeeInWei() (*big.Int, error) {
+	return _Storage.Contract.MinimumFeeInWei(&_Storage.CallOpts)
+}
+
+// MinimumFeeInWei is a free data retrieval call binding the contract method 0x89945883.
+//
+// Solidity: function minimumFeeInWei() view returns(uint256)
+func (_Storage *StorageCallerSession) MinimumFeeInWei() (*big.Int, error) {
+	return _Storage.Contract.MinimumFeeInWei(&_Storage.CallOpts)
+}
+
+// ClaimMessageWithProof is a paid mutator transaction binding the contract method 0x6463fb2a.
+//
+// Solidity: function claimMessageWithProof((bytes32[],uint256,uint32,address,address,uint256,uint256,address,bytes32,bytes) _params) returns()
+func (_Storage *StorageTransactor) ClaimMessageWithProof(opts *bind.TransactOpts, _params IL1MessageServiceClaimMessageWithProofParams) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "claimMessageWithProof", _params)
+}
+
+// ClaimMessageWithProof is a paid mutator transaction binding the contract method 0x6463fb2a.
+//
+// Solidity: function claimMessageWithProof((bytes32[],uint256,uint32,address,address,uint256,uint256,address,bytes32,bytes) _params) returns()
+func (_Storage *StorageSession) ClaimMessageWithProof(_params IL1MessageServiceClaimMessageWithProofParams) (*types.Transaction, error) {
+	return _Storage.Contract.ClaimMessageWithProof(&_Storage.TransactOpts, _params)
+}
+
+// ClaimMessageWithProof is a paid mutator transaction binding the contract method 0x6463fb2a.
+//
+// Solidity: function claimMessageWithProof((bytes32[],uint256,uint32,address,address,uint256,uint256,address,bytes32,bytes) _params) returns()
+func (_Storage *StorageTransactorSession) ClaimMessageWithProof(_params IL1MessageServiceClaimMessageWithProofParams) (*types.Transaction, error) {
+	return _Storage.Contract.ClaimMessageWithProof(&_Storage.TransactOpts, _params)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x9f3ce55a.
+//
+// Solidity: function sendMessage(address _to, uint256 _fee, bytes _calldata) payable returns()
+func (_Storage *StorageTransactor) SendMessage(opts *bind.TransactOpts, _to common.Address, _fee *big.Int, _calldata []byte) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "sendMessage", _to, _fee, _calldata)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x9f3ce55a.
+//
+// Solidity: function sendMessage(address _to, uint256 _fee, bytes _calldata) payable returns()
+func (_Storage *StorageSession) SendMessage(_to common.Address, _fee *big.Int, _calldata []byte) (*types.Transaction, error) {
+	return _Storage.Contract.SendMessage(&_Storage.TransactOpts, _to, _fee, _calldata)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x9f3ce55a.
+//
+// Solidity: function sendMessage(address _to, uint256 _fee, bytes _calldata) payable returns()
+func (_Storage *StorageTransactorSession) SendMessage(_to common.Address, _fee *big.Int, _calldata []byte) (*types.Transaction, error) {
+	return _Storage.Contract.SendMessage(&_Storage.TransactOpts, _to, _fee, _calldata)
+}
+
+// StorageL2MerkleRootAddedIterator is returned from FilterL2MerkleRootAdded and is used to iterate over the raw logs and unpacked data for L2MerkleRootAdded events raised by the Storage contract.
+type StorageL2MerkleRootAddedIterator struct {
+	Event *StorageL2MerkleRootAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StorageL2MerkleRootAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StorageL2MerkleRootAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StorageL2MerkleRootAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StorageL2MerkleRootAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StorageL2MerkleRootAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StorageL2MerkleRootAdded represents a L2MerkleRootAdded event raised by the Storage contract.
+type StorageL2MerkleRootAdded struct {
+	L2MerkleRoot [32]byte
+	TreeDepth    *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterL2MerkleRootAdded is a free log retrieval operation binding the contract event 0x300e6f978eee6a4b0bba78dd8400dc64fd5652dbfc868a2258e16d0977be222b.
+//
+// Solidity: event L2MerkleRootAdded(bytes32 indexed l2MerkleRoot, uint256 indexed treeDepth)
+func (_Storage *StorageFilterer) FilterL2MerkleRootAdded(opts *bind.FilterOpts, l2MerkleRoot [][32]byte, treeDepth []*big.Int) (*StorageL2MerkleRootAddedIterator, error) {
+
+	var l2MerkleRootRule []interface{}
+	for _, l2MerkleRootItem := range l2MerkleRoot {
+		l2MerkleRootRule = append(l2MerkleRootRule, l2MerkleRootItem)
+	}
+	var treeDepthRule []interface{}
+	for _, treeDepthItem := range treeDepth {
+		treeDepthRule = append(treeDepthRule, treeDepthItem)
+	}
+
+	logs, sub, err := _Storage.contract.FilterLogs(opts, "L2MerkleRootAdded", l2MerkleRootRule, treeDepthRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageL2MerkleRootAddedIterator{contract: _Storage.contract, event: "L2MerkleRootAdded", logs: logs, sub: sub}, nil
+}
+
+// WatchL2MerkleRootAdded is a free log subscription operation binding the contract event 0x300e6f978eee6a4b0bba78dd8400dc64fd5652dbfc868a2258e16d0977be222b.
+//
+// Solidity: event L2MerkleRootAdded(bytes32 indexed l2MerkleRoot, uint256 indexed treeDepth)
+func (_Storage *StorageFilterer) WatchL2MerkleRootAdded(opts *bind.WatchOpts, sink chan<- *StorageL2MerkleRootAdded, l2MerkleRoot [][32]byte, treeDepth []*big.Int) (event.Subscription, error) {
+
+	var l2MerkleRootRule []interface{}
+	for _, l2MerkleRootItem := range l2MerkleRoot {
+		l2MerkleRootRule = append(l2MerkleRootRule, l2MerkleRootItem)
+	}
+	var treeDepthRule []interface{}
+	for _, treeDepthItem := range treeDepth {
+		treeDepthRule = append(treeDepthRule, treeDepthItem)
+	}
+
+	logs, sub, err := _Storage.contract.WatchLogs(opts, "L2MerkleRootAdded", l2MerkleRootRule, treeDepthRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StorageL2MerkleRootAdded)
+				if err := _Storage.contract.UnpackLog(event, "L2MerkleRootAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseL2MerkleRootAdded is a log parse operation binding the contract event 0x300e6f978eee6a4b0bba78dd8400dc64fd5652dbfc868a2258e16d0977be222b.
+//
+// Solidity: event L2MerkleRootAdded(bytes32 indexed l2MerkleRoot, uint256 indexed treeDepth)
+func (_Storage *StorageFilterer) ParseL2MerkleRootAdded(log types.Log) (*StorageL2MerkleRootAdded, error) {
+	event := new(StorageL2MerkleRootAdded)
+	if err := _Storage.contract.UnpackLog(event, "L2MerkleRootAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StorageL2MessagingBlockAnchoredIterator is returned from FilterL2MessagingBlockAnchored and is used to iterate over the raw logs and unpacked data for L2MessagingBlockAnchored events raised by the Storage contract.
+type StorageL2MessagingBlockAnchoredIterator struct {
+	Event *StorageL2MessagingBlockAnchored // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StorageL2MessagingBlockAnchoredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StorageL2MessagingBlockAnchored)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StorageL2MessagingBlockAnchored)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StorageL2MessagingBlockAnchoredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StorageL2MessagingBlockAnchoredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StorageL2MessagingBlockAnchored represents a L2MessagingBlockAnchored event raised by the Storage contract.
+type StorageL2MessagingBlockAnchored struct {
+	L2Block *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterL2MessagingBlockAnchored is a free log retrieval operation binding the contract event 0x3c116827db9db3a30c1a25db8b0ee4bab9d2b223560209cfd839601b621c726d.
+//
+// Solidity: event L2MessagingBlockAnchored(uint256 indexed l2Block)
+func (_Storage *StorageFilterer) FilterL2MessagingBlockAnchored(opts *bind.FilterOpts, l2Block []*big.Int) (*StorageL2MessagingBlockAnchoredIterator, error) {
+
+	var l2BlockRule []interface{}
+	for _, l2BlockItem := range l2Block {
+		l2BlockRule = append(l2BlockRule, l2BlockItem)
+	}
+
+	logs, sub, err := _Storage.contract.FilterLogs(opts, "L2MessagingBlockAnchored", l2BlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageL2MessagingBlockAnchoredIterator{contract: _Storage.contract, event: "L2MessagingBlockAnchored", logs: logs, sub: sub}, nil
+}
+
+// WatchL2MessagingBlockAnchored is a free log subscription operation binding the contract event 0x3c116827db9db3a30c1a25db8b0ee4bab9d2b223560209cfd839601b621c726d.
+//
+// Solidity: event L2MessagingBlockAnchored(uint256 indexed l2Block)
+func (_Storage *StorageFilterer) WatchL2MessagingBlockAnchored(opts *bind.WatchOpts, sink chan<- *StorageL2MessagingBlockAnchored, l2Block []*big.Int) (event.Subscription, error) {
+
+	var l2BlockRule []interface{}
+	for _, l2BlockItem := range l2Block {
+		l2BlockRule = append(l2BlockRule, l2BlockItem)
+	}
+
+	logs, sub, err := _Storage.contract.WatchLogs(opts, "L2MessagingBlockAnchored", l2BlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StorageL2MessagingBlockAnchored)
+				if err := _Storage.contract.UnpackLog(event, "L2MessagingBlockAnchored", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseL2MessagingBlockAnchored is a log parse operation binding the contract event 0x3c116827db9db3a30c1a25db8b0ee4bab9d2b223560209cfd839601b621c726d.
+//
+// Solidity: event L2MessagingBlockAnchored(uint256 indexed l2Block)
+func (_Storage *StorageFilterer) ParseL2MessagingBlockAnchored(log types.Log) (*StorageL2MessagingBlockAnchored, error) {
+	event := new(StorageL2MessagingBlockAnchored)
+	if err := _Storage.contract.UnpackLog(event, "L2MessagingBlockAnchored", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StorageMessageSentIterator is returned from FilterMessageSent and is used to iterate over the raw logs and unpacked data for MessageSent events raised by the Storage contract.
+type StorageMessageSentIterator struct {
+	Event *StorageMessageSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StorageMessageSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StorageMessageSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StorageMessageSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StorageMessageSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StorageMessageSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StorageMessageSent represents a MessageSent event raised by the Storage contract.
+type StorageMessageSent struct {
+	From        common.Address
+	To          common.Address
+	Fee         *big.Int
+	Value       *big.Int
+	Nonce       *big.Int
+	Calldata    []byte
+	MessageHash [32]byte
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageSent is a free log retrieval operation binding the contract event 0xe856c2b8bd4eb0027ce32eeaf595c21b0b6b4644b326e5b7bd80a1cf8db72e6c.
+//
+// Solidity: event MessageSent(address indexed _from, address indexed _to, uint256 _fee, uint256 _value, uint256 _nonce, bytes _calldata, bytes32 indexed _messageHash)
+func (_Storage *StorageFilterer) FilterMessageSent(opts *bind.FilterOpts, _from []common.Address, _to []common.Address, _messageHash [][32]byte) (*StorageMessageSentIterator, error) {
+
+	var _fromRule []interface{}
+	for _, _fromItem := range _from {
+		_fromRule = append(_fromRule, _fromItem)
+	}
+	var _toRule []interface{}
+	for _, _toItem := range _to {
+		_toRule = append(_toRule, _toItem)
+	}
+
+	var _messageHashRule []interface{}
+	for _, _messageHashItem := range _messageHash {
+		_messageHashRule = append(_messageHashRule, _messageHashItem)
+	}
+
+	logs, sub, err := _Storage.contract.FilterLogs(opts, "MessageSent", _fromRule, _toRule, _messageHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageMessageSentIterator{contract: _Storage.contract, event: "MessageSent", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageSent is a free log subscription operation binding the contract event 0xe856c2b8bd4eb0027ce32eeaf595c21b0b6b4644b326e5b7bd80a1cf8db72e6c.
+//
+// Solidity: event MessageSent(address indexed _from, address indexed _to, uint256 _fee, uint256 _value, uint256 _nonce, bytes _calldata, bytes32 indexed _messageHash)
+func (_Storage *StorageFilterer) WatchMessageSent(opts *bind.WatchOpts, sink chan<- *StorageMessageSent, _from []common.Address, _to []common.Address, _messageHash [][32]byte) (event.Subscription, error) {
+
+	var _fromRule []interface{}
+	for _, _fromItem := range _from {
+		_fromRule = append(_fromRule, _fromItem)
+	}
+	var _toRule []interface{}
+	for _, _toItem := range _to {
+		_toRule = append(_toRule, _toItem)
+	}
+
+	var _messageHashRule []interface{}
+	for _, _messageHashItem := range _messageHash {
+		_messageHashRule = append(_messageHashRule, _messageHashItem)
+	}
+
+	logs, sub, err := _Storage.contract.WatchLogs(opts, "MessageSent", _fromRule, _toRule, _messageHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StorageMessageSent)
+				if err := _Storage.contract.UnpackLog(event, "MessageSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageSent is a log parse operation binding the contract event 0xe856c2b8bd4eb0027ce32eeaf595c21b0b6b4644b326e5b7bd80a1cf8db72e6c.
+//
+// Solidity: event MessageSent(address indexed _from, address indexed _to, uint256 _fee, uint256 _value, uint256 _nonce, bytes _calldata, bytes32 indexed _messageHash)
+func (_Storage *StorageFilterer) ParseMessageSent(log types.Log) (*StorageMessageSent, error) {
+	event := new(StorageMessageSent)
+	if err := _Storage.contract.UnpackLog(event, "MessageSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
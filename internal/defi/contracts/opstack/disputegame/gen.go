@@ -0,0 +1,4 @@
+package disputegame
+
+// FaultDisputeGame, status 0 in progress, 1 challenger wins, 2 defender wins
+//go:generate abigen --abi abi.json --pkg disputegame --type storage --out storage.go
@@ -0,0 +1,4 @@
+package disputegamefactory
+
+// DisputeGameFactory of op stack chains on ethereum
+//go:generate abigen --abi abi.json --pkg disputegamefactory --type storage --out storage.go
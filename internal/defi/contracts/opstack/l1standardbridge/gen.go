@@ -0,0 +1,4 @@
+package l1standardbridge
+
+// https://docs.optimism.io/chain/addresses
+//go:generate abigen --abi abi.json --pkg l1standardbridge --type storage --out storage.go
@@ -0,0 +1,4 @@
+package messagepasser
+
+// L2ToL1MessagePasser predeploy 0x4200000000000000000000000000000000000016 of op stack chains
+//go:generate abigen --abi abi.json --pkg messagepasser --type storage --out storage.go
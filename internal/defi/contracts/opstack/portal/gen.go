@@ -0,0 +1,4 @@
+package portal
+
+// OptimismPortal2 of op stack chains on ethereum, withdrawals are proven against dispute games
+//go:generate abigen --abi abi.json --pkg portal --type storage --out storage.go
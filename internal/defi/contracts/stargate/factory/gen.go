@@ -0,0 +1,4 @@
+package factory
+
+// https://github.com/stargate-protocol/stargate/blob/main/contracts/Factory.sol
+//go:generate abigen --abi abi.json --pkg factory --type storage --out storage.go
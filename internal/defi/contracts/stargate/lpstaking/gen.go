@@ -0,0 +1,5 @@
+package lpstaking
+
+// https://github.com/stargate-protocol/stargate/blob/main/contracts/LPStaking.sol
+// LPStakingTime of the newer deployments has pendingEmissionToken instead of pendingStargate
+//go:generate abigen --abi abi.json --pkg lpstaking --type storage --out storage.go
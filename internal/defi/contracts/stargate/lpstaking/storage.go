@@ -0,0 +1,416 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package lpstaking
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// StorageMetaData contains all meta data concerning the Storage contract.
+var StorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"deposit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"}],\"name\":\"pendingEmissionToken\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"}],\"name\":\"pendingStargate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"poolInfo\",\"outputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"lpToken\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allocPoint\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastRewardBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"accStargatePerShare\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"poolLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"userInfo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardDebt\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// StorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use StorageMetaData.ABI instead.
+var StorageABI = StorageMetaData.ABI
+
+// Storage is an auto generated Go binding around an Ethereum contract.
+type Storage struct {
+	StorageCaller     // Read-only binding to the contract
+	StorageTransactor // Write-only binding to the contract
+	StorageFilterer   // Log filterer for contract events
+}
+
+// StorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StorageSession struct {
+	Contract     *Storage          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StorageCallerSession struct {
+	Contract *StorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StorageTransactorSession struct {
+	Contract     *StorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StorageRaw struct {
+	Contract *Storage // Generic contract binding to access the raw methods on
+}
+
+// StorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StorageCallerRaw struct {
+	Contract *StorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StorageTransactorRaw struct {
+	Contract *StorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStorage creates a new instance of Storage, bound to a specific deployed contract.
+func NewStorage(address common.Address, backend bind.ContractBackend) (*Storage, error) {
+	contract, err := bindStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{StorageCaller: StorageCaller{contract: contract}, StorageTransactor: StorageTransactor{contract: contract}, StorageFilterer: StorageFilterer{contract: contract}}, nil
+}
+
+// NewStorageCaller creates a new read-only instance of Storage, bound to a specific deployed contract.
+func NewStorageCaller(address common.Address, caller bind.ContractCaller) (*StorageCaller, error) {
+	contract, err := bindStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageCaller{contract: contract}, nil
+}
+
+// NewStorageTransactor creates a new write-only instance of Storage, bound to a specific deployed contract.
+func NewStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*StorageTransactor, error) {
+	contract, err := bindStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageTransactor{contract: contract}, nil
+}
+
+// NewStorageFilterer creates a new log filterer instance of Storage, bound to a specific deployed contract.
+func NewStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*StorageFilterer, error) {
+	contract, err := bindStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageFilterer{contract: contract}, nil
+}
+
+// bindStorage binds a generic wrapper to an already deployed contract.
+func bindStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.StorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transact(opts, method, params...)
+}
+
+// PendingEmissionToken is a free data retrieval call binding the contract method 0xc4a27f54.
+//
+// Solidity: function pendingEmissionToken(uint256 _pid, address _user) view returns(uint256)
+func (_Storage *StorageCaller) PendingEmissionToken(opts *bind.CallOpts, _pid *big.Int, _user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "pendingEmissionToken", _pid, _user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PendingEmissionToken is a free data retrieval call binding the contract method 0xc4a27f54.
+//
+// Solidity: function pendingEmissionToken(uint256 _pid, address _user) view returns(uint256)
+func (_Storage *StorageSession) PendingEmissionToken(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _Storage.Contract.PendingEmissionToken(&_Storage.CallOpts, _pid, _user)
+}
+
+// PendingEmissionToken is a free data retrieval call binding the contract method 0xc4a27f54.
+//
+// Solidity: function pendingEmissionToken(uint256 _pid, address _user) view returns(uint256)
+func (_Storage *StorageCallerSession) PendingEmissionToken(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _Storage.Contract.PendingEmissionToken(&_Storage.CallOpts, _pid, _user)
+}
+
+// PendingStargate is a free data retrieval call binding the contract method 0x2f607fdd.
+//
+// Solidity: function pendingStargate(uint256 _pid, address _user) view returns(uint256)
+func (_Storage *StorageCaller) PendingStargate(opts *bind.CallOpts, _pid *big.Int, _user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "pendingStargate", _pid, _user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PendingStargate is a free data retrieval call binding the contract method 0x2f607fdd.
+//
+// Solidity: function pendingStargate(uint256 _pid, address _user) view returns(uint256)
+func (_Storage *StorageSession) PendingStargate(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _Storage.Contract.PendingStargate(&_Storage.CallOpts, _pid, _user)
+}
+
+// PendingStargate is a free data retrieval call binding the contract method 0x2f607fdd.
+//
+// Solidity: function pendingStargate(uint256 _pid, address _user) view returns(uint256)
+func (_Storage *StorageCallerSession) PendingStargate(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _Storage.Contract.PendingStargate(&_Storage.CallOpts, _pid, _user)
+}
+
+// PoolInfo is a free data retrieval call binding the contract method 0x1526fe27.
+//
+// Solidity: function poolInfo(uint256 ) view returns(address lpToken, uint256 allocPoint, uint256 lastRewardBlock, uint256 accStargatePerShare)
+func (_Storage *StorageCaller) PoolInfo(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	LpToken             common.Address
+	AllocPoint          *big.Int
+	LastRewardBlock     *big.Int
+	AccStargatePerShare *big.Int
+}, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "poolInfo", arg0)
+
+	outstruct := new(struct {
+		LpToken             common.Address
+		AllocPoint          *big.Int
+		LastRewardBlock     *big.Int
+		AccStargatePerShare *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.LpToken = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.AllocPoint = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.LastRewardBlock = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.AccStargatePerShare = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// PoolInfo is a free data retrieval call binding the contract method 0x1526fe27.
+//
+// Solidity: function poolInfo(uint256 ) view returns(address lpToken, uint256 allocPoint, uint256 lastRewardBlock, uint256 accStargatePerShare)
+func (_Storage *StorageSession) PoolInfo(arg0 *big.Int) (struct {
+	LpToken             common.Address
+	AllocPoint          *big.Int
+	LastRewardBlock     *big.Int
+	AccStargatePerShare *big.Int
+}, error) {
+	return _Storage.Contract.PoolInfo(&_Storage.CallOpts, arg0)
+}
+
+// PoolInfo is a free data retrieval call binding the contract method 0x1526fe27.
+//
+// Solidity: function poolInfo(uint256 ) view returns(address lpToken, uint256 allocPoint, uint256 lastRewardBlock, uint256 accStargatePerShare)
+func (_Storage *StorageCallerSession) PoolInfo(arg0 *big.Int) (struct {
+	LpToken             common.Address
+	AllocPoint          *big.Int
+	LastRewardBlock     *big.Int
+	AccStargatePerShare *big.Int
+}, error) {
+	return _Storage.Contract.PoolInfo(&_Storage.CallOpts, arg0)
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_Storage *StorageCaller) PoolLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "poolLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_Storage *StorageSession) PoolLength() (*big.Int, error) {
+	return _Storage.Contract.PoolLength(&_Storage.CallOpts)
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_Storage *StorageCallerSession) PoolLength() (*big.Int, error) {
+	return _Storage.Contract.PoolLength(&_Storage.CallOpts)
+}
+
+// UserInfo is a free data retrieval call binding the contract method 0x93f1a40b.
+//
+// Solidity: function userInfo(uint256 , address ) view returns(uint256 amount, uint256 rewardDebt)
+func (_Storage *StorageCaller) UserInfo(opts *bind.CallOpts, arg0 *big.Int, arg1 common.Address) (struct {
+	Amount     *big.Int
+	RewardDebt *big.Int
+}, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "userInfo", arg0, arg1)
+
+	outstruct := new(struct {
+		Amount     *big.Int
+		RewardDebt *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Amount = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.RewardDebt = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// UserInfo is a free data retrieval call binding the contract method 0x93f1a40b.
+//
+// Solidity: function userInfo(uint256 , address ) view returns(uint256 amount, uint256 rewardDebt)
+func (_Storage *StorageSession) UserInfo(arg0 *big.Int, arg1 common.Address) (struct {
+	Amount     *big.Int
+	RewardDebt *big.Int
+}, error) {
+	return _Storage.Contract.UserInfo(&_Storage.CallOpts, arg0, arg1)
+}
+
+// UserInfo is a free data retrieval call binding the contract method 0x93f1a40b.
+//
+// Solidity: function userInfo(uint256 , address ) view returns(uint256 amount, uint256 rewardDebt)
+func (_Storage *StorageCallerSession) UserInfo(arg0 *big.Int, arg1 common.Address) (struct {
+	Amount     *big.Int
+	RewardDebt *big.Int
+}, error) {
+	return _Storage.Contract.UserInfo(&_Storage.CallOpts, arg0, arg1)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe2bbb158.
+//
+// Solidity: function deposit(uint256 _pid, uint256 _amount) returns()
+func (_Storage *StorageTransactor) Deposit(opts *bind.TransactOpts, _pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "deposit", _pid, _amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe2bbb158.
+//
+// Solidity: function deposit(uint256 _pid, uint256 _amount) returns()
+func (_Storage *StorageSession) Deposit(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Deposit(&_Storage.TransactOpts, _pid, _amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe2bbb158.
+//
+// Solidity: function deposit(uint256 _pid, uint256 _amount) returns()
+func (_Storage *StorageTransactorSession) Deposit(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Deposit(&_Storage.TransactOpts, _pid, _amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x441a3e70.
+//
+// Solidity: function withdraw(uint256 _pid, uint256 _amount) returns()
+func (_Storage *StorageTransactor) Withdraw(opts *bind.TransactOpts, _pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "withdraw", _pid, _amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x441a3e70.
+//
+// Solidity: function withdraw(uint256 _pid, uint256 _amount) returns()
+func (_Storage *StorageSession) Withdraw(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Withdraw(&_Storage.TransactOpts, _pid, _amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x441a3e70.
+//
+// Solidity: function withdraw(uint256 _pid, uint256 _amount) returns()
+func (_Storage *StorageTransactorSession) Withdraw(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Withdraw(&_Storage.TransactOpts, _pid, _amount)
+}
@@ -0,0 +1,4 @@
+package pool
+
+// https://github.com/stargate-protocol/stargate/blob/main/contracts/Pool.sol
+//go:generate abigen --abi abi.json --pkg pool --type storage --out storage.go
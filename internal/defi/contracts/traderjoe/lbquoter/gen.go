@@ -0,0 +1,4 @@
+package lbquoter
+
+// https://arbiscan.io/address/0xd76019A16606FDa4651f636D9751f500Ed776250#code
+//go:generate abigen --abi abi.json --pkg lbquoter --type storage --out storage.go
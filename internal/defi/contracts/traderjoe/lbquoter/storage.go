@@ -0,0 +1,285 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package lbquoter
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LBQuoterQuote is an auto generated low-level Go binding around an user-defined struct.
+type LBQuoterQuote struct {
+	Route                         []common.Address
+	Pairs                         []common.Address
+	BinSteps                      []*big.Int
+	Versions                      []uint8
+	Amounts                       []*big.Int
+	VirtualAmountsWithoutSlippage []*big.Int
+	Fees                          []*big.Int
+}
+
+// StorageMetaData contains all meta data concerning the Storage contract.
+var StorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"route\",\"type\":\"address[]\"},{\"internalType\":\"uint128\",\"name\":\"amountIn\",\"type\":\"uint128\"}],\"name\":\"findBestPathFromAmountIn\",\"outputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"route\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"pairs\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"binSteps\",\"type\":\"uint256[]\"},{\"internalType\":\"enumILBRouter.Version[]\",\"name\":\"versions\",\"type\":\"uint8[]\"},{\"internalType\":\"uint128[]\",\"name\":\"amounts\",\"type\":\"uint128[]\"},{\"internalType\":\"uint128[]\",\"name\":\"virtualAmountsWithoutSlippage\",\"type\":\"uint128[]\"},{\"internalType\":\"uint128[]\",\"name\":\"fees\",\"type\":\"uint128[]\"}],\"internalType\":\"structLBQuoter.Quote\",\"name\":\"quote\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getFactoryV2_1\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"factoryV2_1\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getRouterV2_1\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"routerV2_1\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// StorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use StorageMetaData.ABI instead.
+var StorageABI = StorageMetaData.ABI
+
+// Storage is an auto generated Go binding around an Ethereum contract.
+type Storage struct {
+	StorageCaller     // Read-only binding to the contract
+	StorageTransactor // Write-only binding to the contract
+	StorageFilterer   // Log filterer for contract events
+}
+
+// StorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StorageSession struct {
+	Contract     *Storage          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StorageCallerSession struct {
+	Contract *StorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StorageTransactorSession struct {
+	Contract     *StorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StorageRaw struct {
+	Contract *Storage // Generic contract binding to access the raw methods on
+}
+
+// StorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StorageCallerRaw struct {
+	Contract *StorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StorageTransactorRaw struct {
+	Contract *StorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStorage creates a new instance of Storage, bound to a specific deployed contract.
+func NewStorage(address common.Address, backend bind.ContractBackend) (*Storage, error) {
+	contract, err := bindStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{StorageCaller: StorageCaller{contract: contract}, StorageTransactor: StorageTransactor{contract: contract}, StorageFilterer: StorageFilterer{contract: contract}}, nil
+}
+
+// NewStorageCaller creates a new read-only instance of Storage, bound to a specific deployed contract.
+func NewStorageCaller(address common.Address, caller bind.ContractCaller) (*StorageCaller, error) {
+	contract, err := bindStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageCaller{contract: contract}, nil
+}
+
+// NewStorageTransactor creates a new write-only instance of Storage, bound to a specific deployed contract.
+func NewStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*StorageTransactor, error) {
+	contract, err := bindStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageTransactor{contract: contract}, nil
+}
+
+// NewStorageFilterer creates a new log filterer instance of Storage, bound to a specific deployed contract.
+func NewStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*StorageFilterer, error) {
+	contract, err := bindStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageFilterer{contract: contract}, nil
+}
+
+// bindStorage binds a generic wrapper to an already deployed contract.
+func bindStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.StorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transact(opts, method, params...)
+}
+
+// FindBestPathFromAmountIn is a free data retrieval call binding the contract method 0x0f902a40.
+//
+// Solidity: function findBestPathFromAmountIn(address[] route, uint128 amountIn) view returns((address[],address[],uint256[],uint8[],uint128[],uint128[],uint128[]) quote)
+func (_Storage *StorageCaller) FindBestPathFromAmountIn(opts *bind.CallOpts, route []common.Address, amountIn *big.Int) (LBQuoterQuote, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "findBestPathFromAmountIn", route, amountIn)
+
+	if err != nil {
+		return *new(LBQuoterQuote), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(LBQuoterQuote)).(*LBQuoterQuote)
+
+	return out0, err
+
+}
+
+// FindBestPathFromAmountIn is a free data retrieval call binding the contract method 0x0f902a40.
+//
+// Solidity: function findBestPathFromAmountIn(address[] route, uint128 amountIn) view returns((address[],address[],uint256[],uint8[],uint128[],uint128[],uint128[]) quote)
+func (_Storage *StorageSession) FindBestPathFromAmountIn(route []common.Address, amountIn *big.Int) (LBQuoterQuote, error) {
+	return _Storage.Contract.FindBestPathFromAmountIn(&_Storage.CallOpts, route, amountIn)
+}
+
+// FindBestPathFromAmountIn is a free data retrieval call binding the contract method 0x0f902a40.
+//
+// Solidity: function findBestPathFromAmountIn(address[] route, uint128 amountIn) view returns((address[],address[],uint256[],uint8[],uint128[],uint128[],uint128[]) quote)
+func (_Storage *StorageCallerSession) FindBestPathFromAmountIn(route []common.Address, amountIn *big.Int) (LBQuoterQuote, error) {
+	return _Storage.Contract.FindBestPathFromAmountIn(&_Storage.CallOpts, route, amountIn)
+}
+
+// GetFactoryV21 is a free data retrieval call binding the contract method 0x5c5035cb.
+//
+// Solidity: function getFactoryV2_1() view returns(address factoryV2_1)
+func (_Storage *StorageCaller) GetFactoryV21(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "getFactoryV2_1")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetFactoryV21 is a free data retrieval call binding the contract method 0x5c5035cb.
+//
+// Solidity: function getFactoryV2_1() view returns(address factoryV2_1)
+func (_Storage *StorageSession) GetFactoryV21() (common.Address, error) {
+	return _Storage.Contract.GetFactoryV21(&_Storage.CallOpts)
+}
+
+// GetFactoryV21 is a free data retrieval call binding the contract method 0x5c5035cb.
+//
+// Solidity: function getFactoryV2_1() view returns(address factoryV2_1)
+func (_Storage *StorageCallerSession) GetFactoryV21() (common.Address, error) {
+	return _Storage.Contract.GetFactoryV21(&_Storage.CallOpts)
+}
+
+// GetRouterV21 is a free data retrieval call binding the contract method 0x33f83e2a.
+//
+// Solidity: function getRouterV2_1() view returns(address routerV2_1)
+func (_Storage *StorageCaller) GetRouterV21(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "getRouterV2_1")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetRouterV21 is a free data retrieval call binding the contract method 0x33f83e2a.
+//
+// Solidity: function getRouterV2_1() view returns(address routerV2_1)
+func (_Storage *StorageSession) GetRouterV21() (common.Address, error) {
+	return _Storage.Contract.GetRouterV21(&_Storage.CallOpts)
+}
+
+// GetRouterV21 is a free data retrieval call binding the contract method 0x33f83e2a.
+//
+// Solidity: function getRouterV2_1() view returns(address routerV2_1)
+func (_Storage *StorageCallerSession) GetRouterV21() (common.Address, error) {
+	return _Storage.Contract.GetRouterV21(&_Storage.CallOpts)
+}
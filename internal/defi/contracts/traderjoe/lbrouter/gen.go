@@ -0,0 +1,4 @@
+package lbrouter
+
+// https://arbiscan.io/address/0xb4315e873dBcf96Ffd0acd8EA43f689D8c20fB30#code
+//go:generate abigen --abi abi.json --pkg lbrouter --type storage --out storage.go
@@ -0,0 +1,313 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package lbrouter
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ILBRouterPath is an auto generated low-level Go binding around an user-defined struct.
+type ILBRouterPath struct {
+	PairBinSteps []*big.Int
+	Versions     []uint8
+	TokenPath    []common.Address
+}
+
+// StorageMetaData contains all meta data concerning the Storage contract.
+var StorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"getFactory\",\"outputs\":[{\"internalType\":\"contractILBFactory\",\"name\":\"lbFactory\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getWNATIVE\",\"outputs\":[{\"internalType\":\"contractIWNATIVE\",\"name\":\"wnative\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amountOutMin\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"uint256[]\",\"name\":\"pairBinSteps\",\"type\":\"uint256[]\"},{\"internalType\":\"enumILBRouter.Version[]\",\"name\":\"versions\",\"type\":\"uint8[]\"},{\"internalType\":\"contractIERC20[]\",\"name\":\"tokenPath\",\"type\":\"address[]\"}],\"internalType\":\"structILBRouter.Path\",\"name\":\"path\",\"type\":\"tuple\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"name\":\"swapExactNATIVEForTokens\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountOut\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amountIn\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amountOutMinNATIVE\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"uint256[]\",\"name\":\"pairBinSteps\",\"type\":\"uint256[]\"},{\"internalType\":\"enumILBRouter.Version[]\",\"name\":\"versions\",\"type\":\"uint8[]\"},{\"internalType\":\"contractIERC20[]\",\"name\":\"tokenPath\",\"type\":\"address[]\"}],\"internalType\":\"structILBRouter.Path\",\"name\":\"path\",\"type\":\"tuple\"},{\"internalType\":\"addresspayable\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"name\":\"swapExactTokensForNATIVE\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountOut\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amountIn\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amountOutMin\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"uint256[]\",\"name\":\"pairBinSteps\",\"type\":\"uint256[]\"},{\"internalType\":\"enumILBRouter.Version[]\",\"name\":\"versions\",\"type\":\"uint8[]\"},{\"internalType\":\"contractIERC20[]\",\"name\":\"tokenPath\",\"type\":\"address[]\"}],\"internalType\":\"structILBRouter.Path\",\"name\":\"path\",\"type\":\"tuple\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"name\":\"swapExactTokensForTokens\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountOut\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// StorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use StorageMetaData.ABI instead.
+var StorageABI = StorageMetaData.ABI
+
+// Storage is an auto generated Go binding around an Ethereum contract.
+type Storage struct {
+	StorageCaller     // Read-only binding to the contract
+	StorageTransactor // Write-only binding to the contract
+	StorageFilterer   // Log filterer for contract events
+}
+
+// StorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StorageSession struct {
+	Contract     *Storage          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StorageCallerSession struct {
+	Contract *StorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StorageTransactorSession struct {
+	Contract     *StorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StorageRaw struct {
+	Contract *Storage // Generic contract binding to access the raw methods on
+}
+
+// StorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StorageCallerRaw struct {
+	Contract *StorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StorageTransactorRaw struct {
+	Contract *StorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStorage creates a new instance of Storage, bound to a specific deployed contract.
+func NewStorage(address common.Address, backend bind.ContractBackend) (*Storage, error) {
+	contract, err := bindStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{StorageCaller: StorageCaller{contract: contract}, StorageTransactor: StorageTransactor{contract: contract}, StorageFilterer: StorageFilterer{contract: contract}}, nil
+}
+
+// NewStorageCaller creates a new read-only instance of Storage, bound to a specific deployed contract.
+func NewStorageCaller(address common.Address, caller bind.ContractCaller) (*StorageCaller, error) {
+	contract, err := bindStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageCaller{contract: contract}, nil
+}
+
+// NewStorageTransactor creates a new write-only instance of Storage, bound to a specific deployed contract.
+func NewStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*StorageTransactor, error) {
+	contract, err := bindStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageTransactor{contract: contract}, nil
+}
+
+// NewStorageFilterer creates a new log filterer instance of Storage, bound to a specific deployed contract.
+func NewStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*StorageFilterer, error) {
+	contract, err := bindStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageFilterer{contract: contract}, nil
+}
+
+// bindStorage binds a generic wrapper to an already deployed contract.
+func bindStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.StorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetFactory is a free data retrieval call binding the contract method 0x88cc58e4.
+//
+// Solidity: function getFactory() view returns(address lbFactory)
+func (_Storage *StorageCaller) GetFactory(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "getFactory")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetFactory is a free data retrieval call binding the contract method 0x88cc58e4.
+//
+// Solidity: function getFactory() view returns(address lbFactory)
+func (_Storage *StorageSession) GetFactory() (common.Address, error) {
+	return _Storage.Contract.GetFactory(&_Storage.CallOpts)
+}
+
+// GetFactory is a free data retrieval call binding the contract method 0x88cc58e4.
+//
+// Solidity: function getFactory() view returns(address lbFactory)
+func (_Storage *StorageCallerSession) GetFactory() (common.Address, error) {
+	return _Storage.Contract.GetFactory(&_Storage.CallOpts)
+}
+
+// GetWNATIVE is a free data retrieval call binding the contract method 0x6c9c0078.
+//
+// Solidity: function getWNATIVE() view returns(address wnative)
+func (_Storage *StorageCaller) GetWNATIVE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "getWNATIVE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetWNATIVE is a free data retrieval call binding the contract method 0x6c9c0078.
+//
+// Solidity: function getWNATIVE() view returns(address wnative)
+func (_Storage *StorageSession) GetWNATIVE() (common.Address, error) {
+	return _Storage.Contract.GetWNATIVE(&_Storage.CallOpts)
+}
+
+// GetWNATIVE is a free data retrieval call binding the contract method 0x6c9c0078.
+//
+// Solidity: function getWNATIVE() view returns(address wnative)
+func (_Storage *StorageCallerSession) GetWNATIVE() (common.Address, error) {
+	return _Storage.Contract.GetWNATIVE(&_Storage.CallOpts)
+}
+
+// SwapExactNATIVEForTokens is a paid mutator transaction binding the contract method 0xb066ea7c.
+//
+// Solidity: function swapExactNATIVEForTokens(uint256 amountOutMin, (uint256[],uint8[],address[]) path, address to, uint256 deadline) payable returns(uint256 amountOut)
+func (_Storage *StorageTransactor) SwapExactNATIVEForTokens(opts *bind.TransactOpts, amountOutMin *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "swapExactNATIVEForTokens", amountOutMin, path, to, deadline)
+}
+
+// SwapExactNATIVEForTokens is a paid mutator transaction binding the contract method 0xb066ea7c.
+//
+// Solidity: function swapExactNATIVEForTokens(uint256 amountOutMin, (uint256[],uint8[],address[]) path, address to, uint256 deadline) payable returns(uint256 amountOut)
+func (_Storage *StorageSession) SwapExactNATIVEForTokens(amountOutMin *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.SwapExactNATIVEForTokens(&_Storage.TransactOpts, amountOutMin, path, to, deadline)
+}
+
+// SwapExactNATIVEForTokens is a paid mutator transaction binding the contract method 0xb066ea7c.
+//
+// Solidity: function swapExactNATIVEForTokens(uint256 amountOutMin, (uint256[],uint8[],address[]) path, address to, uint256 deadline) payable returns(uint256 amountOut)
+func (_Storage *StorageTransactorSession) SwapExactNATIVEForTokens(amountOutMin *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.SwapExactNATIVEForTokens(&_Storage.TransactOpts, amountOutMin, path, to, deadline)
+}
+
+// SwapExactTokensForNATIVE is a paid mutator transaction binding the contract method 0x9ab6156b.
+//
+// Solidity: function swapExactTokensForNATIVE(uint256 amountIn, uint256 amountOutMinNATIVE, (uint256[],uint8[],address[]) path, address to, uint256 deadline) returns(uint256 amountOut)
+func (_Storage *StorageTransactor) SwapExactTokensForNATIVE(opts *bind.TransactOpts, amountIn *big.Int, amountOutMinNATIVE *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "swapExactTokensForNATIVE", amountIn, amountOutMinNATIVE, path, to, deadline)
+}
+
+// SwapExactTokensForNATIVE is a paid mutator transaction binding the contract method 0x9ab6156b.
+//
+// Solidity: function swapExactTokensForNATIVE(uint256 amountIn, uint256 amountOutMinNATIVE, (uint256[],uint8[],address[]) path, address to, uint256 deadline) returns(uint256 amountOut)
+func (_Storage *StorageSession) SwapExactTokensForNATIVE(amountIn *big.Int, amountOutMinNATIVE *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.SwapExactTokensForNATIVE(&_Storage.TransactOpts, amountIn, amountOutMinNATIVE, path, to, deadline)
+}
+
+// SwapExactTokensForNATIVE is a paid mutator transaction binding the contract method 0x9ab6156b.
+//
+// Solidity: function swapExactTokensForNATIVE(uint256 amountIn, uint256 amountOutMinNATIVE, (uint256[],uint8[],address[]) path, address to, uint256 deadline) returns(uint256 amountOut)
+func (_Storage *StorageTransactorSession) SwapExactTokensForNATIVE(amountIn *big.Int, amountOutMinNATIVE *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.SwapExactTokensForNATIVE(&_Storage.TransactOpts, amountIn, amountOutMinNATIVE, path, to, deadline)
+}
+
+// SwapExactTokensForTokens is a paid mutator transaction binding the contract method 0x2a443fae.
+//
+// Solidity: function swapExactTokensForTokens(uint256 amountIn, uint256 amountOutMin, (uint256[],uint8[],address[]) path, address to, uint256 deadline) returns(uint256 amountOut)
+func (_Storage *StorageTransactor) SwapExactTokensForTokens(opts *bind.TransactOpts, amountIn *big.Int, amountOutMin *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "swapExactTokensForTokens", amountIn, amountOutMin, path, to, deadline)
+}
+
+// SwapExactTokensForTokens is a paid mutator transaction binding the contract method 0x2a443fae.
+//
+// Solidity: function swapExactTokensForTokens(uint256 amountIn, uint256 amountOutMin, (uint256[],uint8[],address[]) path, address to, uint256 deadline) returns(uint256 amountOut)
+func (_Storage *StorageSession) SwapExactTokensForTokens(amountIn *big.Int, amountOutMin *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.SwapExactTokensForTokens(&_Storage.TransactOpts, amountIn, amountOutMin, path, to, deadline)
+}
+
+// SwapExactTokensForTokens is a paid mutator transaction binding the contract method 0x2a443fae.
+//
+// Solidity: function swapExactTokensForTokens(uint256 amountIn, uint256 amountOutMin, (uint256[],uint8[],address[]) path, address to, uint256 deadline) returns(uint256 amountOut)
+func (_Storage *StorageTransactorSession) SwapExactTokensForTokens(amountIn *big.Int, amountOutMin *big.Int, path ILBRouterPath, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.SwapExactTokensForTokens(&_Storage.TransactOpts, amountIn, amountOutMin, path, to, deadline)
+}
@@ -0,0 +1,4 @@
+package eraname
+
+// https://explorer.zksync.io/address/0x935442AF47F3dc1c11F006D551E13769F12eab13#contract
+//go:generate abigen --abi abi.json --pkg eraname --type storage --out storage.go
@@ -0,0 +1,4 @@
+package izumiliquiditymanager
+
+// https://explorer.zksync.io/address/0x936c9A1B8f88BFDbd5066ad08e5d773BC82EB15F#contract
+//go:generate abigen --abi abi.json --pkg izumiliquiditymanager --type storage --out storage.go
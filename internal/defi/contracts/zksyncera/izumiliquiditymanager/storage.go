@@ -0,0 +1,561 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package izumiliquiditymanager
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LiquidityManagerMintParam is an auto generated low-level Go binding around an user-defined struct.
+type LiquidityManagerMintParam struct {
+	Miner      common.Address
+	TokenX     common.Address
+	TokenY     common.Address
+	Fee        *big.Int
+	Pl         *big.Int
+	Pr         *big.Int
+	XLim       *big.Int
+	YLim       *big.Int
+	AmountXMin *big.Int
+	AmountYMin *big.Int
+	Deadline   *big.Int
+}
+
+// StorageMetaData contains all meta data concerning the Storage contract.
+var StorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"lid\",\"type\":\"uint256\"},{\"internalType\":\"uint128\",\"name\":\"amountXLim\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"amountYLim\",\"type\":\"uint128\"}],\"name\":\"collect\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountX\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amountY\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"lid\",\"type\":\"uint256\"},{\"internalType\":\"uint128\",\"name\":\"liquidDelta\",\"type\":\"uint128\"},{\"internalType\":\"uint256\",\"name\":\"amountXMin\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amountYMin\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"name\":\"decLiquidity\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amountX\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amountY\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"liquidities\",\"outputs\":[{\"internalType\":\"int24\",\"name\":\"leftPt\",\"type\":\"int24\"},{\"internalType\":\"int24\",\"name\":\"rightPt\",\"type\":\"int24\"},{\"internalType\":\"uint128\",\"name\":\"liquidity\",\"type\":\"uint128\"},{\"internalType\":\"uint256\",\"name\":\"lastFeeScaleX_128\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastFeeScaleY_128\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"remainTokenX\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"remainTokenY\",\"type\":\"uint256\"},{\"internalType\":\"uint128\",\"name\":\"poolId\",\"type\":\"uint128\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"miner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenX\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenY\",\"type\":\"address\"},{\"internalType\":\"uint24\",\"name\":\"fee\",\"type\":\"uint24\"},{\"internalType\":\"int24\",\"name\":\"pl\",\"type\":\"int24\"},{\"internalType\":\"int24\",\"name\":\"pr\",\"type\":\"int24\"},{\"internalType\":\"uint128\",\"name\":\"xLim\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"yLim\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"amountXMin\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"amountYMin\",\"type\":\"uint128\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"}],\"internalType\":\"structLiquidityManager.MintParam\",\"type\":\"tuple\",\"name\":\"mintParam\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"lid\",\"type\":\"uint256\"},{\"internalType\":\"uint128\",\"name\":\"liquidity\",\"type\":\"uint128\"},{\"internalType\":\"uint256\",\"name\":\"amountX\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amountY\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes[]\",\"name\":\"data\",\"type\":\"bytes[]\"}],\"name\":\"multicall\",\"outputs\":[{\"internalType\":\"bytes[]\",\"name\":\"results\",\"type\":\"bytes[]\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"tokenX\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenY\",\"type\":\"address\"},{\"internalType\":\"uint24\",\"name\":\"fee\",\"type\":\"uint24\"}],\"name\":\"pool\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint128\",\"name\":\"\",\"type\":\"uint128\"}],\"name\":\"poolMetas\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"tokenX\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenY\",\"type\":\"address\"},{\"internalType\":\"uint24\",\"name\":\"fee\",\"type\":\"uint24\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"refundETH\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"minAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"sweepToken\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenOfOwnerByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"minAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"unwrapWETH9\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// StorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use StorageMetaData.ABI instead.
+var StorageABI = StorageMetaData.ABI
+
+// Storage is an auto generated Go binding around an Ethereum contract.
+type Storage struct {
+	StorageCaller     // Read-only binding to the contract
+	StorageTransactor // Write-only binding to the contract
+	StorageFilterer   // Log filterer for contract events
+}
+
+// StorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StorageSession struct {
+	Contract     *Storage          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StorageCallerSession struct {
+	Contract *StorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StorageTransactorSession struct {
+	Contract     *StorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StorageRaw struct {
+	Contract *Storage // Generic contract binding to access the raw methods on
+}
+
+// StorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StorageCallerRaw struct {
+	Contract *StorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StorageTransactorRaw struct {
+	Contract *StorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStorage creates a new instance of Storage, bound to a specific deployed contract.
+func NewStorage(address common.Address, backend bind.ContractBackend) (*Storage, error) {
+	contract, err := bindStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{StorageCaller: StorageCaller{contract: contract}, StorageTransactor: StorageTransactor{contract: contract}, StorageFilterer: StorageFilterer{contract: contract}}, nil
+}
+
+// NewStorageCaller creates a new read-only instance of Storage, bound to a specific deployed contract.
+func NewStorageCaller(address common.Address, caller bind.ContractCaller) (*StorageCaller, error) {
+	contract, err := bindStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageCaller{contract: contract}, nil
+}
+
+// NewStorageTransactor creates a new write-only instance of Storage, bound to a specific deployed contract.
+func NewStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*StorageTransactor, error) {
+	contract, err := bindStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageTransactor{contract: contract}, nil
+}
+
+// NewStorageFilterer creates a new log filterer instance of Storage, bound to a specific deployed contract.
+func NewStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*StorageFilterer, error) {
+	contract, err := bindStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageFilterer{contract: contract}, nil
+}
+
+// bindStorage binds a generic wrapper to an already deployed contract.
+func bindStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.StorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_Storage *StorageCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_Storage *StorageSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _Storage.Contract.BalanceOf(&_Storage.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_Storage *StorageCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _Storage.Contract.BalanceOf(&_Storage.CallOpts, owner)
+}
+
+// Liquidities is a free data retrieval call binding the contract method 0x0713051d.
+//
+// Solidity: function liquidities(uint256 ) view returns(int24 leftPt, int24 rightPt, uint128 liquidity, uint256 lastFeeScaleX_128, uint256 lastFeeScaleY_128, uint256 remainTokenX, uint256 remainTokenY, uint128 poolId)
+func (_Storage *StorageCaller) Liquidities(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	LeftPt           *big.Int
+	RightPt          *big.Int
+	Liquidity        *big.Int
+	LastFeeScaleX128 *big.Int
+	LastFeeScaleY128 *big.Int
+	RemainTokenX     *big.Int
+	RemainTokenY     *big.Int
+	PoolId           *big.Int
+}, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "liquidities", arg0)
+
+	outstruct := new(struct {
+		LeftPt           *big.Int
+		RightPt          *big.Int
+		Liquidity        *big.Int
+		LastFeeScaleX128 *big.Int
+		LastFeeScaleY128 *big.Int
+		RemainTokenX     *big.Int
+		RemainTokenY     *big.Int
+		PoolId           *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.LeftPt = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.RightPt = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Liquidity = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.LastFeeScaleX128 = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.LastFeeScaleY128 = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.RemainTokenX = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.RemainTokenY = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.PoolId = *abi.ConvertType(out[7], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Liquidities is a free data retrieval call binding the contract method 0x0713051d.
+//
+// Solidity: function liquidities(uint256 ) view returns(int24 leftPt, int24 rightPt, uint128 liquidity, uint256 lastFeeScaleX_128, uint256 lastFeeScaleY_128, uint256 remainTokenX, uint256 remainTokenY, uint128 poolId)
+func (_Storage *StorageSession) Liquidities(arg0 *big.Int) (struct {
+	LeftPt           *big.Int
+	RightPt          *big.Int
+	Liquidity        *big.Int
+	LastFeeScaleX128 *big.Int
+	LastFeeScaleY128 *big.Int
+	RemainTokenX     *big.Int
+	RemainTokenY     *big.Int
+	PoolId           *big.Int
+}, error) {
+	return _Storage.Contract.Liquidities(&_Storage.CallOpts, arg0)
+}
+
+// Liquidities is a free data retrieval call binding the contract method 0x0713051d.
+//
+// Solidity: function liquidities(uint256 ) view returns(int24 leftPt, int24 rightPt, uint128 liquidity, uint256 lastFeeScaleX_128, uint256 lastFeeScaleY_128, uint256 remainTokenX, uint256 remainTokenY, uint128 poolId)
+func (_Storage *StorageCallerSession) Liquidities(arg0 *big.Int) (struct {
+	LeftPt           *big.Int
+	RightPt          *big.Int
+	Liquidity        *big.Int
+	LastFeeScaleX128 *big.Int
+	LastFeeScaleY128 *big.Int
+	RemainTokenX     *big.Int
+	RemainTokenY     *big.Int
+	PoolId           *big.Int
+}, error) {
+	return _Storage.Contract.Liquidities(&_Storage.CallOpts, arg0)
+}
+
+// Pool is a free data retrieval call binding the contract method 0xbecbcc6a.
+//
+// Solidity: function pool(address tokenX, address tokenY, uint24 fee) view returns(address)
+func (_Storage *StorageCaller) Pool(opts *bind.CallOpts, tokenX common.Address, tokenY common.Address, fee *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "pool", tokenX, tokenY, fee)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Pool is a free data retrieval call binding the contract method 0xbecbcc6a.
+//
+// Solidity: function pool(address tokenX, address tokenY, uint24 fee) view returns(address)
+func (_Storage *StorageSession) Pool(tokenX common.Address, tokenY common.Address, fee *big.Int) (common.Address, error) {
+	return _Storage.Contract.Pool(&_Storage.CallOpts, tokenX, tokenY, fee)
+}
+
+// Pool is a free data retrieval call binding the contract method 0xbecbcc6a.
+//
+// Solidity: function pool(address tokenX, address tokenY, uint24 fee) view returns(address)
+func (_Storage *StorageCallerSession) Pool(tokenX common.Address, tokenY common.Address, fee *big.Int) (common.Address, error) {
+	return _Storage.Contract.Pool(&_Storage.CallOpts, tokenX, tokenY, fee)
+}
+
+// PoolMetas is a free data retrieval call binding the contract method 0xf655dbc1.
+//
+// Solidity: function poolMetas(uint128 ) view returns(address tokenX, address tokenY, uint24 fee)
+func (_Storage *StorageCaller) PoolMetas(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	TokenX common.Address
+	TokenY common.Address
+	Fee    *big.Int
+}, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "poolMetas", arg0)
+
+	outstruct := new(struct {
+		TokenX common.Address
+		TokenY common.Address
+		Fee    *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.TokenX = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.TokenY = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Fee = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// PoolMetas is a free data retrieval call binding the contract method 0xf655dbc1.
+//
+// Solidity: function poolMetas(uint128 ) view returns(address tokenX, address tokenY, uint24 fee)
+func (_Storage *StorageSession) PoolMetas(arg0 *big.Int) (struct {
+	TokenX common.Address
+	TokenY common.Address
+	Fee    *big.Int
+}, error) {
+	return _Storage.Contract.PoolMetas(&_Storage.CallOpts, arg0)
+}
+
+// PoolMetas is a free data retrieval call binding the contract method 0xf655dbc1.
+//
+// Solidity: function poolMetas(uint128 ) view returns(address tokenX, address tokenY, uint24 fee)
+func (_Storage *StorageCallerSession) PoolMetas(arg0 *big.Int) (struct {
+	TokenX common.Address
+	TokenY common.Address
+	Fee    *big.Int
+}, error) {
+	return _Storage.Contract.PoolMetas(&_Storage.CallOpts, arg0)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_Storage *StorageCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_Storage *StorageSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _Storage.Contract.TokenOfOwnerByIndex(&_Storage.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_Storage *StorageCallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _Storage.Contract.TokenOfOwnerByIndex(&_Storage.CallOpts, owner, index)
+}
+
+// Collect is a paid mutator transaction binding the contract method 0xa0e4eb3c.
+//
+// Solidity: function collect(address recipient, uint256 lid, uint128 amountXLim, uint128 amountYLim) payable returns(uint256 amountX, uint256 amountY)
+func (_Storage *StorageTransactor) Collect(opts *bind.TransactOpts, recipient common.Address, lid *big.Int, amountXLim *big.Int, amountYLim *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "collect", recipient, lid, amountXLim, amountYLim)
+}
+
+// Collect is a paid mutator transaction binding the contract method 0xa0e4eb3c.
+//
+// Solidity: function collect(address recipient, uint256 lid, uint128 amountXLim, uint128 amountYLim) payable returns(uint256 amountX, uint256 amountY)
+func (_Storage *StorageSession) Collect(recipient common.Address, lid *big.Int, amountXLim *big.Int, amountYLim *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Collect(&_Storage.TransactOpts, recipient, lid, amountXLim, amountYLim)
+}
+
+// Collect is a paid mutator transaction binding the contract method 0xa0e4eb3c.
+//
+// Solidity: function collect(address recipient, uint256 lid, uint128 amountXLim, uint128 amountYLim) payable returns(uint256 amountX, uint256 amountY)
+func (_Storage *StorageTransactorSession) Collect(recipient common.Address, lid *big.Int, amountXLim *big.Int, amountYLim *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.Collect(&_Storage.TransactOpts, recipient, lid, amountXLim, amountYLim)
+}
+
+// DecLiquidity is a paid mutator transaction binding the contract method 0x15feae51.
+//
+// Solidity: function decLiquidity(uint256 lid, uint128 liquidDelta, uint256 amountXMin, uint256 amountYMin, uint256 deadline) returns(uint256 amountX, uint256 amountY)
+func (_Storage *StorageTransactor) DecLiquidity(opts *bind.TransactOpts, lid *big.Int, liquidDelta *big.Int, amountXMin *big.Int, amountYMin *big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "decLiquidity", lid, liquidDelta, amountXMin, amountYMin, deadline)
+}
+
+// DecLiquidity is a paid mutator transaction binding the contract method 0x15feae51.
+//
+// Solidity: function decLiquidity(uint256 lid, uint128 liquidDelta, uint256 amountXMin, uint256 amountYMin, uint256 deadline) returns(uint256 amountX, uint256 amountY)
+func (_Storage *StorageSession) DecLiquidity(lid *big.Int, liquidDelta *big.Int, amountXMin *big.Int, amountYMin *big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.DecLiquidity(&_Storage.TransactOpts, lid, liquidDelta, amountXMin, amountYMin, deadline)
+}
+
+// DecLiquidity is a paid mutator transaction binding the contract method 0x15feae51.
+//
+// Solidity: function decLiquidity(uint256 lid, uint128 liquidDelta, uint256 amountXMin, uint256 amountYMin, uint256 deadline) returns(uint256 amountX, uint256 amountY)
+func (_Storage *StorageTransactorSession) DecLiquidity(lid *big.Int, liquidDelta *big.Int, amountXMin *big.Int, amountYMin *big.Int, deadline *big.Int) (*types.Transaction, error) {
+	return _Storage.Contract.DecLiquidity(&_Storage.TransactOpts, lid, liquidDelta, amountXMin, amountYMin, deadline)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x96f639ed.
+//
+// Solidity: function mint((address,address,address,uint24,int24,int24,uint128,uint128,uint128,uint128,uint256) mintParam) payable returns(uint256 lid, uint128 liquidity, uint256 amountX, uint256 amountY)
+func (_Storage *StorageTransactor) Mint(opts *bind.TransactOpts, mintParam LiquidityManagerMintParam) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "mint", mintParam)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x96f639ed.
+//
+// Solidity: function mint((address,address,address,uint24,int24,int24,uint128,uint128,uint128,uint128,uint256) mintParam) payable returns(uint256 lid, uint128 liquidity, uint256 amountX, uint256 amountY)
+func (_Storage *StorageSession) Mint(mintParam LiquidityManagerMintParam) (*types.Transaction, error) {
+	return _Storage.Contract.Mint(&_Storage.TransactOpts, mintParam)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x96f639ed.
+//
+// Solidity: function mint((address,address,address,uint24,int24,int24,uint128,uint128,uint128,uint128,uint256) mintParam) payable returns(uint256 lid, uint128 liquidity, uint256 amountX, uint256 amountY)
+func (_Storage *StorageTransactorSession) Mint(mintParam LiquidityManagerMintParam) (*types.Transaction, error) {
+	return _Storage.Contract.Mint(&_Storage.TransactOpts, mintParam)
+}
+
+// Multicall is a paid mutator transaction binding the contract method 0xac9650d8.
+//
+// Solidity: function multicall(bytes[] data) payable returns(bytes[] results)
+func (_Storage *StorageTransactor) Multicall(opts *bind.TransactOpts, data [][]byte) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "multicall", data)
+}
+
+// Multicall is a paid mutator transaction binding the contract method 0xac9650d8.
+//
+// Solidity: function multicall(bytes[] data) payable returns(bytes[] results)
+func (_Storage *StorageSession) Multicall(data [][]byte) (*types.Transaction, error) {
+	return _Storage.Contract.Multicall(&_Storage.TransactOpts, data)
+}
+
+// Multicall is a paid mutator transaction binding the contract method 0xac9650d8.
+//
+// Solidity: function multicall(bytes[] data) payable returns(bytes[] results)
+func (_Storage *StorageTransactorSession) Multicall(data [][]byte) (*types.Transaction, error) {
+	return _Storage.Contract.Multicall(&_Storage.TransactOpts, data)
+}
+
+// RefundETH is a paid mutator transaction binding the contract method 0x12210e8a.
+//
+// Solidity: function refundETH() payable returns()
+func (_Storage *StorageTransactor) RefundETH(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "refundETH")
+}
+
+// RefundETH is a paid mutator transaction binding the contract method 0x12210e8a.
+//
+// Solidity: function refundETH() payable returns()
+func (_Storage *StorageSession) RefundETH() (*types.Transaction, error) {
+	return _Storage.Contract.RefundETH(&_Storage.TransactOpts)
+}
+
+// RefundETH is a paid mutator transaction binding the contract method 0x12210e8a.
+//
+// Solidity: function refundETH() payable returns()
+func (_Storage *StorageTransactorSession) RefundETH() (*types.Transaction, error) {
+	return _Storage.Contract.RefundETH(&_Storage.TransactOpts)
+}
+
+// SweepToken is a paid mutator transaction binding the contract method 0xdf2ab5bb.
+//
+// Solidity: function sweepToken(address token, uint256 minAmount, address recipient) payable returns()
+func (_Storage *StorageTransactor) SweepToken(opts *bind.TransactOpts, token common.Address, minAmount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "sweepToken", token, minAmount, recipient)
+}
+
+// SweepToken is a paid mutator transaction binding the contract method 0xdf2ab5bb.
+//
+// Solidity: function sweepToken(address token, uint256 minAmount, address recipient) payable returns()
+func (_Storage *StorageSession) SweepToken(token common.Address, minAmount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _Storage.Contract.SweepToken(&_Storage.TransactOpts, token, minAmount, recipient)
+}
+
+// SweepToken is a paid mutator transaction binding the contract method 0xdf2ab5bb.
+//
+// Solidity: function sweepToken(address token, uint256 minAmount, address recipient) payable returns()
+func (_Storage *StorageTransactorSession) SweepToken(token common.Address, minAmount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _Storage.Contract.SweepToken(&_Storage.TransactOpts, token, minAmount, recipient)
+}
+
+// UnwrapWETH9 is a paid mutator transaction binding the contract method 0x49404b7c.
+//
+// Solidity: function unwrapWETH9(uint256 minAmount, address recipient) payable returns()
+func (_Storage *StorageTransactor) UnwrapWETH9(opts *bind.TransactOpts, minAmount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _Storage.contract.Transact(opts, "unwrapWETH9", minAmount, recipient)
+}
+
+// UnwrapWETH9 is a paid mutator transaction binding the contract method 0x49404b7c.
+//
+// Solidity: function unwrapWETH9(uint256 minAmount, address recipient) payable returns()
+func (_Storage *StorageSession) UnwrapWETH9(minAmount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _Storage.Contract.UnwrapWETH9(&_Storage.TransactOpts, minAmount, recipient)
+}
+
+// UnwrapWETH9 is a paid mutator transaction binding the contract method 0x49404b7c.
+//
+// Solidity: function unwrapWETH9(uint256 minAmount, address recipient) payable returns()
+func (_Storage *StorageTransactorSession) UnwrapWETH9(minAmount *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _Storage.Contract.UnwrapWETH9(&_Storage.TransactOpts, minAmount, recipient)
+}
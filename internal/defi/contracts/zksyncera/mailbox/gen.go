@@ -0,0 +1,4 @@
+package mailbox
+
+// Mailbox facet of the zkSync Era diamond proxy on ethereum 0x32400084C286CF3E17e7B677ea9583e60a000324
+//go:generate abigen --abi abi.json --pkg mailbox --type storage --out storage.go
@@ -0,0 +1,4 @@
+package maverickpool
+
+// https://docs.mav.xyz/guides/technical-reference/pool
+//go:generate abigen --abi abi.json --pkg maverickpool --type storage --out storage.go
@@ -0,0 +1,386 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package maverickpool
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IPoolBinState is an auto generated low-level Go binding around an user-defined struct.
+type IPoolBinState struct {
+	ReserveA        *big.Int
+	ReserveB        *big.Int
+	MergeBinBalance *big.Int
+	MergeId         *big.Int
+	TotalSupply     *big.Int
+	Kind            uint8
+	LowerTick       int32
+}
+
+// IPoolState is an auto generated low-level Go binding around an user-defined struct.
+type IPoolState struct {
+	ActiveTick       int32
+	Status           uint8
+	BinCounter       *big.Int
+	ProtocolFeeRatio uint64
+}
+
+// StorageMetaData contains all meta data concerning the Storage contract.
+var StorageMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint128\",\"name\":\"binId\",\"type\":\"uint128\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"lpToken\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int32\",\"name\":\"tick\",\"type\":\"int32\"},{\"internalType\":\"uint256\",\"name\":\"kind\",\"type\":\"uint256\"}],\"name\":\"binPositions\",\"outputs\":[{\"internalType\":\"uint128\",\"name\":\"\",\"type\":\"uint128\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint128\",\"name\":\"binId\",\"type\":\"uint128\"}],\"name\":\"getBin\",\"outputs\":[{\"components\":[{\"internalType\":\"uint128\",\"name\":\"reserveA\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"reserveB\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"mergeBinBalance\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"mergeId\",\"type\":\"uint128\"},{\"internalType\":\"uint128\",\"name\":\"totalSupply\",\"type\":\"uint128\"},{\"internalType\":\"uint8\",\"name\":\"kind\",\"type\":\"uint8\"},{\"internalType\":\"int32\",\"name\":\"lowerTick\",\"type\":\"int32\"}],\"internalType\":\"structIPool.BinState\",\"type\":\"tuple\",\"name\":\"bin\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getState\",\"outputs\":[{\"components\":[{\"internalType\":\"int32\",\"name\":\"activeTick\",\"type\":\"int32\"},{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"uint128\",\"name\":\"binCounter\",\"type\":\"uint128\"},{\"internalType\":\"uint64\",\"name\":\"protocolFeeRatio\",\"type\":\"uint64\"}],\"internalType\":\"structIPool.State\",\"type\":\"tuple\",\"name\":\"\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenA\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenB\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// StorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use StorageMetaData.ABI instead.
+var StorageABI = StorageMetaData.ABI
+
+// Storage is an auto generated Go binding around an Ethereum contract.
+type Storage struct {
+	StorageCaller     // Read-only binding to the contract
+	StorageTransactor // Write-only binding to the contract
+	StorageFilterer   // Log filterer for contract events
+}
+
+// StorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StorageSession struct {
+	Contract     *Storage          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StorageCallerSession struct {
+	Contract *StorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StorageTransactorSession struct {
+	Contract     *StorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StorageRaw struct {
+	Contract *Storage // Generic contract binding to access the raw methods on
+}
+
+// StorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StorageCallerRaw struct {
+	Contract *StorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StorageTransactorRaw struct {
+	Contract *StorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStorage creates a new instance of Storage, bound to a specific deployed contract.
+func NewStorage(address common.Address, backend bind.ContractBackend) (*Storage, error) {
+	contract, err := bindStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{StorageCaller: StorageCaller{contract: contract}, StorageTransactor: StorageTransactor{contract: contract}, StorageFilterer: StorageFilterer{contract: contract}}, nil
+}
+
+// NewStorageCaller creates a new read-only instance of Storage, bound to a specific deployed contract.
+func NewStorageCaller(address common.Address, caller bind.ContractCaller) (*StorageCaller, error) {
+	contract, err := bindStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageCaller{contract: contract}, nil
+}
+
+// NewStorageTransactor creates a new write-only instance of Storage, bound to a specific deployed contract.
+func NewStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*StorageTransactor, error) {
+	contract, err := bindStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageTransactor{contract: contract}, nil
+}
+
+// NewStorageFilterer creates a new log filterer instance of Storage, bound to a specific deployed contract.
+func NewStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*StorageFilterer, error) {
+	contract, err := bindStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageFilterer{contract: contract}, nil
+}
+
+// bindStorage binds a generic wrapper to an already deployed contract.
+func bindStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.StorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.StorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Storage *StorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Storage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Storage *StorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Storage *StorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Storage.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x6da3bf8b.
+//
+// Solidity: function balanceOf(uint256 tokenId, uint128 binId) view returns(uint256 lpToken)
+func (_Storage *StorageCaller) BalanceOf(opts *bind.CallOpts, tokenId *big.Int, binId *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "balanceOf", tokenId, binId)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x6da3bf8b.
+//
+// Solidity: function balanceOf(uint256 tokenId, uint128 binId) view returns(uint256 lpToken)
+func (_Storage *StorageSession) BalanceOf(tokenId *big.Int, binId *big.Int) (*big.Int, error) {
+	return _Storage.Contract.BalanceOf(&_Storage.CallOpts, tokenId, binId)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x6da3bf8b.
+//
+// Solidity: function balanceOf(uint256 tokenId, uint128 binId) view returns(uint256 lpToken)
+func (_Storage *StorageCallerSession) BalanceOf(tokenId *big.Int, binId *big.Int) (*big.Int, error) {
+	return _Storage.Contract.BalanceOf(&_Storage.CallOpts, tokenId, binId)
+}
+
+// BinPositions is a free data retrieval call binding the contract method 0x83f9c632.
+//
+// Solidity: function binPositions(int32 tick, uint256 kind) view returns(uint128)
+func (_Storage *StorageCaller) BinPositions(opts *bind.CallOpts, tick int32, kind *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "binPositions", tick, kind)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BinPositions is a free data retrieval call binding the contract method 0x83f9c632.
+//
+// Solidity: function binPositions(int32 tick, uint256 kind) view returns(uint128)
+func (_Storage *StorageSession) BinPositions(tick int32, kind *big.Int) (*big.Int, error) {
+	return _Storage.Contract.BinPositions(&_Storage.CallOpts, tick, kind)
+}
+
+// BinPositions is a free data retrieval call binding the contract method 0x83f9c632.
+//
+// Solidity: function binPositions(int32 tick, uint256 kind) view returns(uint128)
+func (_Storage *StorageCallerSession) BinPositions(tick int32, kind *big.Int) (*big.Int, error) {
+	return _Storage.Contract.BinPositions(&_Storage.CallOpts, tick, kind)
+}
+
+// GetBin is a free data retrieval call binding the contract method 0x44a185bb.
+//
+// Solidity: function getBin(uint128 binId) view returns((uint128,uint128,uint128,uint128,uint128,uint8,int32) bin)
+func (_Storage *StorageCaller) GetBin(opts *bind.CallOpts, binId *big.Int) (IPoolBinState, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "getBin", binId)
+
+	if err != nil {
+		return *new(IPoolBinState), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IPoolBinState)).(*IPoolBinState)
+
+	return out0, err
+
+}
+
+// GetBin is a free data retrieval call binding the contract method 0x44a185bb.
+//
+// Solidity: function getBin(uint128 binId) view returns((uint128,uint128,uint128,uint128,uint128,uint8,int32) bin)
+func (_Storage *StorageSession) GetBin(binId *big.Int) (IPoolBinState, error) {
+	return _Storage.Contract.GetBin(&_Storage.CallOpts, binId)
+}
+
+// GetBin is a free data retrieval call binding the contract method 0x44a185bb.
+//
+// Solidity: function getBin(uint128 binId) view returns((uint128,uint128,uint128,uint128,uint128,uint8,int32) bin)
+func (_Storage *StorageCallerSession) GetBin(binId *big.Int) (IPoolBinState, error) {
+	return _Storage.Contract.GetBin(&_Storage.CallOpts, binId)
+}
+
+// GetState is a free data retrieval call binding the contract method 0x1865c57d.
+//
+// Solidity: function getState() view returns((int32,uint8,uint128,uint64))
+func (_Storage *StorageCaller) GetState(opts *bind.CallOpts) (IPoolState, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "getState")
+
+	if err != nil {
+		return *new(IPoolState), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IPoolState)).(*IPoolState)
+
+	return out0, err
+
+}
+
+// GetState is a free data retrieval call binding the contract method 0x1865c57d.
+//
+// Solidity: function getState() view returns((int32,uint8,uint128,uint64))
+func (_Storage *StorageSession) GetState() (IPoolState, error) {
+	return _Storage.Contract.GetState(&_Storage.CallOpts)
+}
+
+// GetState is a free data retrieval call binding the contract method 0x1865c57d.
+//
+// Solidity: function getState() view returns((int32,uint8,uint128,uint64))
+func (_Storage *StorageCallerSession) GetState() (IPoolState, error) {
+	return _Storage.Contract.GetState(&_Storage.CallOpts)
+}
+
+// TokenA is a free data retrieval call binding the contract method 0x0fc63d10.
+//
+// Solidity: function tokenA() view returns(address)
+func (_Storage *StorageCaller) TokenA(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "tokenA")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenA is a free data retrieval call binding the contract method 0x0fc63d10.
+//
+// Solidity: function tokenA() view returns(address)
+func (_Storage *StorageSession) TokenA() (common.Address, error) {
+	return _Storage.Contract.TokenA(&_Storage.CallOpts)
+}
+
+// TokenA is a free data retrieval call binding the contract method 0x0fc63d10.
+//
+// Solidity: function tokenA() view returns(address)
+func (_Storage *StorageCallerSession) TokenA() (common.Address, error) {
+	return _Storage.Contract.TokenA(&_Storage.CallOpts)
+}
+
+// TokenB is a free data retrieval call binding the contract method 0x5f64b55b.
+//
+// Solidity: function tokenB() view returns(address)
+func (_Storage *StorageCaller) TokenB(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Storage.contract.Call(opts, &out, "tokenB")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenB is a free data retrieval call binding the contract method 0x5f64b55b.
+//
+// Solidity: function tokenB() view returns(address)
+func (_Storage *StorageSession) TokenB() (common.Address, error) {
+	return _Storage.Contract.TokenB(&_Storage.CallOpts)
+}
+
+// TokenB is a free data retrieval call binding the contract method 0x5f64b55b.
+//
+// Solidity: function tokenB() view returns(address)
+func (_Storage *StorageCallerSession) TokenB() (common.Address, error) {
+	return _Storage.Contract.TokenB(&_Storage.CallOpts)
+}
@@ -0,0 +1,4 @@
+package univ2factory
+
+// https://docs.uniswap.org/contracts/v2/reference/smart-contracts/factory
+//go:generate abigen --abi abi.json --pkg univ2factory --type storage --out storage.go
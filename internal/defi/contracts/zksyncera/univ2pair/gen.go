@@ -0,0 +1,4 @@
+package univ2pair
+
+// https://docs.uniswap.org/contracts/v2/reference/smart-contracts/pair
+//go:generate abigen --abi abi.json --pkg univ2pair --type storage --out storage.go
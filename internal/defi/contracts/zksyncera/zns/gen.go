@@ -0,0 +1,4 @@
+package zns
+
+// https://explorer.zksync.io/address/0xAE23B6E7f91DDeBD3B70d74d20583E3e674Bd94f#contract
+//go:generate abigen --abi abi.json --pkg zns --type storage --out storage.go
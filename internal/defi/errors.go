@@ -1,6 +1,8 @@
 package defi
 
 import (
+	"time"
+
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/pkg/errors"
 )
@@ -15,3 +17,29 @@ var (
 
 var ErrTxStatusFailed = errors.New("transaction failed")
 var ErrTxNotFound = errors.New("transaction is not found")
+var ErrTxPending = errors.New("transaction is still pending")
+var ErrTxNotPending = errors.New("transaction is not pending")
+var ErrTxReplaced = errors.New("transaction is replaced by another one with the same nonce")
+var ErrTxNotConfirmed = errors.New("transaction is not confirmed yet")
+var ErrTxReverted = errors.New("transaction reverts in simulation")
+var ErrNoApprovals = errors.New("wallet has no approvals to revoke")
+var ErrPermitNotSupported = errors.New("token does not support permit")
+var ErrExternalKeyNotSupported = errors.New("network signs with the private key only, the wallet key is held by the external signer")
+
+// errNotSent releases the reserved nonce when the tx is not sent
+var errNotSent = errors.New("tx is not sent")
+
+var ErrWithdrawalFinalized = errors.New("withdrawal is already finalized")
+
+// WithdrawalNotReady step of the official bridge withdrawal can not be done yet, ReadyAt is zero if unknown
+type WithdrawalNotReady struct {
+	Reason  string
+	ReadyAt time.Time
+}
+
+func (e *WithdrawalNotReady) Error() string {
+	if e.ReadyAt.IsZero() {
+		return "withdrawal is not ready: " + e.Reason
+	}
+	return "withdrawal is not ready: " + e.Reason + ", ready at " + e.ReadyAt.UTC().String()
+}
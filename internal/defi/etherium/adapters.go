@@ -14,6 +14,18 @@ func (c *Client) GetBalance(ctx context.Context, req *defi.GetBalanceReq) (*defi
 	return c.defi.GetBalance(ctx, req)
 }
 
+func (c *Client) GetBalances(ctx context.Context, req *defi.GetBalancesReq) (*defi.GetBalancesRes, error) {
+	return c.defi.GetBalances(ctx, req)
+}
+
+func (c *Client) Approvals(ctx context.Context, req *defi.ApprovalsReq) (*defi.ApprovalsRes, error) {
+	return c.defi.Approvals(ctx, req)
+}
+
+func (c *Client) RevokeApproval(ctx context.Context, req *defi.RevokeApprovalReq) (*bozdo.DefaultRes, error) {
+	return c.defi.RevokeApproval(ctx, req)
+}
+
 func (c *Client) TxViewFn(id string) string {
 	return c.defi.TxViewFn(id)
 }
@@ -68,3 +80,31 @@ func (c *Client) GetPublicKey(pk string, subType v1.ProfileSubType) (string, err
 func (c *Client) Network() v1.Network {
 	return c.defi.Cfg.Network
 }
+
+func (c *Client) LayerZeroBridge(ctx context.Context, req *defi.LayerZeroBridgeReq) (*bozdo.DefaultRes, error) {
+	return c.defi.LayerZeroBridge(ctx, req)
+}
+
+func (c *Client) StgLock(ctx context.Context, req *defi.StgLockReq) (*bozdo.DefaultRes, error) {
+	return c.defi.StgLock(ctx, req)
+}
+
+func (c *Client) StgExtendLock(ctx context.Context, req *defi.StgLockReq) (*bozdo.DefaultRes, error) {
+	return c.defi.StgExtendLock(ctx, req)
+}
+
+func (c *Client) StargateLiquidity(ctx context.Context, req *defi.StargateLiquidityReq) (*bozdo.DefaultRes, error) {
+	return c.defi.StargateLiquidity(ctx, req)
+}
+
+func (c *Client) StargateLiquidityAvailable(ctx context.Context, req *defi.StargateLiquidityAvailableReq) (*big.Int, error) {
+	return c.defi.StargateLiquidityAvailable(ctx, req)
+}
+
+func (c *Client) Bridge(ctx context.Context, req *defi.DefaultBridgeReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
+	return c.defi.Bridge(ctx, req, taskType)
+}
+
+func (c *Client) EVM() *defi.EtheriumClient {
+	return c.defi
+}
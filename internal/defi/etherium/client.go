@@ -22,13 +22,21 @@ var TokenAddress = map[defi.Token]common.Address{
 	v1.Token_USDT:  common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"),
 	v1.Token_STG:   common.HexToAddress("0xaf5191b0de278c7286d6c7cc6ab6bb8a73ba2cd6"),
 	v1.Token_USDC:  common.HexToAddress("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"),
-	v1.Token_veSTG: common.HexToAddress("0xB0D502E938ed5f4df2E681fE6E419ff29631d62b"),
+	v1.Token_veSTG: common.HexToAddress("0x0e42acBD23FAee03249DAFF896b78d7e79fBD58E"),
+	v1.Token_DAI:   common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+	v1.Token_FRAX:  common.HexToAddress("0x853d955aCEf822Db058eb8505911ED77F175b99e"),
+	v1.Token_USDD:  common.HexToAddress("0x0C10bF8FcB7Bf5412187A595ab97a3609160b5c6"),
+	v1.Token_sUSD:  common.HexToAddress("0x57Ab1ec28D129707052df4dF418D58a2D46d5f51"),
+	v1.Token_LUSD:  common.HexToAddress("0x5f98805A4E8be255a32880FDeC7F6728C6568bA0"),
+	v1.Token_MAI:   common.HexToAddress("0x8D6CeBD76f18E1558D4DB88138e2DeFB3909fAD6"),
+	v1.Token_METIS: common.HexToAddress("0x9E32b13ce7f2E80A01932B42553652E053D6ed8e"),
 }
 
 var Dict = defi.Dict{
 	Stargate: defi.Stargate{
 		StargateRouterAddress:    common.HexToAddress("0x8731d54E9D02c286767d56ac03e8037C07e01e98"),
 		StargateRouterEthAddress: common.HexToAddress("0x150f94B44927F078737562f0fcF3C95c01Cc2376"),
+		LPStakingAddress:         common.HexToAddress("0xB0D502E938ed5f4df2E681fE6E419ff29631d62b"),
 	},
 	TestNetBridgeSwapAddress: common.HexToAddress("0x0A9f824C05A74F577A536A8A0c673183a872Dff4"),
 }
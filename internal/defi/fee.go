@@ -0,0 +1,130 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// feeHistoryBlocks recent blocks the priority fee is taken from
+const feeHistoryBlocks = 10
+
+// baseFeeHeadroom the fee cap covers the base fee doubled, it takes 6 full blocks in a row to grow that much
+const baseFeeHeadroom = 2
+
+// feeSpeedPercentile percentile of the tips paid in the block
+var feeSpeedPercentile = map[v1.FeeSpeed]float64{
+	v1.FeeSpeed_FeeSlow:   10,
+	v1.FeeSpeed_FeeNormal: 50,
+	v1.FeeSpeed_FeeFast:   90,
+}
+
+type DynamicFee struct {
+	BaseFee *big.Int
+	Tip     *big.Int
+	FeeCap  *big.Int
+}
+
+// SuggestDynamicFee fee of the eip-1559 tx, nil if the network has no base fee
+func (c *EtheriumClient) SuggestDynamicFee(ctx context.Context, speed v1.FeeSpeed) (*DynamicFee, error) {
+	head, err := c.Cli.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "HeaderByNumber")
+	}
+	if head.BaseFee == nil {
+		return nil, nil
+	}
+
+	tip, err := c.priorityFee(ctx, speed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DynamicFee{
+		BaseFee: head.BaseFee,
+		Tip:     tip,
+		FeeCap:  bozdo.BigIntSum(new(big.Int).Mul(head.BaseFee, big.NewInt(baseFeeHeadroom)), tip),
+	}, nil
+}
+
+// priorityFee median over the recent blocks of the tip percentile, the node suggestion if it has no fee history
+func (c *EtheriumClient) priorityFee(ctx context.Context, speed v1.FeeSpeed) (*big.Int, error) {
+	percentile, ok := feeSpeedPercentile[speed]
+	if !ok {
+		percentile = feeSpeedPercentile[v1.FeeSpeed_FeeNormal]
+	}
+
+	history, err := c.Cli.FeeHistory(ctx, feeHistoryBlocks, nil, []float64{percentile})
+	if err == nil {
+		tips := make([]*big.Int, 0, len(history.Reward))
+		for _, r := range history.Reward {
+			if len(r) > 0 && r[0] != nil {
+				tips = append(tips, r[0])
+			}
+		}
+		if len(tips) > 0 {
+			sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+			return tips[len(tips)/2], nil
+		}
+	}
+
+	tip, err := c.Cli.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "SuggestGasTipCap")
+	}
+	return tip, nil
+}
+
+// maxGasPrice price per gas the tx pays at most, the gas rule overrides the suggested one
+func maxGasPrice(gasPrice *big.Int, fee *DynamicFee, gas *bozdo.Gas) *big.Int {
+	if gas.RuleSet() {
+		return &gas.GasPrice
+	}
+	if fee != nil {
+		return fee.FeeCap
+	}
+	return gasPrice
+}
+
+// dynamicFeeTx the fee cap is the one of the gas rule if it is set, the tip never exceeds it
+func dynamicFeeTx(chainId *big.Int, fee *DynamicFee, gas *bozdo.Gas, tx *types.LegacyTx) *types.Transaction {
+	feeCap, tip := maxGasPrice(nil, fee, gas), fee.Tip
+	if tip.Cmp(feeCap) > 0 {
+		tip = feeCap
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainId,
+		Nonce:     tx.Nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       tx.Gas,
+		To:        tx.To,
+		Value:     tx.Value,
+		Data:      tx.Data,
+	})
+}
+
+// estimatedGasCost the total is the fee cap one, the wallet must hold it for the node to accept the tx
+func estimatedGasCost(gasLimit uint64, gasPrice *big.Int, fee *DynamicFee) *bozdo.EstimatedGasCost {
+	if fee == nil {
+		return &bozdo.EstimatedGasCost{
+			Type:        bozdo.TxTypeLegacy,
+			GasLimit:    new(big.Int).SetUint64(gasLimit),
+			GasPrice:    gasPrice,
+			TotalGasWei: MinerGasLegacy(gasPrice, gasLimit),
+		}
+	}
+	return &bozdo.EstimatedGasCost{
+		Type:        bozdo.TxTypeDynamic,
+		GasLimit:    new(big.Int).SetUint64(gasLimit),
+		GasPrice:    fee.FeeCap,
+		TotalGasWei: MinerGasLegacy(fee.FeeCap, gasLimit),
+		BaseFee:     fee.BaseFee,
+		PriorityFee: fee.Tip,
+	}
+}
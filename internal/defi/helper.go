@@ -37,7 +37,8 @@ func AmountUni(wei *big.Int, network v1.Network) *v1.AmUni {
 	switch network {
 	case v1.Network_ZKSYNCERA, v1.Network_ZKSYNCLITE,
 		v1.Network_Etherium, v1.Network_ARBITRUM,
-		v1.Network_OPTIMISM, v1.Network_GOERLIETH:
+		v1.Network_OPTIMISM, v1.Network_GOERLIETH,
+		v1.Network_Base, v1.Network_Linea:
 		gasTokenPrice = pub.Price().ETH
 	case v1.Network_POLIGON:
 		gasTokenPrice = pub.Price().MATIC
@@ -45,6 +46,8 @@ func AmountUni(wei *big.Int, network v1.Network) *v1.AmUni {
 		gasTokenPrice = pub.Price().BNB
 	case v1.Network_AVALANCHE:
 		gasTokenPrice = pub.Price().AVAX
+	case v1.Network_Metis:
+		gasTokenPrice = pub.Price().METIS
 	}
 
 	amEth := WEIToEther(wei)
@@ -28,6 +28,18 @@ type Balancer interface {
 	GetNetworkToken() Token
 }
 
+// BatchBalancer reads the balances of many tokens of many wallets in one request
+type BatchBalancer interface {
+	GetBalances(ctx context.Context, req *GetBalancesReq) (*GetBalancesRes, error)
+}
+
+// Approver audits and revokes the token approvals of the wallet
+type Approver interface {
+	Networker
+	Approvals(ctx context.Context, req *ApprovalsReq) (*ApprovalsRes, error)
+	RevokeApproval(ctx context.Context, req *RevokeApprovalReq) (*bozdo.DefaultRes, error)
+}
+
 type MintAndBridgeNFT interface {
 	Networker
 	MerklyMintNft(ctx context.Context, req *merkly.MintNFTReq) (*bozdo.DefaultRes, *big.Int, *big.Int, error)
@@ -39,6 +51,11 @@ type StargateSwapper interface {
 	Networker
 	StargateBridgeSwap(ctx context.Context, req *DefaultBridgeReq) (*bozdo.DefaultRes, error)
 }
+type Bridger interface {
+	Networker
+	Bridge(ctx context.Context, req *DefaultBridgeReq, taskType v1.TaskType) (*bozdo.DefaultRes, error)
+}
+
 type TestNetworkBridgeSwapper interface {
 	Networker
 	TestNetBridgeSwap(ctx context.Context, req *TestNetBridgeSwapReq) (*TestNetBridgeSwapRes, error)
@@ -65,6 +82,8 @@ type DefaultSwapReq struct {
 	Slippage     SlippagePercent
 	Debug        bool
 	SubType      v1.ProfileSubType
+	// FeeToken zkSync Era pays the fee in the token through the paymaster, in eth if nil
+	FeeToken *v1.Token
 }
 
 type DefaultBridgeReq struct {
@@ -86,6 +105,7 @@ const (
 	CodeApprove  TxCode = "approve"
 	CodeContract TxCode = "contract"
 	CodeTransfer TxCode = "transfer"
+	CodeLend     TxCode = "lend"
 )
 
 func (c *EtheriumClient) NewTx(id common.Hash, code TxCode, details []bozdo.TxDetail) *bozdo.Transaction {
@@ -144,3 +164,114 @@ type LiquidityBridgeReq struct {
 	FromSubType  v1.ProfileSubType
 	ToSubType    v1.ProfileSubType
 }
+
+type Lender interface {
+	Networker
+	Lend(ctx context.Context, req *LendReq, taskType v1.TaskType) (*bozdo.DefaultRes, error)
+	LendWithdrawable(ctx context.Context, req *LendWithdrawableReq) (*big.Int, error)
+}
+
+type LendReq struct {
+	Network      v1.Network
+	Protocol     v1.LendProtocol
+	Token        v1.Token
+	Amount       *big.Int
+	WalletPK     string
+	EstimateOnly bool
+	Gas          *bozdo.Gas
+	Debug        bool
+}
+
+type LendWithdrawableReq struct {
+	Protocol      v1.LendProtocol
+	Token         v1.Token
+	WalletAddress common.Address
+}
+
+type DomainMinter interface {
+	Networker
+	DomainAvailable(ctx context.Context, name string, taskType v1.TaskType) (bool, error)
+	// DomainPrice network token wei the domain registration is paid with along with the gas
+	DomainPrice(ctx context.Context, taskType v1.TaskType) (*big.Int, error)
+	MintDomain(ctx context.Context, req *MintDomainReq, taskType v1.TaskType) (*bozdo.DefaultRes, error)
+}
+
+type MintDomainReq struct {
+	// Name without top level domain
+	Name         string
+	WalletPK     string
+	SubType      v1.ProfileSubType
+	EstimateOnly bool
+	Gas          *bozdo.Gas
+}
+
+type LayerZeroBridger interface {
+	Networker
+	LayerZeroBridge(ctx context.Context, req *LayerZeroBridgeReq) (*bozdo.DefaultRes, error)
+}
+
+type LayerZeroBridgeReq struct {
+	Kind       v1.LayerZeroContract
+	Contract   common.Address
+	DstChainId uint16
+	// Amount oft amount resolved against the wallet balance of the token
+	Amount *v1.Amount
+	// TokenId onft to send, the last owned one if nil
+	TokenId      *big.Int
+	WalletPK     string
+	EstimateOnly bool
+	Gas          *bozdo.Gas
+}
+
+type StgStaker interface {
+	Networker
+	StgLock(ctx context.Context, req *StgLockReq) (*bozdo.DefaultRes, error)
+	StgExtendLock(ctx context.Context, req *StgLockReq) (*bozdo.DefaultRes, error)
+}
+
+type StgLockReq struct {
+	// Amount stg to lock resolved against the wallet balance, not used to extend the lock
+	Amount *v1.Amount
+	// LockDays lock duration from now, the max lock time of veSTG if 0
+	LockDays     int64
+	WalletPK     string
+	EstimateOnly bool
+	Gas          *bozdo.Gas
+}
+
+type StargateLiquidityProvider interface {
+	Networker
+	StargateLiquidity(ctx context.Context, req *StargateLiquidityReq) (*bozdo.DefaultRes, error)
+	StargateLiquidityAvailable(ctx context.Context, req *StargateLiquidityAvailableReq) (*big.Int, error)
+}
+
+type StargateLiquidityReq struct {
+	Action v1.StargateLiquidityAction
+	Token  v1.Token
+	// Amount token to add, lp to stake, unstake or redeem
+	Amount *big.Int
+	// DstNetwork chain of the pool the delayed redeem is checked against
+	DstNetwork   v1.Network
+	WalletPK     string
+	EstimateOnly bool
+	Gas          *bozdo.Gas
+}
+
+type StargateLiquidityAvailableReq struct {
+	Action        v1.StargateLiquidityAction
+	Token         v1.Token
+	WalletAddress common.Address
+}
+
+// EVM client of the network built on EtheriumClient, for tasks working with ethereum and the rollup at once
+type EVM interface {
+	Networker
+	EVM() *EtheriumClient
+}
+
+type OfficialBridgeReq struct {
+	Amount       *big.Int
+	WalletPK     string
+	EstimateOnly bool
+	Gas          *bozdo.Gas
+}
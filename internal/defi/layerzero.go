@@ -0,0 +1,95 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/bridge/layerzero"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// LayerZeroBridge sends tokens of any oft or onft contract to the same wallet in the destination chain
+func (c *EtheriumClient) LayerZeroBridge(ctx context.Context, req *LayerZeroBridgeReq) (*bozdo.DefaultRes, error) {
+
+	result := &bozdo.DefaultRes{}
+
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	sendReq := &layerzero.SendReq{
+		Kind:       req.Kind,
+		Contract:   req.Contract,
+		DstChainId: req.DstChainId,
+		Wallet:     tr.WalletAddr,
+		Network:    c.Cfg.Network,
+		MainToken:  c.Cfg.MainToken,
+		TokenId:    req.TokenId,
+	}
+
+	if req.Kind == v1.LayerZeroContract_ONFT {
+		if sendReq.TokenId == nil {
+			sendReq.TokenId, err = layerzero.LastONFT(ctx, c.Cli, req.Contract, tr.WalletAddr)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		balance, err := layerzero.OFTBalance(ctx, c.Cli, req.Kind, req.Contract, tr.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+		sendReq.Amount, err = ResolveAmount(req.Amount, balance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txData, err := layerzero.MakeSendTx(ctx, c.Cli, sendReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "layerzero.MakeSendTx")
+	}
+
+	if txData.Approve != nil {
+		if err := RegisterSpender(ctx, c.Cfg.Network, Spender{Name: "LayerZero OFT adapter", Address: txData.Approve.Spender}); err != nil {
+			return nil, err
+		}
+		approveTx, err := c.approveTokenAddress(ctx, tr, txData.Approve.Token, txData.Approve.Spender, txData.Approve.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if approveTx != nil {
+			if err := c.WaitTxComplete(ctx, approveTx.Hash()); err != nil {
+				return nil, err
+			}
+			result.ApproveTx = c.NewTx(approveTx.Hash(), CodeApprove, nil)
+		}
+	}
+
+	value := txData.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	ecost, tx, err := c.sendContractCall(ctx, tr, &contractCall{
+		To:    txData.ContractAddr,
+		Value: value,
+		Data:  txData.Data,
+	}, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = "layerzero bridge"
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeContract, txData.Details)
+
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package linea
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// docs https://docs.linea.build/use-linea/info-contracts
+
+const (
+	MainNetURL = "https://rpc.linea.build"
+)
+
+var TokenAddress = map[defi.Token]common.Address{
+	v1.Token_STG: common.HexToAddress("0x808d7c71ad2ba3FA531b068a2417C63106BC0949"),
+	v1.Token_ETH: common.HexToAddress("0x0000000000000000000000000000000000000000"),
+}
+
+var Dict = defi.Dict{
+	Stargate: defi.Stargate{
+		StargateRouterAddress:    common.HexToAddress("0x2F6F07CDcf3588944Bf4C42aC74ff24bF56e7590"),
+		StargateRouterEthAddress: common.HexToAddress("0x8731d54E9D02c286767d56ac03e8037C07e01e98"),
+		LPStakingAddress:         common.HexToAddress("0x4a364f8c717cAAD9A442737Eb7b8A55cc6cf18D8"),
+	},
+}
+
+type Client struct {
+	defi      *defi.EtheriumClient
+	NetworkId *big.Int
+}
+
+type ClientConfig struct {
+	HttpCli     *http.Client
+	RPCEndpoint string
+}
+
+func TxViewer(txId string) string {
+	return "https://lineascan.build/tx/" + txId
+}
+
+func NewClient(c *ClientConfig) (*Client, error) {
+
+	config := &ClientConfig{
+		HttpCli: &http.Client{},
+	}
+	if c != nil {
+		config = c
+	}
+
+	ethcli, err := defi.NewEVMClient(&defi.ClientConfig{
+		Network:   v1.Network_Linea,
+		MainToken: v1.Token_ETH,
+		MainNet:   c.RPCEndpoint,
+		TokenMap:  TokenAddress,
+		Dict:      &Dict,
+		Httpcli:   config.HttpCli,
+		TxViewFn:  TxViewer,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to connect to ethereum main: "+c.RPCEndpoint)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	networkId, err := ethcli.GetNetworkId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{defi: ethcli, NetworkId: networkId}, nil
+}
@@ -0,0 +1,80 @@
+package metis
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// docs https://docs.metis.io/dev/get-started/metis-connection-details
+
+const (
+	MainNetURL = "https://andromeda.metis.io/?owner=1088"
+)
+
+// METIS native token is an erc20 as well
+var TokenAddress = map[defi.Token]common.Address{
+	v1.Token_USDT:  common.HexToAddress("0xbB06DCA3AE6887fAbF931640f67cab3e3a16F4dC"),
+	v1.Token_METIS: common.HexToAddress("0xDeadDeAddeAddEAddeadDEaDDEAdDeaDDeAD0000"),
+}
+
+var Dict = defi.Dict{
+	Stargate: defi.Stargate{
+		StargateRouterAddress:    common.HexToAddress("0x2F6F07CDcf3588944Bf4C42aC74ff24bF56e7590"),
+		StargateRouterEthAddress: common.HexToAddress(""),
+		LPStakingAddress:         common.HexToAddress("0x45A01E4e04F14f7A4a6702c74187c5F6222033cd"),
+	},
+}
+
+type Client struct {
+	defi      *defi.EtheriumClient
+	NetworkId *big.Int
+}
+
+type ClientConfig struct {
+	HttpCli     *http.Client
+	RPCEndpoint string
+}
+
+func TxViewer(txId string) string {
+	return "https://andromeda-explorer.metis.io/tx/" + txId
+}
+
+func NewClient(c *ClientConfig) (*Client, error) {
+
+	config := &ClientConfig{
+		HttpCli: &http.Client{},
+	}
+	if c != nil {
+		config = c
+	}
+
+	ethcli, err := defi.NewEVMClient(&defi.ClientConfig{
+		Network:   v1.Network_Metis,
+		MainToken: v1.Token_METIS,
+		MainNet:   c.RPCEndpoint,
+		TokenMap:  TokenAddress,
+		Dict:      &Dict,
+		Httpcli:   config.HttpCli,
+		TxViewFn:  TxViewer,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to connect to ethereum main: "+c.RPCEndpoint)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	networkId, err := ethcli.GetNetworkId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{defi: ethcli, NetworkId: networkId}, nil
+}
@@ -0,0 +1,245 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// Multicall3Address the same on all the evm networks, zkSync Era has its own
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// multicallBatchSize calls per eth_call, the node limits the gas of the call
+const multicallBatchSize = 300
+
+const multicall3ABI = `[
+{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"},
+{"inputs":[{"internalType":"address","name":"addr","type":"address"}],"name":"getEthBalance","outputs":[{"internalType":"uint256","name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+var (
+	multicallABIsOnce sync.Once
+	multicallABI      abi.ABI
+	erc20ABI          abi.ABI
+	multicallABIsErr  error
+)
+
+func loadMulticallABIs() {
+	multicallABI, multicallABIsErr = abi.JSON(strings.NewReader(multicall3ABI))
+	if multicallABIsErr != nil {
+		return
+	}
+	erc20ABI, multicallABIsErr = abi.JSON(strings.NewReader(erc_20.StorageMetaData.ABI))
+}
+
+type Call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type Call3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// Multicall3 runs the calls through the multicall contract, one eth_call per batch of them
+func Multicall3(ctx context.Context, caller bind.ContractCaller, multicall common.Address, calls []Call3) ([]Call3Result, error) {
+	multicallABIsOnce.Do(loadMulticallABIs)
+	if multicallABIsErr != nil {
+		return nil, multicallABIsErr
+	}
+
+	res := make([]Call3Result, 0, len(calls))
+	for start := 0; start < len(calls); start += multicallBatchSize {
+		end := start + multicallBatchSize
+		if end > len(calls) {
+			end = len(calls)
+		}
+
+		data, err := multicallABI.Pack("aggregate3", calls[start:end])
+		if err != nil {
+			return nil, errors.Wrap(err, "aggregate3 pack")
+		}
+		out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &multicall, Data: data}, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "aggregate3")
+		}
+		unpacked, err := multicallABI.Unpack("aggregate3", out)
+		if err != nil {
+			return nil, errors.Wrap(err, "aggregate3 unpack")
+		}
+		batch := *abi.ConvertType(unpacked[0], new([]Call3Result)).(*[]Call3Result)
+		if len(batch) != end-start {
+			return nil, errors.New("aggregate3 returned less results than calls")
+		}
+		res = append(res, batch...)
+	}
+	return res, nil
+}
+
+type GetBalancesReq struct {
+	Wallets []common.Address
+	Tokens  []Token
+	// Spender the allowances of the tokens to it are read too, nil if not needed
+	Spender *common.Address
+}
+
+type WalletBalance struct {
+	Wallet  common.Address
+	Balance *GetBalanceRes
+	// Allowance of the token to the spender, nil for the network token or without the spender
+	Allowance *big.Int
+	Err       error
+}
+
+type GetBalancesRes struct {
+	Balances []*WalletBalance
+}
+
+// Get balance of the token of the wallet, the error if it is not read
+func (r *GetBalancesRes) Get(wallet common.Address, token Token) (*WalletBalance, error) {
+	for _, b := range r.Balances {
+		if b.Wallet == wallet && b.Balance.Req.Token == token {
+			if b.Err != nil {
+				return nil, b.Err
+			}
+			return b, nil
+		}
+	}
+	return nil, errors.New("balance is not requested: " + token.String())
+}
+
+// BalanceSource network the balances are read from in one request
+type BalanceSource struct {
+	Caller    bind.ContractCaller
+	Multicall common.Address
+	Network   Network
+	MainToken Token
+	TokenMap  map[Token]common.Address
+}
+
+type balanceCall struct {
+	balance   *WalletBalance
+	allowance bool
+}
+
+// MulticallBalances balances and allowances of all the tokens of all the wallets, the token failed to be read has
+// the error in its balance
+func MulticallBalances(ctx context.Context, src *BalanceSource, req *GetBalancesReq) (*GetBalancesRes, error) {
+	multicallABIsOnce.Do(loadMulticallABIs)
+	if multicallABIsErr != nil {
+		return nil, multicallABIsErr
+	}
+
+	res := &GetBalancesRes{}
+	calls := make([]Call3, 0)
+	targets := make([]balanceCall, 0)
+	for _, wallet := range req.Wallets {
+		for _, token := range req.Tokens {
+			b := &WalletBalance{Wallet: wallet, Balance: &GetBalanceRes{Req: &GetBalanceReq{WalletAddress: wallet.String(), Token: token}}}
+			res.Balances = append(res.Balances, b)
+
+			if token == src.MainToken {
+				data, err := multicallABI.Pack("getEthBalance", wallet)
+				if err != nil {
+					return nil, err
+				}
+				calls = append(calls, Call3{Target: src.Multicall, AllowFailure: true, CallData: data})
+				targets = append(targets, balanceCall{balance: b})
+				continue
+			}
+
+			addr, ok := src.TokenMap[token]
+			if !ok {
+				b.Err = ErrTokenNotSupportedFn(token)
+				continue
+			}
+			data, err := erc20ABI.Pack("balanceOf", wallet)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, Call3{Target: addr, AllowFailure: true, CallData: data})
+			targets = append(targets, balanceCall{balance: b})
+
+			if req.Spender != nil {
+				data, err := erc20ABI.Pack("allowance", wallet, *req.Spender)
+				if err != nil {
+					return nil, err
+				}
+				calls = append(calls, Call3{Target: addr, AllowFailure: true, CallData: data})
+				targets = append(targets, balanceCall{balance: b, allowance: true})
+			}
+		}
+	}
+
+	results, err := Multicall3(ctx, src.Caller, src.Multicall, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range results {
+		t := targets[i]
+		if t.balance.Err != nil {
+			continue
+		}
+		if !r.Success || len(r.ReturnData) < 32 {
+			t.balance.Err = errors.New("balance call failed: " + t.balance.Balance.Req.Token.String())
+			continue
+		}
+		v := new(big.Int).SetBytes(r.ReturnData[:32])
+		if t.allowance {
+			t.balance.Allowance = v
+		} else {
+			t.balance.Balance = balanceRes(t.balance.Balance.Req, v, src.MainToken, src.Network)
+		}
+	}
+
+	return res, nil
+}
+
+// GetBalances balances of the tokens of the wallets in one request
+func (c *EtheriumClient) GetBalances(ctx context.Context, req *GetBalancesReq) (*GetBalancesRes, error) {
+	return MulticallBalances(ctx, &BalanceSource{
+		Caller:    c.Cli,
+		Multicall: Multicall3Address,
+		Network:   c.Cfg.Network,
+		MainToken: c.Cfg.MainToken,
+		TokenMap:  c.Cfg.TokenMap,
+	}, req)
+}
+
+// balanceRes the balance formatted as the GetBalance one of the network
+func balanceRes(req *GetBalanceReq, b *big.Int, mainToken Token, network Network) *GetBalanceRes {
+	f, _ := WEIToEther(b).Float64()
+	if req.Token == mainToken {
+		return &GetBalanceRes{
+			Req:           req,
+			WEI:           b,
+			ETHER:         WEIToEther(b),
+			HumanReadable: WEIToEther(b).String(),
+			Float:         f,
+		}
+	}
+
+	res := &GetBalanceRes{
+		Req:           req,
+		WEI:           b,
+		ETHER:         WeiToToken(b, req.Token),
+		HumanReadable: WeiToToken(b, req.Token).String(),
+		Float:         f,
+	}
+	if network == v1.Network_BinanaceBNB {
+		res.HumanReadable = WEIToEther(b).String()
+	}
+	return res
+}
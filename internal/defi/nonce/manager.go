@@ -0,0 +1,171 @@
+package nonce
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// gapTimeout sent tx the node still does not count in the pending nonce is considered dropped, its nonce is reused
+const gapTimeout = 3 * time.Minute
+
+var ErrNonceTaken = errors.New("nonce is taken by another transaction")
+
+// Reader node the pending nonce of the wallet is read from
+type Reader interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+type key struct {
+	network v1.Network
+	addr    common.Address
+}
+
+type wallet struct {
+	// pending nonce of the node on the last reconcile
+	pending uint64
+	held    map[uint64]struct{}
+	sent    map[uint64]time.Time
+}
+
+// Manager hands out nonces of the wallets, so the txs sent one after another or by the parallel tasks of the same
+// wallet in the network do not collide. It is reconciled with the pending nonce of the node on every request
+type Manager struct {
+	mu      sync.Mutex
+	wallets map[key]*wallet
+	now     func() time.Time
+}
+
+// Default manager shared by all the clients
+var Default = NewManager()
+
+func NewManager() *Manager {
+	return &Manager{
+		wallets: map[key]*wallet{},
+		now:     time.Now,
+	}
+}
+
+// Next nonce the tx of the wallet should be signed with, it is not held until Claim
+func (m *Manager) Next(ctx context.Context, network v1.Network, addr common.Address, r Reader) (uint64, error) {
+	pending, err := r.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return 0, errors.Wrap(err, "PendingNonceAt")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.reconcile(key{network: network, addr: addr}, pending).free(), nil
+}
+
+// Reserve holds the next nonce of the wallet until the reservation is done
+func (m *Manager) Reserve(ctx context.Context, network v1.Network, addr common.Address, r Reader) (*Reservation, error) {
+	pending, err := r.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "PendingNonceAt")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k := key{network: network, addr: addr}
+	w := m.reconcile(k, pending)
+	n := w.free()
+	w.held[n] = struct{}{}
+	return &Reservation{m: m, key: k, Nonce: n}, nil
+}
+
+// Claim holds the nonce the tx is already signed with, ErrNonceTaken if another tx has it
+func (m *Manager) Claim(network v1.Network, addr common.Address, n uint64) (*Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	k := key{network: network, addr: addr}
+	w := m.wallet(k)
+	_, held := w.held[n]
+	_, sent := w.sent[n]
+	if held || sent || n < w.pending {
+		return nil, ErrNonceTaken
+	}
+	w.held[n] = struct{}{}
+	return &Reservation{m: m, key: k, Nonce: n}, nil
+}
+
+func (m *Manager) wallet(k key) *wallet {
+	w, ok := m.wallets[k]
+	if !ok {
+		w = &wallet{held: map[uint64]struct{}{}, sent: map[uint64]time.Time{}}
+		m.wallets[k] = w
+	}
+	return w
+}
+
+// reconcile forgets the sent txs the node already counts and the ones it lost, the lost nonces are handed out again
+// filling the gap the later txs of the wallet are stuck behind
+func (m *Manager) reconcile(k key, pending uint64) *wallet {
+	w := m.wallet(k)
+	w.pending = pending
+	for n, at := range w.sent {
+		if n < pending {
+			delete(w.sent, n)
+		}
+		if n == pending && m.now().Sub(at) > gapTimeout {
+			delete(w.sent, n)
+		}
+	}
+	return w
+}
+
+func (w *wallet) free() uint64 {
+	n := w.pending
+	for {
+		_, held := w.held[n]
+		_, sent := w.sent[n]
+		if !held && !sent {
+			return n
+		}
+		n++
+	}
+}
+
+// Reservation nonce held for the tx being sent
+type Reservation struct {
+	m     *Manager
+	key   key
+	Nonce uint64
+	done  bool
+}
+
+// Done releases the nonce with the result of sending the tx. The nonce stays used when the tx is sent or the node
+// already has a tx with it, otherwise it is handed out again. Nil reservation of the estimation is a no-op
+func (r *Reservation) Done(err error) {
+	if r == nil {
+		return
+	}
+	r.m.mu.Lock()
+	defer r.m.mu.Unlock()
+	if r.done {
+		return
+	}
+	r.done = true
+
+	w := r.m.wallet(r.key)
+	delete(w.held, r.Nonce)
+	if err == nil || IsTaken(err) {
+		w.sent[r.Nonce] = r.m.now()
+	}
+}
+
+// IsTaken the node refused the tx because its nonce is used by another one
+func IsTaken(err error) bool {
+	if err == nil {
+		return false
+	}
+	s := strings.ToLower(err.Error())
+	return strings.Contains(s, "already known") ||
+		strings.Contains(s, "replacement transaction underpriced") ||
+		strings.Contains(s, "nonce too low")
+}
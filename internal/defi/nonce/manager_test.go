@@ -0,0 +1,77 @@
+package nonce
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+type node uint64
+
+func (n *node) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return uint64(*n), nil
+}
+
+func TestManager(t *testing.T) {
+	ctx := context.Background()
+	addr := common.HexToAddress("0x1")
+	now := time.Now()
+	m := NewManager()
+	m.now = func() time.Time { return now }
+	pending := node(5)
+
+	approve, err := m.Reserve(ctx, v1.Network_ARBITRUM, addr, &pending)
+	assert.NoError(t, err)
+	swap, err := m.Reserve(ctx, v1.Network_ARBITRUM, addr, &pending)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), approve.Nonce)
+	assert.Equal(t, uint64(6), swap.Nonce)
+
+	other, err := m.Reserve(ctx, v1.Network_OPTIMISM, addr, &pending)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), other.Nonce)
+
+	// the failed swap gives its nonce back
+	approve.Done(nil)
+	swap.Done(errors.New("execution reverted"))
+	next, err := m.Next(ctx, v1.Network_ARBITRUM, addr, &pending)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), next)
+
+	_, err = m.Claim(v1.Network_ARBITRUM, addr, 5)
+	assert.ErrorIs(t, err, ErrNonceTaken)
+	claimed, err := m.Claim(v1.Network_ARBITRUM, addr, 6)
+	assert.NoError(t, err)
+	claimed.Done(nil)
+
+	// the node counts both txs
+	pending = 7
+	next, err = m.Next(ctx, v1.Network_ARBITRUM, addr, &pending)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), next)
+
+	// the node lost the tx, its nonce is handed out again after the timeout
+	lost, err := m.Reserve(ctx, v1.Network_ARBITRUM, addr, &pending)
+	assert.NoError(t, err)
+	lost.Done(nil)
+	next, err = m.Next(ctx, v1.Network_ARBITRUM, addr, &pending)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(8), next)
+
+	now = now.Add(gapTimeout + time.Second)
+	next, err = m.Next(ctx, v1.Network_ARBITRUM, addr, &pending)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), next)
+}
+
+func TestIsTaken(t *testing.T) {
+	assert.True(t, IsTaken(errors.New("nonce too low: next nonce 8, tx nonce 7")))
+	assert.True(t, IsTaken(errors.New("replacement transaction underpriced")))
+	assert.False(t, IsTaken(errors.New("insufficient funds for gas * price + value")))
+	assert.False(t, IsTaken(nil))
+}
@@ -0,0 +1,145 @@
+package defi
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hardstylez72/cry/internal/defi/contracts/arbitrum/arbsys"
+	"github.com/hardstylez72/cry/internal/defi/contracts/arbitrum/inbox"
+	"github.com/hardstylez72/cry/internal/defi/contracts/arbitrum/nodeinterface"
+	"github.com/hardstylez72/cry/internal/defi/contracts/arbitrum/outbox"
+	"github.com/pkg/errors"
+)
+
+var (
+	arbitrumInbox         = common.HexToAddress("0x4Dbd4fc535Ac27206064B68FfCf827b0A60BAB3f")
+	arbitrumOutbox        = common.HexToAddress("0x0B9857ae2D4A3DBe74ffE1d7DF045bb7F96E4840")
+	arbitrumArbSys        = common.HexToAddress("0x0000000000000000000000000000000000000064")
+	arbitrumNodeInterface = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+)
+
+// arbitrumSendRootDepth assertions are confirmed about hourly, the latest confirmed one is within the range
+const arbitrumSendRootDepth = uint64(50000)
+
+func arbitrumDeposit(amount *big.Int) (*contractCall, error) {
+	abi, err := inbox.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("depositEth")
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: arbitrumInbox, Value: amount, Data: data}, nil
+}
+
+func arbitrumWithdraw(wallet common.Address, amount *big.Int) (*contractCall, error) {
+	abi, err := arbsys.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("withdrawEth", wallet)
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: arbitrumArbSys, Value: amount, Data: data}, nil
+}
+
+func arbitrumWithdrawal(receipt *types.Receipt) (*Withdrawal, error) {
+	f, err := arbsys.NewStorageFilterer(arbitrumArbSys, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != arbitrumArbSys {
+			continue
+		}
+		e, err := f.ParseL2ToL1Tx(*l)
+		if err != nil {
+			continue
+		}
+		return &Withdrawal{
+			Sender:      e.Caller,
+			Target:      e.Destination,
+			Value:       e.Callvalue,
+			Data:        e.Data,
+			Position:    e.Position.Uint64(),
+			ArbBlockNum: e.ArbBlockNum,
+			EthBlockNum: e.EthBlockNum,
+			Timestamp:   e.Timestamp,
+		}, nil
+	}
+	return nil, errors.New("L2ToL1Tx event is not found")
+}
+
+// arbitrumFinalize executes the withdrawal in the outbox once an assertion including it is confirmed
+func (b *OfficialBridge) arbitrumFinalize(ctx context.Context, w *Withdrawal) (*contractCall, error) {
+	opt := &bind.CallOpts{Context: ctx}
+	out, err := outbox.NewStorageCaller(arbitrumOutbox, b.L1.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "outbox.NewStorageCaller")
+	}
+	spent, err := out.IsSpent(opt, new(big.Int).SetUint64(w.Position))
+	if err != nil {
+		return nil, errors.Wrap(err, "outbox.IsSpent")
+	}
+	if spent {
+		return nil, ErrWithdrawalFinalized
+	}
+
+	outboxAbi, err := outbox.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	logs, err := filterLogsBackward(ctx, b.L1, ethereum.FilterQuery{
+		Addresses: []common.Address{arbitrumOutbox},
+		Topics:    [][]common.Hash{{outboxAbi.Events["SendRootUpdated"].ID}},
+	}, arbitrumSendRootDepth)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) == 0 {
+		return nil, &WithdrawalNotReady{Reason: "no confirmed arbitrum assertion"}
+	}
+	latest := logs[len(logs)-1]
+
+	// arbitrum keeps the send count of the block in the first 8 bytes of the mix hash
+	header, err := b.L2.Cli.HeaderByHash(ctx, latest.Topics[2])
+	if err != nil {
+		return nil, errors.Wrap(err, "HeaderByHash")
+	}
+	sendCount := binary.BigEndian.Uint64(header.MixDigest[:8])
+	if sendCount <= w.Position {
+		return nil, &WithdrawalNotReady{Reason: "assertion including the withdrawal is not confirmed"}
+	}
+
+	ni, err := nodeinterface.NewStorageCaller(arbitrumNodeInterface, b.L2.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "nodeinterface.NewStorageCaller")
+	}
+	proof, err := ni.ConstructOutboxProof(opt, sendCount, w.Position)
+	if err != nil {
+		return nil, errors.Wrap(err, "nodeinterface.ConstructOutboxProof")
+	}
+
+	data, err := outboxAbi.Pack("executeTransaction",
+		proof.Proof,
+		new(big.Int).SetUint64(w.Position),
+		w.Sender,
+		w.Target,
+		w.ArbBlockNum,
+		w.EthBlockNum,
+		w.Timestamp,
+		w.Value,
+		[]byte(w.Data),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: arbitrumOutbox, Value: big.NewInt(0), Data: data}, nil
+}
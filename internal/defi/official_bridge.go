@@ -0,0 +1,300 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// officialBridgeLogRange blocks requested by a single eth_getLogs, public rpc refuse the wider ranges
+const officialBridgeLogRange = uint64(5000)
+
+// OfficialBridge canonical eth bridge between ethereum and the rollup. Deposits are sent on l1, withdrawals
+// are initiated on l2 and finalized on l1 after the challenge period, op stack ones are proven before.
+type OfficialBridge struct {
+	Network v1.Network
+	L1      *EtheriumClient
+	L2      *EtheriumClient
+}
+
+func NewOfficialBridge(l1, l2 *EtheriumClient) (*OfficialBridge, error) {
+	if l1.Cfg.Network != v1.Network_Etherium {
+		return nil, errors.New("official bridge l1 must be " + v1.Network_Etherium.String())
+	}
+	switch l2.Cfg.Network {
+	case v1.Network_ARBITRUM, v1.Network_OPTIMISM, v1.Network_Base, v1.Network_Linea:
+	default:
+		return nil, errors.New("official bridge is not supported in " + l2.Cfg.Network.String())
+	}
+	return &OfficialBridge{Network: l2.Cfg.Network, L1: l1, L2: l2}, nil
+}
+
+// Withdrawal l2 message of the withdrawal, the task stores it between the steps
+type Withdrawal struct {
+	L2Tx    common.Hash `json:"l2Tx"`
+	L2Block uint64      `json:"l2Block"`
+	// L1Block ethereum head when the withdrawal was read, l1 events of the withdrawal are searched from it
+	L1Block uint64         `json:"l1Block"`
+	Sender  common.Address `json:"sender"`
+	Target  common.Address `json:"target"`
+	Value   *big.Int       `json:"value"`
+	Data    hexutil.Bytes  `json:"data"`
+
+	// Position leaf of the arbitrum outbox
+	Position    uint64   `json:"position,omitempty"`
+	ArbBlockNum *big.Int `json:"arbBlockNum,omitempty"`
+	EthBlockNum *big.Int `json:"ethBlockNum,omitempty"`
+	Timestamp   *big.Int `json:"timestamp,omitempty"`
+
+	// Nonce of the op stack withdrawal or the linea message
+	Nonce *big.Int `json:"nonce,omitempty"`
+	// GasLimit of the op stack withdrawal
+	GasLimit *big.Int `json:"gasLimit,omitempty"`
+	// Fee of the linea message
+	Fee *big.Int `json:"fee,omitempty"`
+	// Hash of the op stack withdrawal or the linea message
+	Hash common.Hash `json:"hash,omitempty"`
+}
+
+// officialBridgeSpenders entry points of the official bridges in the network
+func officialBridgeSpenders(network v1.Network) []Spender {
+	switch network {
+	case v1.Network_Etherium:
+		s := []Spender{
+			{Name: "Arbitrum inbox", Address: arbitrumInbox},
+			{Name: "Linea message service", Address: lineaL1MessageService},
+			{Name: "zkSync Era diamond proxy", Address: zkSyncDiamondProxy},
+		}
+		for l2, addr := range opStackL1StandardBridge {
+			s = append(s, Spender{Name: l2.String() + " standard bridge", Address: addr})
+		}
+		for l2, addr := range opStackPortal {
+			s = append(s, Spender{Name: l2.String() + " portal", Address: addr})
+		}
+		sort.Slice(s, func(i, j int) bool { return s[i].Name < s[j].Name })
+		return s
+	case v1.Network_ARBITRUM:
+		return []Spender{{Name: "Arbitrum ArbSys", Address: arbitrumArbSys}}
+	case v1.Network_OPTIMISM, v1.Network_Base:
+		return []Spender{{Name: "OP stack message passer", Address: opStackMessagePasser}}
+	case v1.Network_Linea:
+		return []Spender{{Name: "Linea message service", Address: lineaL2MessageService}}
+	default:
+		return nil
+	}
+}
+
+// NeedsProof op stack withdrawals are proven before the challenge period starts
+func (b *OfficialBridge) NeedsProof() bool {
+	return opStackNetwork(b.Network)
+}
+
+// Deposit sends eth from ethereum to the wallet on l2
+func (b *OfficialBridge) Deposit(ctx context.Context, req *OfficialBridgeReq) (*bozdo.DefaultRes, error) {
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	var call *contractCall
+	switch b.Network {
+	case v1.Network_ARBITRUM:
+		call, err = arbitrumDeposit(req.Amount)
+	case v1.Network_OPTIMISM, v1.Network_Base:
+		call, err = opStackDeposit(b.Network, req.Amount)
+	case v1.Network_Linea:
+		call, err = lineaSendMessage(lineaL1MessageService, tr.WalletAddr, req.Amount, big.NewInt(0))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return b.send(ctx, b.L1, tr, call, req, "official bridge deposit "+b.Network.String())
+}
+
+// Withdraw initiates the withdrawal of eth on l2 to the wallet on ethereum
+// WithdrawFee network token wei the withdrawal message is paid with along with the withdrawn value, the linea
+// message service takes it, the other bridges take none
+func (b *OfficialBridge) WithdrawFee(ctx context.Context) (*big.Int, error) {
+	if b.Network == v1.Network_Linea {
+		return b.lineaMinimumFee(ctx)
+	}
+	return big.NewInt(0), nil
+}
+
+func (b *OfficialBridge) Withdraw(ctx context.Context, req *OfficialBridgeReq) (*bozdo.DefaultRes, error) {
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	var call *contractCall
+	var extraFee *big.Int
+	switch b.Network {
+	case v1.Network_ARBITRUM:
+		call, err = arbitrumWithdraw(tr.WalletAddr, req.Amount)
+	case v1.Network_OPTIMISM, v1.Network_Base:
+		call, err = opStackWithdraw(tr.WalletAddr, req.Amount)
+	case v1.Network_Linea:
+		extraFee, err = b.lineaMinimumFee(ctx)
+		if err != nil {
+			return nil, err
+		}
+		call, err = lineaSendMessage(lineaL2MessageService, tr.WalletAddr, req.Amount, extraFee)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.send(ctx, b.L2, tr, call, req, "official bridge withdraw "+b.Network.String())
+	if err != nil {
+		return nil, err
+	}
+	res.ECost.ExtraFee = extraFee
+	return res, nil
+}
+
+// Withdrawal reads the withdrawal message from the receipt of the l2 transaction initiated it
+func (b *OfficialBridge) Withdrawal(ctx context.Context, l2Tx common.Hash) (*Withdrawal, error) {
+	receipt, err := b.L2.Cli.TransactionReceipt(ctx, l2Tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "TransactionReceipt")
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, ErrTxStatusFailed
+	}
+	l1Block, err := b.L1.Cli.BlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "BlockNumber")
+	}
+
+	var w *Withdrawal
+	switch b.Network {
+	case v1.Network_ARBITRUM:
+		w, err = arbitrumWithdrawal(receipt)
+	case v1.Network_OPTIMISM, v1.Network_Base:
+		w, err = opStackWithdrawal(receipt)
+	case v1.Network_Linea:
+		w, err = lineaWithdrawal(receipt)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	w.L2Tx = l2Tx
+	w.L2Block = receipt.BlockNumber.Uint64()
+	w.L1Block = l1Block
+	return w, nil
+}
+
+// Prove proves the op stack withdrawal with the latest dispute game covering its block
+func (b *OfficialBridge) Prove(ctx context.Context, w *Withdrawal, req *OfficialBridgeReq) (*bozdo.DefaultRes, error) {
+	if !b.NeedsProof() {
+		return nil, errors.New("withdrawals of " + b.Network.String() + " are not proven")
+	}
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	call, err := b.opStackProve(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.send(ctx, b.L1, tr, call, req, "official bridge prove "+b.Network.String())
+}
+
+// Finalize releases the withdrawn eth on ethereum once the challenge period is over
+func (b *OfficialBridge) Finalize(ctx context.Context, w *Withdrawal, req *OfficialBridgeReq) (*bozdo.DefaultRes, error) {
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	var call *contractCall
+	switch b.Network {
+	case v1.Network_ARBITRUM:
+		call, err = b.arbitrumFinalize(ctx, w)
+	case v1.Network_OPTIMISM, v1.Network_Base:
+		call, err = b.opStackFinalize(ctx, w, tr.WalletAddr)
+	case v1.Network_Linea:
+		call, err = b.lineaClaim(ctx, w)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return b.send(ctx, b.L1, tr, call, req, "official bridge finalize "+b.Network.String())
+}
+
+func (b *OfficialBridge) send(ctx context.Context, c *EtheriumClient, tr *WalletTransactor, call *contractCall, req *OfficialBridgeReq, name string) (*bozdo.DefaultRes, error) {
+	ecost, tx, err := c.sendContractCall(ctx, tr, call, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = name
+	result := &bozdo.DefaultRes{ECost: ecost}
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeContract, nil)
+	return result, nil
+}
+
+// filterLogsBackward newest logs matching the query, searched back from the head up to depth blocks
+func filterLogsBackward(ctx context.Context, c *EtheriumClient, q ethereum.FilterQuery, depth uint64) ([]types.Log, error) {
+	head, err := c.Cli.BlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "BlockNumber")
+	}
+
+	for to := head; to+depth > head; to -= officialBridgeLogRange {
+		from := uint64(0)
+		if to > officialBridgeLogRange {
+			from = to - officialBridgeLogRange + 1
+		}
+		q.FromBlock = new(big.Int).SetUint64(from)
+		q.ToBlock = new(big.Int).SetUint64(to)
+		logs, err := c.Cli.FilterLogs(ctx, q)
+		if err != nil {
+			return nil, errors.Wrap(err, "FilterLogs")
+		}
+		if len(logs) > 0 {
+			return logs, nil
+		}
+		if from == 0 {
+			break
+		}
+	}
+	return nil, nil
+}
+
+// filterLogsForward logs matching the query from the block up to the head
+func filterLogsForward(ctx context.Context, c *EtheriumClient, q ethereum.FilterQuery, from, to uint64) ([]types.Log, error) {
+	var out []types.Log
+	for ; from <= to; from += officialBridgeLogRange {
+		end := from + officialBridgeLogRange - 1
+		if end > to {
+			end = to
+		}
+		q.FromBlock = new(big.Int).SetUint64(from)
+		q.ToBlock = new(big.Int).SetUint64(end)
+		logs, err := c.Cli.FilterLogs(ctx, q)
+		if err != nil {
+			return nil, errors.Wrap(err, "FilterLogs")
+		}
+		out = append(out, logs...)
+	}
+	return out, nil
+}
@@ -0,0 +1,215 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/linea/messageservice"
+	"github.com/pkg/errors"
+)
+
+var (
+	lineaL1MessageService = common.HexToAddress("0xd19d4B5d358258f05D7B411E21A1460D11B0876F")
+	lineaL2MessageService = common.HexToAddress("0x508Ca82Df566dCD1B0DE8296e70a96332cD644ec")
+)
+
+func lineaSendMessage(service, wallet common.Address, amount, fee *big.Int) (*contractCall, error) {
+	abi, err := messageservice.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("sendMessage", wallet, fee, []byte{})
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: service, Value: bozdo.BigIntSum(amount, fee), Data: data}, nil
+}
+
+func (b *OfficialBridge) lineaMinimumFee(ctx context.Context) (*big.Int, error) {
+	s, err := messageservice.NewStorageCaller(lineaL2MessageService, b.L2.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "messageservice.NewStorageCaller")
+	}
+	fee, err := s.MinimumFeeInWei(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, errors.Wrap(err, "messageservice.MinimumFeeInWei")
+	}
+	return fee, nil
+}
+
+func lineaWithdrawal(receipt *types.Receipt) (*Withdrawal, error) {
+	f, err := messageservice.NewStorageFilterer(lineaL2MessageService, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != lineaL2MessageService {
+			continue
+		}
+		e, err := f.ParseMessageSent(*l)
+		if err != nil {
+			continue
+		}
+		return &Withdrawal{
+			Sender: e.From,
+			Target: e.To,
+			Value:  e.Value,
+			Data:   e.Calldata,
+			Nonce:  e.Nonce,
+			Fee:    e.Fee,
+			Hash:   e.MessageHash,
+		}, nil
+	}
+	return nil, errors.New("MessageSent event is not found")
+}
+
+// lineaClaim claims the message on ethereum once the l2 block of the withdrawal is finalized. Finalization anchors
+// the l2 blocks and adds merkle roots of all their messages, the proof of the message is built from the same tree.
+func (b *OfficialBridge) lineaClaim(ctx context.Context, w *Withdrawal) (*contractCall, error) {
+	opt := &bind.CallOpts{Context: ctx}
+	l1, err := messageservice.NewStorageCaller(lineaL1MessageService, b.L1.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "messageservice.NewStorageCaller")
+	}
+	claimed, err := l1.IsMessageClaimed(opt, w.Nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "messageservice.IsMessageClaimed")
+	}
+	if claimed {
+		return nil, ErrWithdrawalFinalized
+	}
+
+	abi, err := messageservice.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := b.L1.Cli.BlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "BlockNumber")
+	}
+	anchored, err := filterLogsForward(ctx, b.L1, ethereum.FilterQuery{
+		Addresses: []common.Address{lineaL1MessageService},
+		Topics: [][]common.Hash{
+			{abi.Events["L2MessagingBlockAnchored"].ID},
+			{common.BigToHash(new(big.Int).SetUint64(w.L2Block))},
+		},
+	}, w.L1Block, head)
+	if err != nil {
+		return nil, err
+	}
+	if len(anchored) == 0 {
+		return nil, &WithdrawalNotReady{Reason: "l2 block of the withdrawal is not finalized"}
+	}
+
+	finalization, err := b.L1.Cli.TransactionReceipt(ctx, anchored[0].TxHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "TransactionReceipt")
+	}
+	var roots []common.Hash
+	var depth uint64
+	var fromBlock, toBlock uint64
+	for _, l := range finalization.Logs {
+		if l.Address != lineaL1MessageService || len(l.Topics) < 2 {
+			continue
+		}
+		switch l.Topics[0] {
+		case abi.Events["L2MerkleRootAdded"].ID:
+			if len(l.Topics) < 3 {
+				continue
+			}
+			roots = append(roots, l.Topics[1])
+			depth = l.Topics[2].Big().Uint64()
+		case abi.Events["L2MessagingBlockAnchored"].ID:
+			block := l.Topics[1].Big().Uint64()
+			if fromBlock == 0 || block < fromBlock {
+				fromBlock = block
+			}
+			if block > toBlock {
+				toBlock = block
+			}
+		}
+	}
+	if len(roots) == 0 {
+		return nil, errors.New("finalization has no l2 merkle roots")
+	}
+
+	sent, err := filterLogsForward(ctx, b.L2, ethereum.FilterQuery{
+		Addresses: []common.Address{lineaL2MessageService},
+		Topics:    [][]common.Hash{{abi.Events["MessageSent"].ID}},
+	}, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+	index := -1
+	hashes := make([]common.Hash, 0, len(sent))
+	for _, l := range sent {
+		if len(l.Topics) < 4 {
+			continue
+		}
+		h := l.Topics[3]
+		if h == w.Hash {
+			index = len(hashes)
+		}
+		hashes = append(hashes, h)
+	}
+	if index < 0 {
+		return nil, errors.New("message is not found in the finalized l2 blocks")
+	}
+
+	treeSize := 1 << depth
+	tree := index / treeSize
+	if tree >= len(roots) {
+		return nil, errors.New("finalization has no merkle root of the message")
+	}
+	leaves := make([]common.Hash, treeSize)
+	copy(leaves, hashes[tree*treeSize:])
+	leafIndex := index % treeSize
+	root, proof := lineaMerkleProof(leaves, leafIndex)
+	if root != roots[tree] {
+		return nil, errors.New("merkle root of the message does not match the finalization")
+	}
+
+	proofBytes := make([][32]byte, len(proof))
+	for i := range proof {
+		proofBytes[i] = proof[i]
+	}
+	data, err := abi.Pack("claimMessageWithProof", messageservice.IL1MessageServiceClaimMessageWithProofParams{
+		Proof:         proofBytes,
+		MessageNumber: w.Nonce,
+		LeafIndex:     uint32(leafIndex),
+		From:          w.Sender,
+		To:            w.Target,
+		Fee:           w.Fee,
+		Value:         w.Value,
+		FeeRecipient:  common.Address{},
+		MerkleRoot:    root,
+		Data:          w.Data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: lineaL1MessageService, Value: big.NewInt(0), Data: data}, nil
+}
+
+// lineaMerkleProof root of the full binary keccak tree and the sibling path of the leaf
+func lineaMerkleProof(leaves []common.Hash, index int) (common.Hash, []common.Hash) {
+	var proof []common.Hash
+	level := leaves
+	for len(level) > 1 {
+		proof = append(proof, level[index^1])
+		next := make([]common.Hash, len(level)/2)
+		for i := range next {
+			next[i] = crypto.Keccak256Hash(level[2*i].Bytes(), level[2*i+1].Bytes())
+		}
+		level = next
+		index /= 2
+	}
+	return level[0], proof
+}
@@ -0,0 +1,310 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/disputegame"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/disputegamefactory"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/l1standardbridge"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/messagepasser"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/portal"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+var (
+	opStackMessagePasser = common.HexToAddress("0x4200000000000000000000000000000000000016")
+
+	// https://docs.optimism.io/chain/addresses
+	// https://docs.base.org/docs/base-contracts
+	opStackL1StandardBridge = map[v1.Network]common.Address{
+		v1.Network_OPTIMISM: common.HexToAddress("0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1"),
+		v1.Network_Base:     common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35"),
+	}
+	opStackPortal = map[v1.Network]common.Address{
+		v1.Network_OPTIMISM: common.HexToAddress("0xbEb5Fc579115071764c7423A4f12eDde41f106Ed"),
+		v1.Network_Base:     common.HexToAddress("0x49048044D57e1C92A77f79988d21Fa8fAF74E97e"),
+	}
+)
+
+const (
+	opStackDepositGasLimit  = uint32(200000)
+	opStackWithdrawGasLimit = int64(100000)
+	// opStackGameLookup latest dispute games checked for the respected one, games are created about hourly
+	opStackGameLookup = int64(100)
+
+	opStackGameChallengerWins = uint8(1)
+	opStackGameDefenderWins   = uint8(2)
+)
+
+func opStackDeposit(network v1.Network, amount *big.Int) (*contractCall, error) {
+	abi, err := l1standardbridge.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("depositETH", opStackDepositGasLimit, []byte{})
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: opStackL1StandardBridge[network], Value: amount, Data: data}, nil
+}
+
+func opStackWithdraw(wallet common.Address, amount *big.Int) (*contractCall, error) {
+	abi, err := messagepasser.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("initiateWithdrawal", wallet, big.NewInt(opStackWithdrawGasLimit), []byte{})
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: opStackMessagePasser, Value: amount, Data: data}, nil
+}
+
+func opStackWithdrawal(receipt *types.Receipt) (*Withdrawal, error) {
+	f, err := messagepasser.NewStorageFilterer(opStackMessagePasser, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != opStackMessagePasser {
+			continue
+		}
+		e, err := f.ParseMessagePassed(*l)
+		if err != nil {
+			continue
+		}
+		return &Withdrawal{
+			Sender:   e.Sender,
+			Target:   e.Target,
+			Value:    e.Value,
+			Data:     e.Data,
+			Nonce:    e.Nonce,
+			GasLimit: e.GasLimit,
+			Hash:     e.WithdrawalHash,
+		}, nil
+	}
+	return nil, errors.New("MessagePassed event is not found")
+}
+
+func (w *Withdrawal) opStackTx() portal.TypesWithdrawalTransaction {
+	return portal.TypesWithdrawalTransaction{
+		Nonce:    w.Nonce,
+		Sender:   w.Sender,
+		Target:   w.Target,
+		Value:    w.Value,
+		GasLimit: w.GasLimit,
+		Data:     w.Data,
+	}
+}
+
+func (b *OfficialBridge) opStackPortal() (*portal.StorageCaller, error) {
+	p, err := portal.NewStorageCaller(opStackPortal[b.Network], b.L1.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "portal.NewStorageCaller")
+	}
+	return p, nil
+}
+
+// opStackProve proves the withdrawal against the latest respected dispute game, its output root is rebuilt from
+// the l2 block and the storage proof of the withdrawal in the message passer
+func (b *OfficialBridge) opStackProve(ctx context.Context, w *Withdrawal) (*contractCall, error) {
+	opt := &bind.CallOpts{Context: ctx}
+	p, err := b.opStackPortal()
+	if err != nil {
+		return nil, err
+	}
+	finalized, err := p.FinalizedWithdrawals(opt, w.Hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "portal.FinalizedWithdrawals")
+	}
+	if finalized {
+		return nil, ErrWithdrawalFinalized
+	}
+
+	gameIndex, game, err := b.opStackGame(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	gameBlock, err := game.L2BlockNumber(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "disputegame.L2BlockNumber")
+	}
+	if gameBlock.Uint64() < w.L2Block {
+		return nil, &WithdrawalNotReady{Reason: "no dispute game covers the withdrawal block yet"}
+	}
+	rootClaim, err := game.RootClaim(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "disputegame.RootClaim")
+	}
+
+	header, err := b.L2.Cli.HeaderByNumber(ctx, gameBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "HeaderByNumber")
+	}
+	slot := crypto.Keccak256Hash(w.Hash.Bytes(), common.Hash{}.Bytes())
+	proof, err := gethclient.New(b.L2.RpcCli).GetProof(ctx, opStackMessagePasser, []string{slot.Hex()}, gameBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetProof")
+	}
+	if len(proof.StorageProof) == 0 {
+		return nil, errors.New("empty storage proof of the withdrawal")
+	}
+
+	outputRootProof := portal.TypesOutputRootProof{
+		StateRoot:                header.Root,
+		MessagePasserStorageRoot: proof.StorageHash,
+		LatestBlockhash:          header.Hash(),
+	}
+	outputRoot := crypto.Keccak256Hash(
+		outputRootProof.Version[:],
+		outputRootProof.StateRoot[:],
+		outputRootProof.MessagePasserStorageRoot[:],
+		outputRootProof.LatestBlockhash[:],
+	)
+	if outputRoot != rootClaim {
+		return nil, errors.New("output root of l2 block " + gameBlock.String() + " does not match the dispute game")
+	}
+
+	withdrawalProof := make([][]byte, 0, len(proof.StorageProof[0].Proof))
+	for _, node := range proof.StorageProof[0].Proof {
+		b, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, errors.Wrap(err, "storage proof node")
+		}
+		withdrawalProof = append(withdrawalProof, b)
+	}
+
+	abi, err := portal.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("proveWithdrawalTransaction", w.opStackTx(), gameIndex, outputRootProof, withdrawalProof)
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: opStackPortal[b.Network], Value: big.NewInt(0), Data: data}, nil
+}
+
+// opStackGame latest game of the respected type the challenger has not won
+func (b *OfficialBridge) opStackGame(ctx context.Context, p *portal.StorageCaller) (*big.Int, *disputegame.StorageCaller, error) {
+	opt := &bind.CallOpts{Context: ctx}
+	factoryAddr, err := p.DisputeGameFactory(opt)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "portal.DisputeGameFactory")
+	}
+	gameType, err := p.RespectedGameType(opt)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "portal.RespectedGameType")
+	}
+	factory, err := disputegamefactory.NewStorageCaller(factoryAddr, b.L1.Cli)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "disputegamefactory.NewStorageCaller")
+	}
+	count, err := factory.GameCount(opt)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "disputegamefactory.GameCount")
+	}
+
+	for i := count.Int64() - 1; i >= 0 && i >= count.Int64()-opStackGameLookup; i-- {
+		index := big.NewInt(i)
+		g, err := factory.GameAtIndex(opt, index)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "disputegamefactory.GameAtIndex")
+		}
+		if g.GameType != gameType {
+			continue
+		}
+		game, err := disputegame.NewStorageCaller(g.Proxy, b.L1.Cli)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "disputegame.NewStorageCaller")
+		}
+		status, err := game.Status(opt)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "disputegame.Status")
+		}
+		if status == opStackGameChallengerWins {
+			continue
+		}
+		return index, game, nil
+	}
+	return nil, nil, &WithdrawalNotReady{Reason: "no respected dispute game"}
+}
+
+// opStackFinalize finalizes the proven withdrawal once the proof is mature and its dispute game is resolved
+// in favor of the proposer and finalized
+func (b *OfficialBridge) opStackFinalize(ctx context.Context, w *Withdrawal, wallet common.Address) (*contractCall, error) {
+	opt := &bind.CallOpts{Context: ctx}
+	p, err := b.opStackPortal()
+	if err != nil {
+		return nil, err
+	}
+	finalized, err := p.FinalizedWithdrawals(opt, w.Hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "portal.FinalizedWithdrawals")
+	}
+	if finalized {
+		return nil, ErrWithdrawalFinalized
+	}
+
+	proven, err := p.ProvenWithdrawals(opt, w.Hash, wallet)
+	if err != nil {
+		return nil, errors.Wrap(err, "portal.ProvenWithdrawals")
+	}
+	if proven.Timestamp == 0 {
+		return nil, errors.New("withdrawal is not proven")
+	}
+	maturity, err := p.ProofMaturityDelaySeconds(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "portal.ProofMaturityDelaySeconds")
+	}
+	readyAt := time.Unix(int64(proven.Timestamp)+maturity.Int64(), 0)
+	if time.Now().Before(readyAt) {
+		return nil, &WithdrawalNotReady{Reason: "proof is not mature", ReadyAt: readyAt}
+	}
+
+	game, err := disputegame.NewStorageCaller(proven.DisputeGameProxy, b.L1.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "disputegame.NewStorageCaller")
+	}
+	status, err := game.Status(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "disputegame.Status")
+	}
+	if status == opStackGameChallengerWins {
+		return nil, errors.New("dispute game of the proof is lost, withdrawal must be proven again")
+	}
+	if status != opStackGameDefenderWins {
+		return nil, &WithdrawalNotReady{Reason: "dispute game is not resolved"}
+	}
+	resolvedAt, err := game.ResolvedAt(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "disputegame.ResolvedAt")
+	}
+	delay, err := p.DisputeGameFinalityDelaySeconds(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "portal.DisputeGameFinalityDelaySeconds")
+	}
+	readyAt = time.Unix(int64(resolvedAt)+delay.Int64(), 0)
+	if time.Now().Before(readyAt) {
+		return nil, &WithdrawalNotReady{Reason: "dispute game is not finalized", ReadyAt: readyAt}
+	}
+
+	abi, err := portal.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("finalizeWithdrawalTransaction", w.opStackTx())
+	if err != nil {
+		return nil, err
+	}
+	return &contractCall{To: opStackPortal[b.Network], Value: big.NewInt(0), Data: data}, nil
+}
@@ -0,0 +1,70 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/mailbox"
+	"github.com/pkg/errors"
+)
+
+var zkSyncDiamondProxy = common.HexToAddress("0x32400084C286CF3E17e7B677ea9583e60a000324")
+
+// ZkSyncWithdrawal l2 to l1 message of the zkSync Era eth withdrawal and its proof in the executed batch
+type ZkSyncWithdrawal struct {
+	BatchNumber     *big.Int
+	MessageIndex    *big.Int
+	TxNumberInBatch uint16
+	Message         []byte
+	Proof           [][32]byte
+}
+
+// ZkSyncFinalizeWithdrawal releases the eth withdrawn from zkSync Era on ethereum
+func (c *EtheriumClient) ZkSyncFinalizeWithdrawal(ctx context.Context, w *ZkSyncWithdrawal, req *OfficialBridgeReq) (*bozdo.DefaultRes, error) {
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := mailbox.NewStorageCaller(zkSyncDiamondProxy, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "mailbox.NewStorageCaller")
+	}
+	finalized, err := m.IsEthWithdrawalFinalized(&bind.CallOpts{Context: ctx}, w.BatchNumber, w.MessageIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "mailbox.IsEthWithdrawalFinalized")
+	}
+	if finalized {
+		return nil, ErrWithdrawalFinalized
+	}
+
+	abi, err := mailbox.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("finalizeEthWithdrawal", w.BatchNumber, w.MessageIndex, w.TxNumberInBatch, w.Message, w.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	ecost, tx, err := c.sendContractCall(ctx, tr, &contractCall{
+		To:    zkSyncDiamondProxy,
+		Value: big.NewInt(0),
+		Data:  data,
+	}, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = "zksync finalize withdrawal"
+	result := &bozdo.DefaultRes{ECost: ecost}
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeContract, nil)
+	return result, nil
+}
@@ -23,16 +23,26 @@ var TokenAddress = map[defi.Token]common.Address{
 	v1.Token_USDT:  common.HexToAddress("0x94b008aa00579c1307b0ef2c499ad98a8ce58e58"),
 	v1.Token_STG:   common.HexToAddress("0x296F55F8Fb28E498B858d0BcDA06D955B2Cb3f97"),
 	v1.Token_USDC:  common.HexToAddress("0x7F5c764cBc14f9669B88837ca1490cCa17c31607"),
-	v1.Token_veSTG: common.HexToAddress("0xB0D502E938ed5f4df2E681fE6E419ff29631d62b"),
 	v1.Token_ETH:   common.HexToAddress("0x0000000000000000000000000000000000000000"),
+	v1.Token_veSTG: common.HexToAddress("0x43d2761ed16C89A2C4342e2B16A3C61Ccf88f05B"),
+	v1.Token_DAI:   common.HexToAddress("0xDA10009cBd5D07dd0CeCc66161FC93D7c9000da1"),
+	v1.Token_FRAX:  common.HexToAddress("0x2E3D870790dC77A83DD1d18184Acc7439A53f475"),
+	v1.Token_sUSD:  common.HexToAddress("0x8c6f28f2F1A3C87F0f938b96d27520d9751ec8d9"),
+	v1.Token_LUSD:  common.HexToAddress("0xc40F949F8a4e094D1b49a23ea9241D289B7b2819"),
+	v1.Token_MAI:   common.HexToAddress("0xdFA46478F9e5EA86d57387849598dbFB2e964b02"),
 }
 
 var Dict = defi.Dict{
 	Stargate: defi.Stargate{
 		StargateRouterAddress:    common.HexToAddress("0xB0D502E938ed5f4df2E681fE6E419ff29631d62b"),
 		StargateRouterEthAddress: common.HexToAddress("0xB49c4e680174E331CB0A7fF3Ab58afC9738d5F8b"),
+		LPStakingAddress:         common.HexToAddress("0x4DeA9e918c6289a52cd469cAC652727B7b412Cd2"),
 	},
 	TestNetBridgeSwapAddress: common.HexToAddress("0x0A9f824C05A74F577A536A8A0c673183a872Dff4"),
+	Aave: defi.Aave{
+		Pool:        common.HexToAddress("0x794a61358D6845594F94dc1DB02A252b5b4814aD"),
+		WETHGateway: common.HexToAddress("0x76D3030728e52DEB8848d5613aBaDE88441cbc59"),
+	},
 }
 
 type Client struct {
@@ -23,11 +23,14 @@ type OrbiterBridgeReq struct {
 
 	Gas          *bozdo.Gas
 	EstimateOnly bool
+	// Maker the estimation was made with, sending is refused if the maker config has changed since
+	Maker *orbiter.Tx
 }
 
 type OrbiterBridgeRes struct {
 	Tx    *bozdo.Transaction
 	ECost *bozdo.EstimatedGasCost
+	Maker *orbiter.Tx
 }
 
 func (c *EtheriumClient) OrbiterBridge(ctx context.Context, req *OrbiterBridgeReq) (*OrbiterBridgeRes, error) {
@@ -40,12 +43,13 @@ func (c *EtheriumClient) OrbiterBridge(ctx context.Context, req *OrbiterBridgeRe
 		FromToken:   req.FromToken,
 		ToToken:     req.ToToken,
 		Amount:      req.Amount,
+		Expected:    req.Maker,
 	})
 
 	if err != nil {
 		return nil, err
 	}
-	req.Amount = orbiter.WrapValueWei(req.Amount, opt.Value4DigitCode)
+	req.Amount = opt.Value
 
 	res, err := c.Transfer(ctx, &TransferReq{
 		Pk:           req.WalletPk,
@@ -60,6 +64,7 @@ func (c *EtheriumClient) OrbiterBridge(ctx context.Context, req *OrbiterBridgeRe
 	}
 	r.Tx = res.Tx
 	r.ECost = res.ECost
+	r.Maker = opt
 
 	return r, nil
 }
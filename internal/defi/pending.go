@@ -2,50 +2,31 @@ package defi
 
 import (
 	"context"
-	"time"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 )
 
+// WaitTxComplete waits for the tx to get the confirmations of the network, the outcome other than the success is
+// returned as the error of it
 func (c *EtheriumClient) WaitTxComplete(ctx context.Context, txId common.Hash) error {
+	return c.WaitTxTracked(ctx, txId, &TxTrack{})
+}
 
-	ticker := time.NewTicker(time.Second * 10)
-	defer ticker.Stop()
-
-	for {
-		tx, isPending, err := c.Cli.TransactionByHash(ctx, txId)
-		if err == nil {
-			if !isPending {
-				rec, err := bind.WaitMined(context.Background(), c.Cli, tx)
-				if err != nil {
-					return err
-				}
-
-				if rec.Status == types.ReceiptStatusFailed {
-					return errors.Wrap(ErrTxStatusFailed, "invalid status")
-				}
-			}
-		}
-
-		if isPending {
-			continue
-		}
-
-		if err != nil {
-			if err.Error() == "not found" {
-				return errors.Wrap(ErrTxNotFound, "blockchain error")
-			}
-		}
-
-		select {
-		case <-ctx.Done():
-			return nil
-		case <-ticker.C:
+// WaitTxTracked WaitTxComplete with what is known of the tx kept by the caller
+func (c *EtheriumClient) WaitTxTracked(ctx context.Context, txId common.Hash, track *TxTrack) error {
+	res, err := c.TrackTx(ctx, txId, track)
+	if err != nil {
+		return err
+	}
 
-			return nil
-		}
+	switch res.Outcome {
+	case TxMinedReverted:
+		return errors.Wrap(ErrTxStatusFailed, "invalid status")
+	case TxDropped:
+		return errors.Wrap(ErrTxNotFound, "blockchain error")
+	case TxReplaced:
+		return ErrTxReplaced
 	}
+	return nil
 }
@@ -0,0 +1,186 @@
+package defi
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+)
+
+const permitABI = `[
+{"inputs":[],"name":"name","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},
+{"inputs":[],"name":"version","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},
+{"inputs":[],"name":"DOMAIN_SEPARATOR","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"nonces","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"internalType":"address","name":"owner","type":"address"},{"internalType":"address","name":"spender","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"uint8","name":"v","type":"uint8"},{"internalType":"bytes32","name":"r","type":"bytes32"},{"internalType":"bytes32","name":"s","type":"bytes32"}],"name":"permit","outputs":[],"stateMutability":"nonpayable","type":"function"}
+]`
+
+// permitTypes eip-712 types of the eip-2612 permit
+var permitTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Permit": {
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+var (
+	permitABIOnce sync.Once
+	permitABIVal  abi.ABI
+	permitABIErr  error
+)
+
+func loadPermitABI() {
+	permitABIVal, permitABIErr = abi.JSON(strings.NewReader(permitABI))
+}
+
+// Permit signed eip-2612 approval of the token the spender submits along with the spending call
+type Permit struct {
+	Token    common.Address
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Deadline *big.Int
+	V        uint8
+	R        [32]byte
+	S        [32]byte
+}
+
+type PermitReq struct {
+	Token    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Deadline *big.Int
+	ChainID  *big.Int
+	Signer   Signer
+}
+
+// SignPermit signs the eip-2612 permit of the token as eip-712 typed data with the signer of the wallet. The domain
+// is checked against the separator of the token and the permit with eth_call, ErrPermitNotSupported is returned when
+// the token has no permit or its permit is not the eip-2612 one (dai-like), so the classic approve is to be made
+// instead. Only the zkSync Era routers taking the permit along with the swap use it, see SignPermit2 for the routers
+// taking the Permit2 one
+func SignPermit(ctx context.Context, caller bind.ContractCaller, req *PermitReq) (*Permit, error) {
+	permitABIOnce.Do(loadPermitABI)
+	if permitABIErr != nil {
+		return nil, permitABIErr
+	}
+
+	owner := req.Signer.Address()
+
+	separator, err := callPermitToken(ctx, caller, req.Token, "DOMAIN_SEPARATOR")
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := callPermitToken(ctx, caller, req.Token, "nonces", owner)
+	if err != nil {
+		return nil, err
+	}
+	name, err := callPermitString(ctx, caller, req.Token, "name")
+	if err != nil {
+		return nil, err
+	}
+	// the tokens without version() sign the domain of version 1
+	version, err := callPermitString(ctx, caller, req.Token, "version")
+	if err != nil {
+		version = "1"
+	}
+
+	data := apitypes.TypedData{
+		Types:       permitTypes,
+		PrimaryType: "Permit",
+		Domain: apitypes.TypedDataDomain{
+			Name:              name,
+			Version:           version,
+			ChainId:           (*math.HexOrDecimal256)(req.ChainID),
+			VerifyingContract: req.Token.String(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"owner":    owner.String(),
+			"spender":  req.Spender.String(),
+			"value":    req.Value.String(),
+			"nonce":    new(big.Int).SetBytes(nonce).String(),
+			"deadline": req.Deadline.String(),
+		},
+	}
+	domain, err := data.HashStruct("EIP712Domain", data.Domain.Map())
+	if err != nil {
+		return nil, errors.Wrap(err, "HashStruct")
+	}
+	if !bytes.Equal(domain, separator) {
+		return nil, ErrPermitNotSupported
+	}
+
+	sig, err := req.Signer.SignTypedData(ctx, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "SignTypedData")
+	}
+
+	p := &Permit{
+		Token:    req.Token,
+		Owner:    owner,
+		Spender:  req.Spender,
+		Value:    req.Value,
+		Deadline: req.Deadline,
+		V:        sig[64],
+	}
+	copy(p.R[:], sig[:32])
+	copy(p.S[:], sig[32:64])
+
+	call, err := permitABIVal.Pack("permit", p.Owner, p.Spender, p.Value, p.Deadline, p.V, p.R, p.S)
+	if err != nil {
+		return nil, errors.Wrap(err, "permit pack")
+	}
+	if _, err := caller.CallContract(ctx, ethereum.CallMsg{From: owner, To: &req.Token, Data: call}, nil); err != nil {
+		return nil, ErrPermitNotSupported
+	}
+
+	return p, nil
+}
+
+// callPermitToken 32 bytes the view method of the token returns, ErrPermitNotSupported if it has no such method
+func callPermitToken(ctx context.Context, caller bind.ContractCaller, token common.Address, method string, args ...interface{}) ([]byte, error) {
+	data, err := permitABIVal.Pack(method, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, method+" pack")
+	}
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &token, Data: data}, nil)
+	if err != nil || len(out) < 32 {
+		return nil, ErrPermitNotSupported
+	}
+	return out[:32], nil
+}
+
+// callPermitString string the view method of the token returns, ErrPermitNotSupported if it has no such method
+func callPermitString(ctx context.Context, caller bind.ContractCaller, token common.Address, method string) (string, error) {
+	data, err := permitABIVal.Pack(method)
+	if err != nil {
+		return "", errors.Wrap(err, method+" pack")
+	}
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &token, Data: data}, nil)
+	if err != nil {
+		return "", ErrPermitNotSupported
+	}
+	var res string
+	if err := permitABIVal.UnpackIntoInterface(&res, method, out); err != nil {
+		return "", ErrPermitNotSupported
+	}
+	return res, nil
+}
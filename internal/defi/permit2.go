@@ -0,0 +1,191 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+)
+
+// Permit2Address canonical Uniswap Permit2 contract, the same on the evm networks
+var Permit2Address = common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3")
+
+const permit2ABI = `[
+{"inputs":[{"internalType":"address","name":"user","type":"address"},{"internalType":"address","name":"token","type":"address"},{"internalType":"address","name":"spender","type":"address"}],"name":"allowance","outputs":[{"internalType":"uint160","name":"amount","type":"uint160"},{"internalType":"uint48","name":"expiration","type":"uint48"},{"internalType":"uint48","name":"nonce","type":"uint48"}],"stateMutability":"view","type":"function"}
+]`
+
+// permit2Types eip-712 types of the Permit2 PermitSingle
+var permit2Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"PermitDetails": {
+		{Name: "token", Type: "address"},
+		{Name: "amount", Type: "uint160"},
+		{Name: "expiration", Type: "uint48"},
+		{Name: "nonce", Type: "uint48"},
+	},
+	"PermitSingle": {
+		{Name: "details", Type: "PermitDetails"},
+		{Name: "spender", Type: "address"},
+		{Name: "sigDeadline", Type: "uint256"},
+	},
+}
+
+// maxPermit2Amount Permit2 allowances are uint160
+var maxPermit2Amount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+
+var (
+	permit2ABIOnce sync.Once
+	permit2ABIVal  abi.ABI
+	permit2ABIErr  error
+)
+
+func loadPermit2ABI() {
+	permit2ABIVal, permit2ABIErr = abi.JSON(strings.NewReader(permit2ABI))
+}
+
+// Permit2Details allowance of the token the spender gets from Permit2
+type Permit2Details struct {
+	Token      common.Address
+	Amount     *big.Int
+	Expiration *big.Int
+	Nonce      *big.Int
+}
+
+// Permit2 signed PermitSingle the router submits to Permit2 along with the swap, Signature is r, s, v
+type Permit2 struct {
+	Owner       common.Address
+	Details     Permit2Details
+	Spender     common.Address
+	SigDeadline *big.Int
+	Signature   []byte
+}
+
+type Permit2Req struct {
+	// Permit2 Permit2 contract of the network
+	Permit2    common.Address
+	Token      common.Address
+	Spender    common.Address
+	Amount     *big.Int
+	Expiration *big.Int
+	Deadline   *big.Int
+	ChainID    *big.Int
+	Signer     Signer
+}
+
+// SignPermit2 signs the Permit2 PermitSingle of the token to the spender as eip-712 typed data with the signer of the
+// wallet. ErrPermitNotSupported is returned when Permit2 is not deployed on the network or the token is not approved
+// to it for the amount, so the classic approve to the spender is to be made instead
+func SignPermit2(ctx context.Context, caller bind.ContractCaller, req *Permit2Req) (*Permit2, error) {
+	permit2ABIOnce.Do(loadPermit2ABI)
+	if permit2ABIErr != nil {
+		return nil, permit2ABIErr
+	}
+	if req.Amount.Cmp(maxPermit2Amount) > 0 {
+		return nil, ErrPermitNotSupported
+	}
+
+	code, err := caller.CodeAt(ctx, req.Permit2, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CodeAt")
+	}
+	if len(code) == 0 {
+		return nil, ErrPermitNotSupported
+	}
+
+	owner := req.Signer.Address()
+
+	approved, err := erc20Allowance(ctx, caller, req.Token, owner, req.Permit2)
+	if err != nil {
+		return nil, err
+	}
+	if approved.Cmp(req.Amount) < 0 {
+		return nil, ErrPermitNotSupported
+	}
+
+	data, err := permit2ABIVal.Pack("allowance", owner, req.Token, req.Spender)
+	if err != nil {
+		return nil, errors.Wrap(err, "allowance pack")
+	}
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &req.Permit2, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Permit2 allowance")
+	}
+	allowance, err := permit2ABIVal.Unpack("allowance", out)
+	if err != nil {
+		return nil, errors.Wrap(err, "Permit2 allowance unpack")
+	}
+	nonce, ok := allowance[2].(*big.Int)
+	if !ok {
+		return nil, errors.New("invalid Permit2 allowance nonce")
+	}
+
+	p := &Permit2{
+		Owner: owner,
+		Details: Permit2Details{
+			Token:      req.Token,
+			Amount:     req.Amount,
+			Expiration: req.Expiration,
+			Nonce:      nonce,
+		},
+		Spender:     req.Spender,
+		SigDeadline: req.Deadline,
+	}
+
+	typed := apitypes.TypedData{
+		Types:       permit2Types,
+		PrimaryType: "PermitSingle",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Permit2",
+			ChainId:           (*math.HexOrDecimal256)(req.ChainID),
+			VerifyingContract: req.Permit2.String(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"details": map[string]interface{}{
+				"token":      p.Details.Token.String(),
+				"amount":     p.Details.Amount.String(),
+				"expiration": p.Details.Expiration.String(),
+				"nonce":      p.Details.Nonce.String(),
+			},
+			"spender":     p.Spender.String(),
+			"sigDeadline": p.SigDeadline.String(),
+		},
+	}
+
+	p.Signature, err = req.Signer.SignTypedData(ctx, typed)
+	if err != nil {
+		return nil, errors.Wrap(err, "SignTypedData")
+	}
+	return p, nil
+}
+
+// erc20Allowance allowance of the token of the owner to the spender
+func erc20Allowance(ctx context.Context, caller bind.ContractCaller, token, owner, spender common.Address) (*big.Int, error) {
+	multicallABIsOnce.Do(loadMulticallABIs)
+	if multicallABIsErr != nil {
+		return nil, multicallABIsErr
+	}
+	data, err := erc20ABI.Pack("allowance", owner, spender)
+	if err != nil {
+		return nil, errors.Wrap(err, "allowance pack")
+	}
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &token, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "allowance")
+	}
+	if len(out) < 32 {
+		return nil, ErrPermitNotSupported
+	}
+	return new(big.Int).SetBytes(out[:32]), nil
+}
@@ -22,14 +22,21 @@ var TokenAddress = map[defi.Token]common.Address{
 	v1.Token_USDT:  common.HexToAddress("0xc2132d05d31c914a87c6611c10748aeb04b58e8f"),
 	v1.Token_STG:   common.HexToAddress("0x2F6F07CDcf3588944Bf4C42aC74ff24bF56e7590"),
 	v1.Token_USDC:  common.HexToAddress("0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174"),
-	v1.Token_veSTG: common.HexToAddress("0xb0d502e938ed5f4df2e681fe6e419ff29631d62b"),
 	v1.Token_MATIC: common.HexToAddress("0x0000000000000000000000000000000000000000"),
+	v1.Token_veSTG: common.HexToAddress("0x3AB2DA31bBD886A7eDF68a6b60D3CDe657D3A15D"),
+	v1.Token_DAI:   common.HexToAddress("0x8f3Cf7ad23Cd3CaDbD9735AFf958023239c6A063"),
+	v1.Token_MAI:   common.HexToAddress("0xa3Fa99A148fA48D14Ed51d610c367C61876997F1"),
 }
 
 var Dict = defi.Dict{
 	Stargate: defi.Stargate{
 		StargateRouterAddress:    common.HexToAddress("0x45A01E4e04F14f7A4a6702c74187c5F6222033cd"),
 		StargateRouterEthAddress: common.HexToAddress(""),
+		LPStakingAddress:         common.HexToAddress("0x8731d54E9D02c286767d56ac03e8037C07e01e98"),
+	},
+	Aave: defi.Aave{
+		Pool:        common.HexToAddress("0x794a61358D6845594F94dc1DB02A252b5b4814aD"),
+		WETHGateway: common.HexToAddress("0x1e4b7A6b903680eab0c5dAbcb8fD429cD2a9598c"),
 	},
 }
 
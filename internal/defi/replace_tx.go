@@ -0,0 +1,127 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// replaceFeeBumpPercent both fee caps of the replacement are raised by 15%, nodes accept it only with 10% at least
+const replaceFeeBumpPercent = 15
+
+type ReplaceTxReq struct {
+	TxId     common.Hash
+	WalletPK string
+	// Cancel replaces the tx with the 0 value transfer to the wallet itself
+	Cancel bool
+}
+
+// ReplaceTx rebroadcasts the pending tx with the same nonce and the bumped fee, the fee is never lower than
+// the fast one suggested now
+func (c *EtheriumClient) ReplaceTx(ctx context.Context, req *ReplaceTxReq) (*bozdo.Transaction, error) {
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	old, isPending, err := c.Cli.TransactionByHash(ctx, req.TxId)
+	if err != nil {
+		return nil, errors.Wrap(err, "TransactionByHash")
+	}
+	if !isPending {
+		return nil, ErrTxNotPending
+	}
+
+	signer := types.LatestSignerForChainID(c.Cfg.networkId)
+	sender, err := types.Sender(signer, old)
+	if err != nil {
+		return nil, errors.Wrap(err, "types.Sender")
+	}
+	if sender != tr.WalletAddr {
+		return nil, errors.New("tx is not sent by the wallet")
+	}
+
+	to, value, data, gas := old.To(), old.Value(), old.Data(), old.Gas()
+	code := CodeContract
+	if req.Cancel {
+		to, value, data, gas = &tr.WalletAddr, big.NewInt(0), nil, 21000
+		code = CodeTransfer
+	}
+
+	var tx *types.Transaction
+	if old.Type() == types.DynamicFeeTxType {
+		fee, err := c.SuggestDynamicFee(ctx, v1.FeeSpeed_FeeFast)
+		if err != nil {
+			return nil, err
+		}
+		if fee == nil {
+			return nil, errors.New("network has no base fee")
+		}
+		tip := bigMax(bumpFee(old.GasTipCap()), fee.Tip)
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   c.Cfg.networkId,
+			Nonce:     old.Nonce(),
+			GasTipCap: tip,
+			GasFeeCap: bigMax(bumpFee(old.GasFeeCap()), bozdo.BigIntSum(new(big.Int).Mul(fee.BaseFee, big.NewInt(baseFeeHeadroom)), tip)),
+			Gas:       gas,
+			To:        to,
+			Value:     value,
+			Data:      data,
+		})
+	} else {
+		gasPrice, err := c.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    old.Nonce(),
+			GasPrice: bigMax(bumpFee(old.GasPrice()), gasPrice),
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		})
+	}
+
+	tx, err = tr.Signer.SignTx(ctx, tx, c.Cfg.networkId)
+	if err != nil {
+		return nil, errors.Wrap(err, "SignTx")
+	}
+	if err := c.Cli.SendTransaction(ctx, tx); err != nil {
+		return nil, errors.Wrap(err, "SendTransaction")
+	}
+
+	return c.NewTx(tx.Hash(), code, nil), nil
+}
+
+// MinedTx the tx of the ones sharing the nonce that is mined, nil if none is
+func (c *EtheriumClient) MinedTx(ctx context.Context, ids ...common.Hash) (*common.Hash, error) {
+	for i := range ids {
+		_, err := c.Cli.TransactionReceipt(ctx, ids[i])
+		if err == nil {
+			return &ids[i], nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, errors.Wrap(err, "TransactionReceipt")
+		}
+	}
+	return nil, nil
+}
+
+func bumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+replaceFeeBumpPercent))
+	return bumped.Div(bumped, big.NewInt(100)).Add(bumped, big.NewInt(1))
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
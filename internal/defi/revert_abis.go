@@ -0,0 +1,133 @@
+package defi
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/hardstylez72/cry/internal/defi/contracts/aave/addressesprovider"
+	"github.com/hardstylez72/cry/internal/defi/contracts/aave/oracle"
+	aavepool "github.com/hardstylez72/cry/internal/defi/contracts/aave/pool"
+	"github.com/hardstylez72/cry/internal/defi/contracts/aave/wethgateway"
+	"github.com/hardstylez72/cry/internal/defi/contracts/across/spokepool"
+	"github.com/hardstylez72/cry/internal/defi/contracts/arbitrum/arbsys"
+	"github.com/hardstylez72/cry/internal/defi/contracts/arbitrum/inbox"
+	"github.com/hardstylez72/cry/internal/defi/contracts/arbitrum/nodeinterface"
+	"github.com/hardstylez72/cry/internal/defi/contracts/arbitrum/outbox"
+	"github.com/hardstylez72/cry/internal/defi/contracts/compound/comptroller"
+	"github.com/hardstylez72/cry/internal/defi/contracts/compound/ctoken"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_721"
+	"github.com/hardstylez72/cry/internal/defi/contracts/eth_p"
+	"github.com/hardstylez72/cry/internal/defi/contracts/hop/ammwrapper"
+	"github.com/hardstylez72/cry/internal/defi/contracts/hop/l1bridge"
+	"github.com/hardstylez72/cry/internal/defi/contracts/layerzero/oft"
+	"github.com/hardstylez72/cry/internal/defi/contracts/layerzero/oftv2"
+	"github.com/hardstylez72/cry/internal/defi/contracts/layerzero/onft"
+	"github.com/hardstylez72/cry/internal/defi/contracts/linea/messageservice"
+	"github.com/hardstylez72/cry/internal/defi/contracts/maverickrouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/disputegame"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/disputegamefactory"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/l1standardbridge"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/messagepasser"
+	"github.com/hardstylez72/cry/internal/defi/contracts/opstack/portal"
+	"github.com/hardstylez72/cry/internal/defi/contracts/optimism_fee"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/ethroutereth"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/factory"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/lpstaking"
+	stargatepool "github.com/hardstylez72/cry/internal/defi/contracts/stargate/pool"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/router"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/routereth"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/stake"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/startgatemavrouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stg"
+	"github.com/hardstylez72/cry/internal/defi/contracts/testnetbridge"
+	"github.com/hardstylez72/cry/internal/defi/contracts/traderjoe/lbquoter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/traderjoe/lbrouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/weth"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/eraname"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/ezkaliburrouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/izumiliquiditymanager"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/izumipool"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/izumiquoter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/izumirouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/mailbox"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/maverickpool"
+	muteiorouter "github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/muteio"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/pancakeswap"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/spacefirouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/syncswapclassicpool"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/syncswappoolfactory"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/syncswappoolmaster"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/syncswaprouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/syncswapvault"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/univ2factory"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/univ2pair"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/velocorerouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/vesyncrouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/zkswaprouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/zns"
+)
+
+// revertABIs abis of all the bound contracts, the custom errors of the reverts are decoded with
+var revertABIs = []*bind.MetaData{
+	addressesprovider.StorageMetaData,
+	oracle.StorageMetaData,
+	aavepool.StorageMetaData,
+	wethgateway.StorageMetaData,
+	spokepool.StorageMetaData,
+	arbsys.StorageMetaData,
+	inbox.StorageMetaData,
+	nodeinterface.StorageMetaData,
+	outbox.StorageMetaData,
+	comptroller.StorageMetaData,
+	ctoken.StorageMetaData,
+	erc_20.StorageMetaData,
+	erc_721.StorageMetaData,
+	eth_p.StorageMetaData,
+	ammwrapper.StorageMetaData,
+	l1bridge.StorageMetaData,
+	oft.StorageMetaData,
+	oftv2.StorageMetaData,
+	onft.StorageMetaData,
+	messageservice.StorageMetaData,
+	maverickrouter.StorageMetaData,
+	disputegame.StorageMetaData,
+	disputegamefactory.StorageMetaData,
+	l1standardbridge.StorageMetaData,
+	messagepasser.StorageMetaData,
+	portal.StorageMetaData,
+	optimism_fee.StorageMetaData,
+	ethroutereth.StorageMetaData,
+	factory.StorageMetaData,
+	lpstaking.StorageMetaData,
+	stargatepool.StorageMetaData,
+	router.RouterMetaData,
+	routereth.StorageMetaData,
+	stake.StakerMetaData,
+	startgatemavrouter.StorageMetaData,
+	stg.StgMetaData,
+	testnetbridge.TestnetbridgeMetaData,
+	lbquoter.StorageMetaData,
+	lbrouter.StorageMetaData,
+	weth.StorageMetaData,
+	eraname.StorageMetaData,
+	ezkaliburrouter.StorageMetaData,
+	izumiliquiditymanager.StorageMetaData,
+	izumipool.StorageMetaData,
+	izumiquoter.StorageMetaData,
+	izumirouter.StorageMetaData,
+	mailbox.StorageMetaData,
+	maverickpool.StorageMetaData,
+	muteiorouter.StorageMetaData,
+	pancakeswap.RouterMetaData,
+	spacefirouter.StorageMetaData,
+	syncswapclassicpool.StorageMetaData,
+	syncswappoolfactory.StorageMetaData,
+	syncswappoolmaster.StorageMetaData,
+	syncswaprouter.StorageMetaData,
+	syncswapvault.StorageMetaData,
+	univ2factory.StorageMetaData,
+	univ2pair.StorageMetaData,
+	velocorerouter.StorageMetaData,
+	vesyncrouter.StorageMetaData,
+	zkswaprouter.StorageMetaData,
+	zns.StorageMetaData,
+}
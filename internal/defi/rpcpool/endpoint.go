@@ -0,0 +1,70 @@
+package rpcpool
+
+import (
+	"strconv"
+	"strings"
+
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+)
+
+// Endpoint rpc url of the network, the requests are spread over the healthy endpoints by weight
+type Endpoint struct {
+	URL    string
+	Weight uint32
+}
+
+const defaultWeight = 1
+
+// Parse endpoints of the comma separated url|weight list, the weight is optional. The single url is the list of one
+func Parse(s string) []Endpoint {
+	res := make([]Endpoint, 0)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		e := Endpoint{URL: part, Weight: defaultWeight}
+		if u, w, ok := strings.Cut(part, "|"); ok {
+			e.URL = strings.TrimSpace(u)
+			if weight, err := strconv.ParseUint(strings.TrimSpace(w), 10, 32); err == nil && weight > 0 {
+				e.Weight = uint32(weight)
+			}
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// Join endpoints into the list the clients are configured with
+func Join(endpoints []Endpoint) string {
+	parts := make([]string, 0, len(endpoints))
+	for _, e := range endpoints {
+		if e.URL == "" {
+			continue
+		}
+		if e.Weight > defaultWeight {
+			parts = append(parts, e.URL+"|"+strconv.FormatUint(uint64(e.Weight), 10))
+		} else {
+			parts = append(parts, e.URL)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// Primary url the client dials, the requests to it are spread over the pool
+func Primary(s string) string {
+	endpoints := Parse(s)
+	if len(endpoints) == 0 {
+		return s
+	}
+	return endpoints[0].URL
+}
+
+// FromSettings endpoint list of the network settings, the rpc endpoint goes first, the fallbacks follow it
+func FromSettings(s *v1.NetworkSettings) string {
+	endpoints := []Endpoint{{URL: s.GetRpcEndpoint(), Weight: defaultWeight}}
+	for _, e := range s.GetRpcFallbacks() {
+		endpoints = append(endpoints, Endpoint{URL: e.GetUrl(), Weight: e.GetWeight()})
+	}
+	return Join(endpoints)
+}
@@ -0,0 +1,349 @@
+package rpcpool
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	healthCheckInterval = 30 * time.Second
+	healthCheckTimeout  = 5 * time.Second
+	// maxHeadLag blocks the endpoint may be behind the best one of the pool
+	maxHeadLag = 10
+	// maxFailures in a row the endpoint is taken out of the pool after
+	maxFailures     = 3
+	failureCooldown = time.Minute
+	// groupIdleTimeout the pool not used for is forgotten with its health checks
+	groupIdleTimeout = 10 * time.Minute
+)
+
+// Checks json-rpc methods the health of the endpoint is checked with, the empty ones are not checked
+type Checks struct {
+	ChainId string
+	Head    string
+}
+
+var (
+	EVM      = Checks{ChainId: "eth_chainId", Head: "eth_blockNumber"}
+	StarkNet = Checks{ChainId: "starknet_chainId", Head: "starknet_blockNumber"}
+	// NoChecks the endpoints are taken out of the pool on errors only
+	NoChecks = Checks{}
+)
+
+type endpoint struct {
+	Endpoint
+
+	mu        sync.Mutex
+	requests  uint64
+	errors    uint64
+	failures  int
+	downUntil time.Time
+	// unhealthy by the last health check
+	unhealthy bool
+	latency   time.Duration
+	head      uint64
+	lastErr   string
+}
+
+func (e *endpoint) available(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !e.unhealthy && now.After(e.downUntil)
+}
+
+func (e *endpoint) success(latency time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests++
+	e.failures = 0
+	e.latency = latency
+}
+
+func (e *endpoint) fail(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests++
+	e.errors++
+	e.failures++
+	e.lastErr = err.Error()
+	if e.failures >= maxFailures {
+		e.downUntil = time.Now().Add(failureCooldown)
+	}
+}
+
+type group struct {
+	key       string
+	source    string
+	endpoints []*endpoint
+	checks    Checks
+	// probes the transport of the client the pool is made for, so they go through its proxy
+	probes *http.Client
+
+	mu       sync.Mutex
+	lastUsed time.Time
+}
+
+var (
+	groupsMu sync.Mutex
+	groups   = map[string]*group{}
+)
+
+// Proxied transport of the client that goes through the proxy, the pools of the endpoints are kept per proxy
+type Proxied interface {
+	ProxyHost() string
+}
+
+// getGroup pool of the endpoints shared by the clients of them going through the same proxy, so the health is known
+// before the first request and the failures of one proxy do not take the endpoints out of the pools of the others
+func getGroup(s string, checks Checks, next http.RoundTripper) *group {
+	key := checks.ChainId + "/" + s
+	if p, ok := next.(Proxied); ok {
+		key += "/" + p.ProxyHost()
+	}
+
+	groupsMu.Lock()
+	defer groupsMu.Unlock()
+	g, ok := groups[key]
+	if !ok {
+		g = &group{
+			key:      key,
+			source:   s,
+			checks:   checks,
+			probes:   &http.Client{Transport: next},
+			lastUsed: time.Now(),
+		}
+		for _, e := range Parse(s) {
+			g.endpoints = append(g.endpoints, &endpoint{Endpoint: e})
+		}
+		groups[key] = g
+		if len(g.endpoints) > 1 && checks.ChainId != "" {
+			go g.healthCheck()
+		}
+	}
+	return g
+}
+
+func (g *group) use() {
+	g.mu.Lock()
+	g.lastUsed = time.Now()
+	g.mu.Unlock()
+}
+
+func (g *group) idle() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return time.Since(g.lastUsed) > groupIdleTimeout
+}
+
+func (g *group) healthCheck() {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		g.check()
+		<-ticker.C
+		if g.idle() {
+			groupsMu.Lock()
+			delete(groups, g.key)
+			groupsMu.Unlock()
+			return
+		}
+	}
+}
+
+type probe struct {
+	chainId string
+	head    uint64
+	latency time.Duration
+	err     error
+}
+
+// check probes all the endpoints, the ones of another chain than the primary one or lagging behind the best head
+// are unhealthy until the next check
+func (g *group) check() {
+	probes := make([]probe, len(g.endpoints))
+	var wg sync.WaitGroup
+	for i := range g.endpoints {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			probes[i] = g.probe(g.endpoints[i].URL)
+		}(i)
+	}
+	wg.Wait()
+
+	chainId := ""
+	var best uint64
+	for _, p := range probes {
+		if p.err != nil {
+			continue
+		}
+		if chainId == "" {
+			chainId = p.chainId
+		}
+		if p.head > best {
+			best = p.head
+		}
+	}
+
+	for i, e := range g.endpoints {
+		p := probes[i]
+		err := p.err
+		if err == nil && p.chainId != chainId {
+			err = errors.New("chain id " + p.chainId + " does not match " + chainId)
+		}
+		if err == nil && g.checks.Head != "" && p.head+maxHeadLag < best {
+			err = errors.New("head is behind the best one of the pool")
+		}
+
+		e.mu.Lock()
+		e.unhealthy = err != nil
+		if err != nil {
+			e.lastErr = err.Error()
+		} else {
+			e.latency = p.latency
+			e.head = p.head
+		}
+		e.mu.Unlock()
+	}
+}
+
+func (g *group) probe(url string) probe {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	chainId, err := g.call(ctx, url, g.checks.ChainId)
+	if err != nil {
+		return probe{err: err}
+	}
+	res := probe{chainId: string(chainId), latency: time.Since(start)}
+
+	if g.checks.Head != "" {
+		head, err := g.call(ctx, url, g.checks.Head)
+		if err != nil {
+			return probe{err: err}
+		}
+		res.head, err = parseNumber(head)
+		if err != nil {
+			return probe{err: err}
+		}
+	}
+	return res
+}
+
+func (g *group) call(ctx context.Context, url, method string) (json.RawMessage, error) {
+	body, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": []any{}})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := g.probes.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("status: " + resp.Status)
+	}
+
+	var msg struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return nil, errors.Wrap(err, "json.Unmarshal")
+	}
+	if msg.Error != nil {
+		return nil, errors.New(msg.Error.Message)
+	}
+	return msg.Result, nil
+}
+
+// parseNumber block number of the hex string of eth or the plain number of starknet
+func parseNumber(raw json.RawMessage) (uint64, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		var n uint64
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return 0, errors.Wrap(err, "invalid block number")
+		}
+		return n, nil
+	}
+	n, ok := new(big.Int).SetString(strings.TrimPrefix(s, "0x"), 16)
+	if !ok || !n.IsUint64() {
+		return 0, errors.New("invalid block number: " + s)
+	}
+	return n.Uint64(), nil
+}
+
+type EndpointStats struct {
+	URL      string
+	Requests uint64
+	Errors   uint64
+	Healthy  bool
+	Latency  time.Duration
+	Head     uint64
+	LastErr  string
+}
+
+// Stats request and error counters of the endpoints of the endpoint list, empty if its pool is not in use. The
+// counters of the pools of the proxies are summed up, the endpoint is healthy if it is so through any of them
+func Stats(endpoints string) []EndpointStats {
+	groupsMu.Lock()
+	defer groupsMu.Unlock()
+
+	now := time.Now()
+	res := make([]EndpointStats, 0)
+	index := map[string]int{}
+	for _, g := range groups {
+		if g.source != endpoints {
+			continue
+		}
+		for _, e := range g.endpoints {
+			healthy := e.available(now)
+			e.mu.Lock()
+			i, ok := index[e.URL]
+			if !ok {
+				i = len(res)
+				index[e.URL] = i
+				res = append(res, EndpointStats{URL: e.URL})
+			}
+			st := &res[i]
+			st.Requests += e.requests
+			st.Errors += e.errors
+			st.Healthy = st.Healthy || healthy
+			if e.latency != 0 {
+				st.Latency = e.latency
+			}
+			if e.head > st.Head {
+				st.Head = e.head
+			}
+			if e.lastErr != "" {
+				st.LastErr = e.lastErr
+			}
+			e.mu.Unlock()
+		}
+	}
+	return res
+}
@@ -0,0 +1,243 @@
+package rpcpool
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hardstylez72/cry/internal/log"
+	"github.com/pkg/errors"
+)
+
+// sendMethods json-rpc methods that are not retried on another endpoint, the request may be already accepted
+var sendMethods = []string{"eth_send", "starknet_add", "tx_submit", "submit_txs_batch"}
+
+// Transport spreads the requests to the primary url over the endpoints of the pool. The idempotent requests failed
+// on the endpoint are retried on the next one, the other requests go to the single one
+type Transport struct {
+	endpoints string
+	checks    Checks
+	primary   string
+	next      http.RoundTripper
+}
+
+// NewHTTPClient client of the endpoint list, the requests to other urls go through the client as they are
+func NewHTTPClient(cli *http.Client, endpoints string, checks Checks) *http.Client {
+	if cli == nil {
+		cli = &http.Client{}
+	}
+	next := cli.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	pooled := *cli
+	t := &Transport{
+		endpoints: endpoints,
+		checks:    checks,
+		primary:   strings.TrimSuffix(Primary(endpoints), "/"),
+		next:      next,
+	}
+	t.pool()
+	pooled.Transport = t
+	return &pooled
+}
+
+// pool the group of the endpoints is resolved on every request, the one forgotten for being idle is made again with
+// its health checks
+func (t *Transport) pool() *group {
+	return getGroup(t.endpoints, t.checks, t.next)
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	suffix, ok := strings.CutPrefix(req.URL.String(), t.primary)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+	g := t.pool()
+	if len(g.endpoints) < 2 {
+		return t.next.RoundTrip(req)
+	}
+	g.use()
+
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	endpoints := g.order()
+	if !idempotent(req.Method, body) {
+		endpoints = endpoints[:1]
+	}
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	for i, e := range endpoints {
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		var r *http.Request
+		r, err = rewrite(req, e.URL+suffix, body)
+		if err != nil {
+			return nil, err
+		}
+
+		start := time.Now()
+		resp, err = t.next.RoundTrip(r)
+		failure := err
+		if err == nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError) {
+			failure = errors.New("status: " + resp.Status)
+		}
+		if err == nil && resp.StatusCode == http.StatusOK {
+			var msg string
+			msg, err = rateLimited(resp)
+			failure = err
+			if err != nil {
+				resp = nil
+			}
+			if msg != "" {
+				failure = errors.New("rpc error: " + msg)
+			}
+		}
+		if failure == nil {
+			e.success(time.Since(start))
+			return resp, nil
+		}
+		if req.Context().Err() != nil {
+			return resp, err
+		}
+
+		e.fail(failure)
+		if i+1 < len(endpoints) {
+			log.Log.Warnw("rpc endpoint failed, trying the next one", "url", e.URL, "err", failure.Error())
+		}
+	}
+	return resp, err
+}
+
+// order available endpoints in the random order by weight, the unavailable ones go last
+func (g *group) order() []*endpoint {
+	now := time.Now()
+	type ranked struct {
+		e   *endpoint
+		key float64
+	}
+	list := make([]ranked, 0, len(g.endpoints))
+	for _, e := range g.endpoints {
+		// the weighted random sampling key, the bigger the weight the closer the key to 1
+		key := math.Pow(rand.Float64(), 1/float64(e.Weight))
+		if !e.available(now) {
+			key -= 1
+		}
+		list = append(list, ranked{e: e, key: key})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].key > list[j].key })
+
+	res := make([]*endpoint, 0, len(list))
+	for _, r := range list {
+		res = append(res, r.e)
+	}
+	return res
+}
+
+func rewrite(req *http.Request, url string, body []byte) (*http.Request, error) {
+	r, err := http.NewRequestWithContext(req.Context(), req.Method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "http.NewRequest")
+	}
+	r.Header = req.Header.Clone()
+	if body == nil {
+		r.Body = http.NoBody
+	}
+	return r, nil
+}
+
+// rateLimitCodes json-rpc error codes the endpoints reject the requests over the limit with
+var rateLimitCodes = []int{-32005, -32029, -32090, 429}
+
+// rateLimited message of the json-rpc error the endpoint rejected the request over its limit with along with http
+// 200, empty if the request is not rejected. The body is kept readable
+func rateLimited(resp *http.Response) (string, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	type message struct {
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	var batch []message
+	if err := json.Unmarshal(body, &batch); err != nil {
+		var m message
+		if err := json.Unmarshal(body, &m); err != nil {
+			return "", nil
+		}
+		batch = []message{m}
+	}
+
+	for _, m := range batch {
+		if m.Error == nil {
+			continue
+		}
+		msg := strings.ToLower(m.Error.Message)
+		if strings.Contains(msg, "rate limit") || strings.Contains(msg, "too many requests") || strings.Contains(msg, "limit exceeded") {
+			return m.Error.Message, nil
+		}
+		for _, code := range rateLimitCodes {
+			if m.Error.Code == code {
+				return m.Error.Message, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// idempotent the request only reads the chain, it is safe to send it again to another endpoint
+func idempotent(method string, body []byte) bool {
+	if method == http.MethodGet {
+		return true
+	}
+
+	type message struct {
+		Method string `json:"method"`
+	}
+	var batch []message
+	if err := json.Unmarshal(body, &batch); err != nil {
+		var m message
+		if err := json.Unmarshal(body, &m); err != nil {
+			return false
+		}
+		batch = []message{m}
+	}
+
+	for _, m := range batch {
+		if m.Method == "" {
+			return false
+		}
+		for _, send := range sendMethods {
+			if strings.HasPrefix(m.Method, send) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package rpcpool
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	endpoints := Parse("https://a.io, https://b.io|3,")
+	assert.Equal(t, []Endpoint{{URL: "https://a.io", Weight: 1}, {URL: "https://b.io", Weight: 3}}, endpoints)
+	assert.Equal(t, "https://a.io,https://b.io|3", Join(endpoints))
+	assert.Equal(t, "https://a.io", Primary("https://a.io,https://b.io|3"))
+}
+
+func TestIdempotent(t *testing.T) {
+	assert.True(t, idempotent(http.MethodPost, []byte(`{"method":"eth_call"}`)))
+	assert.True(t, idempotent(http.MethodPost, []byte(`[{"method":"eth_getBalance"},{"method":"eth_blockNumber"}]`)))
+	assert.False(t, idempotent(http.MethodPost, []byte(`{"method":"eth_sendRawTransaction"}`)))
+	assert.False(t, idempotent(http.MethodPost, []byte(`[{"method":"eth_call"},{"method":"eth_sendRawTransaction"}]`)))
+	assert.False(t, idempotent(http.MethodPost, []byte(`not json`)))
+}
+
+func TestTransportFailover(t *testing.T) {
+	var failed, served int32
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&failed, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&served, 1)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer up.Close()
+
+	endpoints := down.URL + "|4000000000," + up.URL
+	cli := NewHTTPClient(nil, endpoints, NoChecks)
+
+	for i := 0; i < maxFailures; i++ {
+		resp, err := cli.Post(Primary(endpoints), "application/json", bytes.NewBufferString(`{"method":"eth_chainId"}`))
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, string(body), "0x1")
+	}
+	assert.Equal(t, int32(maxFailures), served)
+
+	// the endpoint failed in a row is out of the pool
+	before := atomic.LoadInt32(&failed)
+	resp, err := cli.Post(Primary(endpoints), "application/json", bytes.NewBufferString(`{"method":"eth_sendRawTransaction"}`))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, before, atomic.LoadInt32(&failed))
+}
+
+func TestTransportRateLimited(t *testing.T) {
+	var limited, served int32
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&limited, 1)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"daily request count exceeded, request rate limited"}}`))
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&served, 1)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer up.Close()
+
+	endpoints := down.URL + "|4000000000," + up.URL
+	cli := NewHTTPClient(nil, endpoints, NoChecks)
+
+	resp, err := cli.Post(Primary(endpoints), "application/json", bytes.NewBufferString(`{"method":"eth_blockNumber"}`))
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "0x1")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&limited))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&served))
+
+	stats := Stats(endpoints)
+	assert.Len(t, stats, 2)
+	for _, s := range stats {
+		if s.URL == down.URL {
+			assert.Equal(t, uint64(1), s.Errors)
+		}
+	}
+}
+
+type proxied struct {
+	http.RoundTripper
+	host string
+}
+
+func (p proxied) ProxyHost() string {
+	return p.host
+}
+
+func TestTransportProxyPools(t *testing.T) {
+	var served int32
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&served, 1)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer up.Close()
+
+	endpoints := down.URL + "|4000000000," + up.URL
+	a := NewHTTPClient(&http.Client{Transport: proxied{http.DefaultTransport, "a"}}, endpoints, NoChecks)
+	b := NewHTTPClient(&http.Client{Transport: proxied{http.DefaultTransport, "b"}}, endpoints, NoChecks)
+
+	for i := 0; i < maxFailures; i++ {
+		resp, err := a.Post(Primary(endpoints), "application/json", bytes.NewBufferString(`{"method":"eth_chainId"}`))
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// the failures through one proxy keep the endpoint in the pool of the other
+	ga := a.Transport.(*Transport).pool()
+	gb := b.Transport.(*Transport).pool()
+	assert.NotSame(t, ga, gb)
+	assert.False(t, ga.endpoints[0].available(time.Now()))
+	assert.True(t, gb.endpoints[0].available(time.Now()))
+
+	// the pool forgotten for being idle is made again on the next request
+	groupsMu.Lock()
+	delete(groups, ga.key)
+	groupsMu.Unlock()
+
+	before := atomic.LoadInt32(&served)
+	resp, err := a.Post(Primary(endpoints), "application/json", bytes.NewBufferString(`{"method":"eth_chainId"}`))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, before+1, atomic.LoadInt32(&served))
+
+	groupsMu.Lock()
+	again := groups[ga.key]
+	groupsMu.Unlock()
+	assert.NotNil(t, again)
+	assert.NotSame(t, ga, again)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	"github.com/hardstylez72/cry/internal/server/config"
 	"github.com/hardstylez72/cry/internal/traderjoe"
 	"github.com/pkg/errors"
@@ -20,6 +21,8 @@ const RetryMax = 1
 type Dict struct {
 	Stargate                 Stargate
 	TestNetBridgeSwapAddress common.Address
+	TraderJoe                TraderJoe
+	Aave                     Aave
 }
 
 type SyncSwap struct {
@@ -30,6 +33,7 @@ type SyncSwap struct {
 type Stargate struct {
 	StargateRouterAddress    common.Address
 	StargateRouterEthAddress common.Address
+	LPStakingAddress         common.Address
 }
 
 type EtheriumClient struct {
@@ -52,37 +56,46 @@ type ClientConfig struct {
 
 func NewEVMClient(c *ClientConfig) (*EtheriumClient, error) {
 
-	rpcClient, err := rpc.DialOptions(context.Background(), c.MainNet, rpc.WithHTTPClient(c.Httpcli))
+	httpCli := rpcpool.NewHTTPClient(c.Httpcli, c.MainNet, rpcpool.EVM)
+	rpcClient, err := rpc.DialOptions(context.Background(), rpcpool.Primary(c.MainNet), rpc.WithHTTPClient(httpCli))
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to connect to ETH: "+c.MainNet)
 	}
 
-	traderJoe := ""
-	if config.CFG != nil {
-		traderJoe = config.CFG.HalperHost
+	var traderJoe *traderjoe.Service
+	if config.CFG != nil && config.CFG.HalperHost != "" {
+		traderJoe = traderjoe.NewService(&traderjoe.Config{Host: config.CFG.HalperHost})
 	}
 
 	return &EtheriumClient{
 		Cli:              ethclient.NewClient(rpcClient),
 		Cfg:              c,
 		RpcCli:           rpcClient,
-		traderJoeService: traderjoe.NewService(&traderjoe.Config{Host: traderJoe}),
+		traderJoeService: traderJoe,
 	}, nil
 }
 
+// ResoleGas sets the eip-1559 fee of the speed of the network, the gas rule caps it. The networks without the base fee
+// and the failed fee history leave the legacy gas price to the node suggestion or the rule
 func (c *EtheriumClient) ResoleGas(ctx context.Context, gas *bozdo.Gas, opt *bind.TransactOpts) *bind.TransactOpts {
+	if gas.RuleSet() {
+		opt.GasLimit = gas.GasLimit.Uint64()
+	}
 
-	if !gas.RuleSet() {
+	fee, err := c.SuggestDynamicFee(ctx, gas.Speed())
+	if err != nil || fee == nil {
+		if gas.RuleSet() {
+			opt.GasPrice = &gas.GasPrice
+		}
 		return opt
 	}
 
-	head, errHead := c.Cli.HeaderByNumber(ctx, nil)
-	if errHead == nil && head.BaseFee != nil {
-		opt.GasLimit = gas.GasLimit.Uint64()
+	opt.GasFeeCap, opt.GasTipCap = fee.FeeCap, fee.Tip
+	if gas.RuleSet() {
 		opt.GasFeeCap = &gas.GasPrice
-	} else {
-		opt.GasLimit = gas.GasLimit.Uint64()
-		opt.GasPrice = &gas.GasPrice
+	}
+	if opt.GasTipCap.Cmp(opt.GasFeeCap) > 0 {
+		opt.GasTipCap = opt.GasFeeCap
 	}
 
 	return opt
@@ -0,0 +1,177 @@
+package defi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/hardstylez72/cry/internal/server/config"
+	"github.com/pkg/errors"
+)
+
+var ErrExternalSignerNotSet = errors.New("wallet key is held by the external signer, but it is not set")
+
+// Signer signs the txs of the wallet. The key is held by the signer, it may be another process
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	// SignTypedData eip-712 signature of the data, v is in the 27/28 form
+	SignTypedData(ctx context.Context, data apitypes.TypedData) ([]byte, error)
+}
+
+// NewKeySigner signer of the wallet with the private key in memory
+func NewKeySigner(pk *ecdsa.PrivateKey) Signer {
+	return &keySigner{pk: pk, addr: crypto.PubkeyToAddress(pk.PublicKey)}
+}
+
+// keySigner signs with the private key in memory, the default one
+type keySigner struct {
+	pk   *ecdsa.PrivateKey
+	addr common.Address
+}
+
+func (s *keySigner) Address() common.Address {
+	return s.addr
+}
+
+func (s *keySigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.pk)
+}
+
+func (s *keySigner) SignTypedData(_ context.Context, data apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "apitypes.TypedDataAndHash")
+	}
+	sig, err := crypto.Sign(hash, s.pk)
+	if err != nil {
+		return nil, errors.Wrap(err, "crypto.Sign")
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+// externalSigner signs with the clef compatible signer listening on the ipc socket or http endpoint, the key never
+// gets into the service
+type externalSigner struct {
+	api     *external.ExternalSigner
+	account accounts.Account
+}
+
+func (s *externalSigner) Address() common.Address {
+	return s.account.Address
+}
+
+func (s *externalSigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signed, err := s.api.SignTx(s.account, tx, chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "account_signTransaction")
+	}
+	return signed, nil
+}
+
+func (s *externalSigner) SignTypedData(_ context.Context, data apitypes.TypedData) ([]byte, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "json.Marshal")
+	}
+	sig, err := s.api.SignData(s.account, apitypes.DataTyped.Mime, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "account_signData")
+	}
+	if len(sig) != 65 {
+		return nil, errors.New("account_signData: invalid signature length")
+	}
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	return sig, nil
+}
+
+var (
+	externalSignerMu  sync.Mutex
+	externalSignerAPI *external.ExternalSigner
+)
+
+// externalSignerApi the signer set by EXTERNAL_SIGNER, dialed once it is needed
+func externalSignerApi() (*external.ExternalSigner, error) {
+	externalSignerMu.Lock()
+	defer externalSignerMu.Unlock()
+
+	if externalSignerAPI != nil {
+		return externalSignerAPI, nil
+	}
+	if config.CFG == nil || config.CFG.ExternalSigner == "" {
+		return nil, ErrExternalSignerNotSet
+	}
+	api, err := external.NewExternalSigner(config.CFG.ExternalSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "external.NewExternalSigner")
+	}
+	externalSignerAPI = api
+	return api, nil
+}
+
+// externalKeyPrefix marks the wallet key of the profile flagged external_signer, the address follows it
+const externalKeyPrefix = "external:"
+
+// ExternalKey wallet key of the profile whose private key the external signer holds
+func ExternalKey(addr common.Address) string {
+	return externalKeyPrefix + addr.String()
+}
+
+// IsExternalKey the wallet key is the one ExternalKey made
+func IsExternalKey(key string) bool {
+	return strings.HasPrefix(key, externalKeyPrefix)
+}
+
+// ExternalKeyAddr address of the wallet the external key is made for
+func ExternalKeyAddr(key string) (common.Address, error) {
+	addr := strings.TrimPrefix(key, externalKeyPrefix)
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, errors.New("invalid external signer wallet address: " + addr)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+// NewExternalSigner signer of the wallet the external signer holds the key of
+func NewExternalSigner(addr common.Address) (Signer, error) {
+	api, err := externalSignerApi()
+	if err != nil {
+		return nil, err
+	}
+	return &externalSigner{api: api, account: accounts.Account{Address: addr}}, nil
+}
+
+// TransactOpts opts of the contract bindings signed by the signer of the wallet
+func (w *WalletTransactor) TransactOpts(ctx context.Context, chainID *big.Int) (*bind.TransactOpts, error) {
+	if w.Signer == nil {
+		return nil, errors.New("wallet has no signer")
+	}
+	_, external := w.Signer.(*externalSigner)
+	opt := &bind.TransactOpts{
+		From:    w.WalletAddr,
+		Context: ctx,
+	}
+	opt.Signer = func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if addr != w.WalletAddr {
+			return nil, bind.ErrNotAuthorized
+		}
+		// the estimated tx is never sent, the external signer is not asked to confirm it
+		if opt.NoSend && external {
+			return tx, nil
+		}
+		return w.Signer.SignTx(ctx, tx, chainID)
+	}
+	return opt, nil
+}
@@ -0,0 +1,190 @@
+package defi
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/hardstylez72/cry/internal/defi/nonce"
+	"github.com/pkg/errors"
+)
+
+var panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+// panicReasons solidity panic codes
+var panicReasons = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division by zero",
+	0x21: "invalid enum value",
+	0x31: "pop of the empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call of the zero function",
+}
+
+var (
+	customErrorsOnce sync.Once
+	customErrors     map[[4]byte]abi.Error
+)
+
+func loadCustomErrors() {
+	customErrors = map[[4]byte]abi.Error{}
+	for _, m := range revertABIs {
+		a, err := m.GetAbi()
+		if err != nil {
+			continue
+		}
+		for _, e := range a.Errors {
+			var id [4]byte
+			copy(id[:], e.ID[:4])
+			customErrors[id] = e
+		}
+	}
+}
+
+// Simulate runs the tx with eth_call on the pending block, the revert is returned as ErrTxReverted with the reason
+func (c *EtheriumClient) Simulate(ctx context.Context, msg ethereum.CallMsg) error {
+	if _, err := c.Cli.PendingCallContract(ctx, msg); err != nil {
+		return RevertError(err)
+	}
+	return nil
+}
+
+// prepareSend holds the next nonce of the wallet for the opts and simulates the tx before it is signed, so the
+// reverting tx is never sent. Nil reservation when the tx is only estimated
+func (c *EtheriumClient) prepareSend(ctx context.Context, opt *bind.TransactOpts) (*nonce.Reservation, error) {
+	if opt.NoSend {
+		return nil, nil
+	}
+	n, err := nonce.Default.Reserve(ctx, c.Cfg.Network, opt.From, c.Cli)
+	if err != nil {
+		return nil, err
+	}
+	opt.Nonce = new(big.Int).SetUint64(n.Nonce)
+
+	signer := opt.Signer
+	opt.Signer = func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if err := c.Simulate(ctx, CallMsg(from, tx)); err != nil {
+			return nil, err
+		}
+		return signer(from, tx)
+	}
+	return n, nil
+}
+
+// CallMsg eth_call of the tx, the fee is left out so only the revert of the call fails it
+func CallMsg(from common.Address, tx *types.Transaction) ethereum.CallMsg {
+	return ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+}
+
+// RevertError ErrTxReverted with the decoded reason if the node reverted the call, the error as it is otherwise
+func RevertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	reason, ok := RevertReason(err)
+	if !ok {
+		return errors.Wrap(err, "eth_call")
+	}
+	return errors.Wrap(ErrTxReverted, reason)
+}
+
+// EstimateError ErrTxReverted with the decoded reason if the gas estimate of the call reverted, the error as it is
+// otherwise
+func EstimateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	reason, ok := RevertReason(err)
+	if !ok {
+		return err
+	}
+	return errors.Wrap(ErrTxReverted, reason)
+}
+
+// revertBackend backend of the bound contracts, the gas estimate of the tx the contract reverts fails with
+// ErrTxReverted and the reason of it
+type revertBackend struct {
+	bind.ContractBackend
+}
+
+func (b revertBackend) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	gas, err := b.ContractBackend.EstimateGas(ctx, msg)
+	return gas, EstimateError(err)
+}
+
+// backend the bound transactors of the client are made with
+func (c *EtheriumClient) backend() bind.ContractBackend {
+	return revertBackend{ContractBackend: c.Cli}
+}
+
+// RevertReason readable reason of the reverted call: the revert string, the panic code or the custom error of the
+// bound contracts with its arguments
+func RevertReason(err error) (string, bool) {
+	var data []byte
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		data = revertData(dataErr.ErrorData())
+	}
+	if len(data) < 4 {
+		if strings.Contains(err.Error(), "revert") {
+			return err.Error(), true
+		}
+		return "", false
+	}
+
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return "execution reverted: " + reason, true
+	}
+
+	if string(data[:4]) == string(panicSelector) && len(data) >= 36 {
+		code := new(big.Int).SetBytes(data[4:36])
+		reason, ok := panicReasons[code.Uint64()]
+		if !ok {
+			reason = "code " + hexutil.EncodeBig(code)
+		}
+		return "panic: " + reason, true
+	}
+
+	customErrorsOnce.Do(loadCustomErrors)
+	var id [4]byte
+	copy(id[:], data[:4])
+	if e, ok := customErrors[id]; ok {
+		args, err := e.Inputs.Unpack(data[4:])
+		if err != nil || len(args) == 0 {
+			return "execution reverted: " + e.Name, true
+		}
+		return fmt.Sprintf("execution reverted: %s%v", e.Name, args), true
+	}
+
+	return "execution reverted: 0x" + hex.EncodeToString(data), true
+}
+
+func revertData(v interface{}) []byte {
+	s, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	data, err := hexutil.Decode(s)
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -70,4 +70,11 @@ var SlippageMap = map[v1.TaskType]SlippagePercent{
 	v1.TaskType_JediSwap:       SlippagePercent05,
 	v1.TaskType_MySwap:         SlippagePercent2,
 	v1.TaskType_ProtossSwap:    SlippagePercent2,
+	v1.TaskType_TraderJoeSwap:  SlippagePercent05,
+	v1.TaskType_MuteioLP:       SlippagePercent1,
+	v1.TaskType_SpaceFiLP:      SlippagePercent1,
+	v1.TaskType_VelocoreLP:     SlippagePercent1,
+	v1.TaskType_IzumiLP:        SlippagePercent2,
+	v1.TaskType_MaverickLP:     SlippagePercent2,
+	v1.TaskType_HopBridge:      SlippagePercent05,
 }
@@ -0,0 +1,298 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/bridge/layerzero"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/factory"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/lpstaking"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/pool"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/router"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/routereth"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// stargateRedeemLocalCallBack Bridge function type quoted for redeemLocal
+const stargateRedeemLocalCallBack uint8 = 3
+
+type stargatePool struct {
+	id      int64
+	address common.Address
+	caller  *pool.StorageCaller
+}
+
+func (c *EtheriumClient) stargatePool(ctx context.Context, token v1.Token) (*stargatePool, error) {
+	id, ok := PoolIdMap[c.Cfg.Network][token]
+	if !ok {
+		return nil, errors.New("stargate has no " + token.String() + " pool in " + c.Cfg.Network.String())
+	}
+
+	opt := &bind.CallOpts{Context: ctx}
+	r, err := router.NewRouterCaller(c.Cfg.Dict.Stargate.StargateRouterAddress, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "router.NewRouterCaller")
+	}
+	factoryAddr, err := r.Factory(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "router.Factory")
+	}
+	f, err := factory.NewStorageCaller(factoryAddr, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "factory.NewStorageCaller")
+	}
+	addr, err := f.GetPool(opt, big.NewInt(id))
+	if err != nil {
+		return nil, errors.Wrap(err, "factory.GetPool")
+	}
+	if addr == bozdo.ZEROADDR {
+		return nil, errors.New("stargate pool is not deployed: " + token.String())
+	}
+	caller, err := pool.NewStorageCaller(addr, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "pool.NewStorageCaller")
+	}
+
+	return &stargatePool{id: id, address: addr, caller: caller}, nil
+}
+
+// stargateFarm LPStaking pool id of the lp token, it differs from the stargate pool id
+func (c *EtheriumClient) stargateFarm(ctx context.Context, lp common.Address) (*lpstaking.StorageCaller, *big.Int, error) {
+	staking := c.Cfg.Dict.Stargate.LPStakingAddress
+	if staking == bozdo.ZEROADDR {
+		return nil, nil, errors.New("stargate lp staking is not supported in " + c.Cfg.Network.String())
+	}
+	caller, err := lpstaking.NewStorageCaller(staking, c.Cli)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "lpstaking.NewStorageCaller")
+	}
+
+	opt := &bind.CallOpts{Context: ctx}
+	length, err := caller.PoolLength(opt)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "lpstaking.PoolLength")
+	}
+	for pid := int64(0); pid < length.Int64(); pid++ {
+		info, err := caller.PoolInfo(opt, big.NewInt(pid))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "lpstaking.PoolInfo")
+		}
+		if info.LpToken == lp {
+			return caller, big.NewInt(pid), nil
+		}
+	}
+	return nil, nil, errors.New("lp is not farmed in stargate lp staking: " + lp.String())
+}
+
+// stargatePending stg reward of the farm, LPStakingTime of the newer deployments names it pendingEmissionToken
+func stargatePending(ctx context.Context, caller *lpstaking.StorageCaller, pid *big.Int, wallet common.Address) (*big.Int, error) {
+	opt := &bind.CallOpts{Context: ctx}
+	pending, err := caller.PendingStargate(opt, pid, wallet)
+	if err == nil {
+		return pending, nil
+	}
+	pending, err = caller.PendingEmissionToken(opt, pid, wallet)
+	if err != nil {
+		return nil, errors.Wrap(err, "lpstaking.PendingEmissionToken")
+	}
+	return pending, nil
+}
+
+// StargateLiquidityAvailable amount the action can be done with: token balance to add, lp balance to stake or redeem,
+// staked lp to unstake and pending reward to claim
+func (c *EtheriumClient) StargateLiquidityAvailable(ctx context.Context, req *StargateLiquidityAvailableReq) (*big.Int, error) {
+
+	if req.Action == v1.StargateLiquidityAction_AddLiquidity {
+		balance, err := c.GetBalance(ctx, &GetBalanceReq{
+			WalletAddress: req.WalletAddress.String(),
+			Token:         req.Token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return balance.WEI, nil
+	}
+
+	p, err := c.stargatePool(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case v1.StargateLiquidityAction_StakeLP, v1.StargateLiquidityAction_InstantRedeem, v1.StargateLiquidityAction_DelayedRedeem:
+		balance, err := p.caller.BalanceOf(&bind.CallOpts{Context: ctx}, req.WalletAddress)
+		if err != nil {
+			return nil, errors.Wrap(err, "pool.BalanceOf")
+		}
+		return balance, nil
+	case v1.StargateLiquidityAction_UnstakeLP:
+		farm, pid, err := c.stargateFarm(ctx, p.address)
+		if err != nil {
+			return nil, err
+		}
+		info, err := farm.UserInfo(&bind.CallOpts{Context: ctx}, pid, req.WalletAddress)
+		if err != nil {
+			return nil, errors.Wrap(err, "lpstaking.UserInfo")
+		}
+		return info.Amount, nil
+	case v1.StargateLiquidityAction_ClaimStg:
+		farm, pid, err := c.stargateFarm(ctx, p.address)
+		if err != nil {
+			return nil, err
+		}
+		return stargatePending(ctx, farm, pid, req.WalletAddress)
+	default:
+		return nil, errors.New("unsupported stargate liquidity action: " + req.Action.String())
+	}
+}
+
+func (c *EtheriumClient) StargateLiquidity(ctx context.Context, req *StargateLiquidityReq) (*bozdo.DefaultRes, error) {
+
+	result := &bozdo.DefaultRes{}
+
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := c.stargatePool(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &contractCall{To: c.Cfg.Dict.Stargate.StargateRouterAddress, Value: big.NewInt(0)}
+	var details []bozdo.TxDetail
+	var extraFee *big.Int
+
+	routerAbi, err := router.RouterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	stakingAbi, err := lpstaking.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case v1.StargateLiquidityAction_AddLiquidity:
+		if req.Token == c.Cfg.MainToken {
+			ethAbi, err := routereth.StorageMetaData.GetAbi()
+			if err != nil {
+				return nil, err
+			}
+			call.To = c.Cfg.Dict.Stargate.StargateRouterEthAddress
+			call.Value = req.Amount
+			call.Data, err = ethAbi.Pack("addLiquidityETH")
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		limitTx, err := c.TokenLimitChecker(ctx, &TokenLimitCheckerReq{
+			Token:       req.Token,
+			Wallet:      tr,
+			Amount:      req.Amount,
+			SpenderAddr: c.Cfg.Dict.Stargate.StargateRouterAddress,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "TokenLimitChecker")
+		}
+		if limitTx.LimitExtended {
+			result.ApproveTx = c.NewTx(limitTx.ApproveTx.Hash(), CodeApprove, nil)
+		}
+		call.Data, err = routerAbi.Pack("addLiquidity", big.NewInt(p.id), req.Amount, tr.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+	case v1.StargateLiquidityAction_StakeLP, v1.StargateLiquidityAction_UnstakeLP, v1.StargateLiquidityAction_ClaimStg:
+		_, pid, err := c.stargateFarm(ctx, p.address)
+		if err != nil {
+			return nil, err
+		}
+		call.To = c.Cfg.Dict.Stargate.LPStakingAddress
+
+		switch req.Action {
+		case v1.StargateLiquidityAction_StakeLP:
+			approveTx, err := c.approveTokenAddress(ctx, tr, p.address, call.To, req.Amount)
+			if err != nil {
+				return nil, err
+			}
+			if approveTx != nil {
+				if err := c.WaitTxComplete(ctx, approveTx.Hash()); err != nil {
+					return nil, err
+				}
+				result.ApproveTx = c.NewTx(approveTx.Hash(), CodeApprove, nil)
+			}
+			call.Data, err = stakingAbi.Pack("deposit", pid, req.Amount)
+		case v1.StargateLiquidityAction_UnstakeLP:
+			call.Data, err = stakingAbi.Pack("withdraw", pid, req.Amount)
+		default:
+			// rewards are paid out on any deposit
+			call.Data, err = stakingAbi.Pack("deposit", pid, big.NewInt(0))
+		}
+		if err != nil {
+			return nil, err
+		}
+	case v1.StargateLiquidityAction_InstantRedeem:
+		call.Data, err = routerAbi.Pack("instantRedeemLocal", uint16(p.id), req.Amount, tr.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+	case v1.StargateLiquidityAction_DelayedRedeem:
+		dstChainId, ok := layerzero.LayerZeroChainMap[req.DstNetwork]
+		if !ok || req.DstNetwork == c.Cfg.Network {
+			return nil, errors.New("invalid redeem chain: " + req.DstNetwork.String())
+		}
+		dstPoolId, ok := PoolIdMap[req.DstNetwork][req.Token]
+		if !ok {
+			return nil, errors.New("stargate has no " + req.Token.String() + " pool in " + req.DstNetwork.String())
+		}
+
+		r, err := router.NewRouterCaller(c.Cfg.Dict.Stargate.StargateRouterAddress, c.Cli)
+		if err != nil {
+			return nil, errors.Wrap(err, "router.NewRouterCaller")
+		}
+		lzTx := router.IStargateRouterlzTxObj{
+			DstGasForCall:   big.NewInt(0),
+			DstNativeAmount: big.NewInt(0),
+			DstNativeAddr:   common.HexToAddress("0x0000000000000000000000000000000000000001").Bytes(),
+		}
+		fee, _, err := r.QuoteLayerZeroFee(&bind.CallOpts{Context: ctx}, dstChainId, stargateRedeemLocalCallBack, tr.WalletAddr.Bytes(), []byte{}, lzTx)
+		if err != nil {
+			return nil, errors.Wrap(err, "router.QuoteLayerZeroFee")
+		}
+		fee = bozdo.BigIntSum(fee, bozdo.Percent(fee, layerzero.LayerZeroBoostPercent))
+		details = append(details, bozdo.NewLZFeeDetails(fee, c.Cfg.Network, c.Cfg.MainToken))
+
+		call.Value = fee
+		extraFee = fee
+		call.Data, err = routerAbi.Pack("redeemLocal", dstChainId, big.NewInt(p.id), big.NewInt(dstPoolId), tr.WalletAddr, req.Amount, tr.WalletAddr.Bytes(), lzTx)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unsupported stargate liquidity action: " + req.Action.String())
+	}
+
+	ecost, tx, err := c.sendContractCall(ctx, tr, call, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = "stargate " + req.Action.String()
+	ecost.Details = append(ecost.Details, details...)
+	ecost.ExtraFee = extraFee
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeContract, details)
+
+	return result, nil
+}
@@ -0,0 +1,200 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/stargate/stake"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// veSTG rounds the unlock time down to whole weeks
+const stgLockWeek = int64(7 * 24 * 60 * 60)
+
+type stgLock struct {
+	staker  common.Address
+	amount  *big.Int
+	end     *big.Int
+	maxTime *big.Int
+}
+
+func (c *EtheriumClient) stgLockState(ctx context.Context, wallet common.Address) (*stgLock, error) {
+	staker, ok := c.Cfg.TokenMap[v1.Token_veSTG]
+	if !ok {
+		return nil, errors.New("veSTG is not supported in " + c.Cfg.Network.String())
+	}
+
+	caller, err := stake.NewStakerCaller(staker, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "stake.NewStakerCaller")
+	}
+
+	opt := &bind.CallOpts{Context: ctx}
+	locked, err := caller.Locked(opt, wallet)
+	if err != nil {
+		return nil, errors.Wrap(err, "stake.Locked")
+	}
+	maxTime, err := caller.MAXTIME(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "stake.MAXTIME")
+	}
+
+	return &stgLock{
+		staker:  staker,
+		amount:  locked.Amount,
+		end:     locked.End,
+		maxTime: maxTime,
+	}, nil
+}
+
+// unlockTime timestamp lockDays from now limited by the max lock time of veSTG, the max one if lockDays is 0
+func (l *stgLock) unlockTime(lockDays int64) *big.Int {
+	now := time.Now().Unix()
+	duration := l.maxTime.Int64()
+	if lockDays > 0 && lockDays*24*60*60 < duration {
+		duration = lockDays * 24 * 60 * 60
+	}
+	unlock := (now + duration) / stgLockWeek * stgLockWeek
+	return big.NewInt(unlock)
+}
+
+func (l *stgLock) active() bool {
+	return l.amount.Sign() > 0 && l.end.Int64() > time.Now().Unix()
+}
+
+// StgLock locks stg for veSTG, adds the amount to the active lock if the wallet has one
+func (c *EtheriumClient) StgLock(ctx context.Context, req *StgLockReq) (*bozdo.DefaultRes, error) {
+
+	result := &bozdo.DefaultRes{}
+
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := c.stgLockState(ctx, tr.WalletAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := c.GetBalance(ctx, &GetBalanceReq{
+		WalletAddress: tr.WalletAddr.String(),
+		Token:         v1.Token_STG,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetBalance")
+	}
+	amount, err := ResolveAmount(req.Amount, balance.WEI)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Sign() == 0 {
+		return nil, errors.New("zero stg amount to lock")
+	}
+
+	approveTx, err := c.approveTokenAddress(ctx, tr, c.Cfg.TokenMap[v1.Token_STG], lock.staker, amount)
+	if err != nil {
+		return nil, err
+	}
+	if approveTx != nil {
+		if err := c.WaitTxComplete(ctx, approveTx.Hash()); err != nil {
+			return nil, err
+		}
+		result.ApproveTx = c.NewTx(approveTx.Hash(), CodeApprove, nil)
+	}
+
+	abi, err := stake.StakerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	switch {
+	case lock.active():
+		data, err = abi.Pack("increase_amount", amount)
+	case lock.amount.Sign() > 0:
+		// expired lock is withdrawn to the wallet and locked again
+		data, err = abi.Pack("withdrawAndCreateLock", amount, lock.unlockTime(req.LockDays))
+	default:
+		data, err = abi.Pack("create_lock", amount, lock.unlockTime(req.LockDays))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ecost, tx, err := c.sendContractCall(ctx, tr, &contractCall{
+		To:    lock.staker,
+		Value: big.NewInt(0),
+		Data:  data,
+	}, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = "stg lock"
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeContract, nil)
+
+	return result, nil
+}
+
+// StgExtendLock moves the unlock time of the active veSTG lock
+func (c *EtheriumClient) StgExtendLock(ctx context.Context, req *StgLockReq) (*bozdo.DefaultRes, error) {
+
+	result := &bozdo.DefaultRes{}
+
+	tr, err := newWalletTransactor(req.WalletPK)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := c.stgLockState(ctx, tr.WalletAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !lock.active() {
+		return nil, errors.New("wallet has no active stg lock")
+	}
+
+	unlock := lock.unlockTime(req.LockDays)
+	if unlock.Cmp(lock.end) <= 0 {
+		return nil, errors.New("stg lock already ends at " + time.Unix(lock.end.Int64(), 0).UTC().String())
+	}
+
+	abi, err := stake.StakerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("increase_unlock_time", unlock)
+	if err != nil {
+		return nil, err
+	}
+
+	ecost, tx, err := c.sendContractCall(ctx, tr, &contractCall{
+		To:    lock.staker,
+		Value: big.NewInt(0),
+		Data:  data,
+	}, req.Gas, req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+	ecost.Name = "stg extend lock"
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeContract, nil)
+
+	return result, nil
+}
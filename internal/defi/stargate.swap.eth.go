@@ -64,9 +64,9 @@ func (c *EtheriumClient) StargateBridgeSwapEth(ctx context.Context, req *Stargat
 		return nil, err
 	}
 
-	opt, err := bind.NewKeyedTransactorWithChainID(req.Wallet.PrivateKey, chainID)
+	opt, err := req.Wallet.TransactOpts(ctx, chainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "TransactOpts")
 	}
 	opt.Context = ctx
 
@@ -82,7 +82,7 @@ func (c *EtheriumClient) StargateBridgeSwapEth(ctx context.Context, req *Stargat
 	destChainId := layerzero.LayerZeroChainMap[req.DestChain]
 
 	l1Gasfee := big.NewInt(0)
-	if c.Cfg.Network == v1.Network_OPTIMISM {
+	if opStackNetwork(c.Cfg.Network) {
 		amSlip, err := Slippage(req.Quantity, req.Slippage)
 		if err != nil {
 			return nil, err
@@ -128,10 +128,15 @@ func (c *EtheriumClient) StargateBridgeSwapEth(ctx context.Context, req *Stargat
 	if err != nil {
 		return nil, err
 	}
-	tr, err := routereth.NewStorageTransactor(c.Cfg.Dict.Stargate.StargateRouterEthAddress, c.Cli)
+	tr, err := routereth.NewStorageTransactor(c.Cfg.Dict.Stargate.StargateRouterEthAddress, c.backend())
 	if err != nil {
 		return nil, err
 	}
+	reserved, err := c.prepareSend(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := tr.SwapETH(
 		opt,
 		destChainId,
@@ -140,13 +145,14 @@ func (c *EtheriumClient) StargateBridgeSwapEth(ctx context.Context, req *Stargat
 		req.Quantity,
 		amSlip,
 	)
+	reserved.Done(err)
 	if err != nil {
 		return nil, errors.Wrap(err, "tr.SwapETH")
 	}
 
 	return &StargateBridgeSwapEthRes{
 		Tx:    tx,
-		ECost: Estimate(tx, bozdo.BigIntSum(l1Gasfee, fee.Fee1), "bridge", details),
+		ECost: c.Estimate(ctx, tx, bozdo.BigIntSum(l1Gasfee, fee.Fee1), "bridge", details),
 	}, nil
 }
 
@@ -11,57 +11,69 @@ import (
 )
 
 var (
+	// https://stargateprotocol.gitbook.io/stargate/developers/pool-ids
 	PoolIdMap = map[v1.Network]map[Token]int64{
 		v1.Network_ARBITRUM: {
 			v1.Token_USDC: 1,
 			v1.Token_USDT: 2,
-			//"FRAX":    7,
-			v1.Token_ETH: 13,
-			//"LUSD":    15,
-			//"MAI":     16,
+			v1.Token_FRAX: 7,
+			v1.Token_ETH:  13,
+			v1.Token_LUSD: 15,
+			v1.Token_MAI:  16,
 		},
 		v1.Network_OPTIMISM: {
 			v1.Token_USDC: 1,
-			//"DAI":     3,
-			//"FRAX":    7,
-			v1.Token_ETH: 13,
-			//"sUSD":    14,
-			//"LUSD":    15,
-			//"MAI":     16,
+			v1.Token_DAI:  3,
+			v1.Token_FRAX: 7,
+			v1.Token_ETH:  13,
+			v1.Token_sUSD: 14,
+			v1.Token_LUSD: 15,
+			v1.Token_MAI:  16,
 		},
 		v1.Network_BinanaceBNB: {
-			v1.Token_USDT: 2,
-			//"BUSD":    5,
-			//"USDD":    11,
-			//"MAI":     16,
-			//"METIS":   17,
+			v1.Token_USDT:  2,
+			v1.Token_BUSD:  5,
+			v1.Token_USDD:  11,
+			v1.Token_MAI:   16,
+			v1.Token_METIS: 17,
 		},
 		v1.Network_Etherium: {
-			v1.Token_USDC: 1,
-			v1.Token_USDT: 2,
-			v1.Token_ETH:  13,
-			//DAI: 3
-			//FRAX: 7
-			//USDD: 11
-			//sUSD: 14
-			//LUSD: 15
-			//MAI: 16
-			//METIS: 17
+			v1.Token_USDC:  1,
+			v1.Token_USDT:  2,
+			v1.Token_DAI:   3,
+			v1.Token_FRAX:  7,
+			v1.Token_USDD:  11,
+			v1.Token_ETH:   13,
+			v1.Token_sUSD:  14,
+			v1.Token_LUSD:  15,
+			v1.Token_MAI:   16,
+			v1.Token_METIS: 17,
 			//metis.USDT: 19
 		},
 		v1.Network_POLIGON: {
 			v1.Token_USDC: 1,
 			v1.Token_USDT: 2,
-			//DAI: 3
-			//MAI: 16
+			v1.Token_DAI:  3,
+			v1.Token_MAI:  16,
 		},
 		v1.Network_AVALANCHE: {
 			v1.Token_USDC: 1,
 			v1.Token_USDT: 2,
-			//FRAX: 7
-			//MAI: 16
+			v1.Token_FRAX: 7,
+			v1.Token_MAI:  16,
 			//metis.USDT: 19
 		},
+		v1.Network_Base: {
+			v1.Token_USDC: 1,
+			v1.Token_ETH:  13,
+		},
+		v1.Network_Linea: {
+			v1.Token_ETH: 13,
+		},
+		v1.Network_Metis: {
+			v1.Token_METIS: 17,
+			v1.Token_USDT:  19,
+		},
 	}
 )
 
@@ -119,6 +131,11 @@ func (r *StargateBridgeSwapReq) Validate(currentChain v1.Network) error {
 	return nil
 }
 
+// opStackNetwork networks charging l1 data fee through the GasPriceOracle predeploy
+func opStackNetwork(network v1.Network) bool {
+	return network == v1.Network_OPTIMISM || network == v1.Network_Base
+}
+
 // https://stargateprotocol.gitbook.io/stargate/developers/how-to-swap
 func (c *EtheriumClient) StargateBridgeSwap(ctx context.Context, req *DefaultBridgeReq) (*bozdo.DefaultRes, error) {
 
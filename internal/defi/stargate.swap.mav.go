@@ -4,7 +4,6 @@ import (
 	"context"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
 	"github.com/hardstylez72/cry/internal/defi/bridge/layerzero"
@@ -13,19 +12,21 @@ import (
 	"github.com/pkg/errors"
 )
 
+// stargateMavRouter https://bscscan.com/address/0x86355F02119bdBC28ED6A4D5E0cA327Ca7730fFF
+var stargateMavRouter = common.HexToAddress("0x86355F02119bdBC28ED6A4D5E0cA327Ca7730fFF")
+
 func (c *EtheriumClient) StargateBridgeSwapMAV(ctx context.Context, req *DefaultBridgeReq) (*bozdo.DefaultRes, error) {
 	details := []bozdo.TxDetail{}
 	result := &bozdo.DefaultRes{}
 
-	// https://bscscan.com/address/0x86355F02119bdBC28ED6A4D5E0cA327Ca7730fFF
-	constractAddr := common.HexToAddress("0x86355F02119bdBC28ED6A4D5E0cA327Ca7730fFF")
+	constractAddr := stargateMavRouter
 
 	mavAddr, ok := c.Cfg.TokenMap[v1.Token_MAV]
 	if !ok {
 		return nil, ErrTokenNotSupportedFn(req.FromToken)
 	}
 
-	router, err := startgatemavrouter.NewStorageTransactor(constractAddr, c.Cli)
+	router, err := startgatemavrouter.NewStorageTransactor(constractAddr, c.backend())
 	if err != nil {
 		return nil, err
 	}
@@ -53,9 +54,9 @@ func (c *EtheriumClient) StargateBridgeSwapMAV(ctx context.Context, req *Default
 		return nil, err
 	}
 
-	opt, err := bind.NewKeyedTransactorWithChainID(wt.PrivateKey, chainID)
+	opt, err := wt.TransactOpts(ctx, chainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "TransactOpts")
 	}
 	opt.Context = ctx
 
@@ -86,6 +87,11 @@ func (c *EtheriumClient) StargateBridgeSwapMAV(ctx context.Context, req *Default
 		return nil, err
 	}
 
+	reserved, err := c.prepareSend(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := router.SendOFT(
 		opt,
 		mavAddr,
@@ -102,11 +108,12 @@ func (c *EtheriumClient) StargateBridgeSwapMAV(ctx context.Context, req *Default
 			PartnerId: [2]byte{0, 0},
 		},
 	)
+	reserved.Done(err)
 	if err != nil {
 		return nil, errors.Wrap(err, "router.SendOFT")
 	}
 
-	result.ECost = Estimate(tx, nil, "bridge", details)
+	result.ECost = c.Estimate(ctx, tx, nil, "bridge", details)
 	result.Tx = c.NewTx(tx.Hash(), CodeContract, details)
 
 	return result, nil
@@ -54,7 +54,7 @@ func (c *EtheriumClient) StargateBridgeSwapSTG(ctx context.Context, req *Stargat
 		return nil, err
 	}
 
-	tr, err := stg.NewStgTransactor(c.Cfg.TokenMap[v1.Token_STG], c.Cli)
+	tr, err := stg.NewStgTransactor(c.Cfg.TokenMap[v1.Token_STG], c.backend())
 	if err != nil {
 		return nil, err
 	}
@@ -64,9 +64,9 @@ func (c *EtheriumClient) StargateBridgeSwapSTG(ctx context.Context, req *Stargat
 		return nil, err
 	}
 
-	opt, err := bind.NewKeyedTransactorWithChainID(req.Wallet.PrivateKey, chainID)
+	opt, err := req.Wallet.TransactOpts(ctx, chainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "TransactOpts")
 	}
 	opt.Context = ctx
 
@@ -83,7 +83,7 @@ func (c *EtheriumClient) StargateBridgeSwapSTG(ctx context.Context, req *Stargat
 	destChainId := layerzero.LayerZeroChainMap[req.DestChain]
 
 	l1Gasfee := big.NewInt(0)
-	if c.Cfg.Network == v1.Network_OPTIMISM {
+	if opStackNetwork(c.Cfg.Network) {
 		optFeeCaller, err := optimism_fee.NewStorageCaller(common.HexToAddress("0x420000000000000000000000000000000000000F"), c.Cli)
 		if err != nil {
 			return nil, err
@@ -118,6 +118,11 @@ func (c *EtheriumClient) StargateBridgeSwapSTG(ctx context.Context, req *Stargat
 
 	opt = c.ResoleGas(ctx, req.Gas, opt)
 
+	reserved, err := c.prepareSend(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := tr.SendTokens(
 		opt,
 		destChainId,
@@ -126,30 +131,41 @@ func (c *EtheriumClient) StargateBridgeSwapSTG(ctx context.Context, req *Stargat
 		common.HexToAddress("0x0000000000000000000000000000000000000000"),
 		[]byte{},
 	)
+	reserved.Done(err)
 	if err != nil {
 		return nil, errors.Wrap(err, "tr.SendTokens")
 	}
 
 	return &StargateBridgeSwapSTGRes{
 		Tx:    tx,
-		ECost: Estimate(tx, nil, "bridge", details),
+		ECost: c.Estimate(ctx, tx, nil, "bridge", details),
 	}, nil
 }
 
-func Estimate(tx *types.Transaction, extraFee *big.Int, name string, details []bozdo.TxDetail) *bozdo.EstimatedGasCost {
+// Estimate gas cost of the built tx, the eip-1559 one reports the base fee of the latest block and the tip
+func (c *EtheriumClient) Estimate(ctx context.Context, tx *types.Transaction, extraFee *big.Int, name string, details []bozdo.TxDetail) *bozdo.EstimatedGasCost {
 	gasLimit := new(big.Int).SetUint64(tx.Gas())
 	gasLimit = bozdo.BigIntSum(gasLimit, bozdo.Percent(gasLimit, 30))
 
 	if tx.Type() == types.DynamicFeeTxType {
 		fee := bozdo.BigIntSum(tx.GasFeeCap())
 
+		var baseFee *big.Int
+		head, err := c.Cli.HeaderByNumber(ctx, nil)
+		if err == nil {
+			baseFee = head.BaseFee
+		}
+
 		return &bozdo.EstimatedGasCost{
-			Name:        "bridge",
+			Type:        bozdo.TxTypeDynamic,
+			Name:        name,
 			GasLimit:    gasLimit,
 			GasPrice:    fee,
-			TotalGasWei: new(big.Int).Mul(bozdo.BigIntSum(fee), gasLimit),
+			TotalGasWei: new(big.Int).Mul(fee, gasLimit),
 			Details:     details,
 			ExtraFee:    extraFee,
+			BaseFee:     baseFee,
+			PriorityFee: tx.GasTipCap(),
 		}
 	}
 
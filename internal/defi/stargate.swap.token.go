@@ -34,7 +34,7 @@ func (c *EtheriumClient) StargateBridgeSwapToken(ctx context.Context, req *Starg
 	if err != nil {
 		return nil, err
 	}
-	tr, err := router.NewRouterTransactor(contractAddr, c.Cli)
+	tr, err := router.NewRouterTransactor(contractAddr, c.backend())
 	if err != nil {
 		return nil, err
 	}
@@ -44,9 +44,9 @@ func (c *EtheriumClient) StargateBridgeSwapToken(ctx context.Context, req *Starg
 		return nil, err
 	}
 
-	opt, err := bind.NewKeyedTransactorWithChainID(wt.PrivateKey, chainID)
+	opt, err := wt.TransactOpts(ctx, chainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "TransactOpts")
 	}
 	opt.Context = ctx
 
@@ -73,7 +73,7 @@ func (c *EtheriumClient) StargateBridgeSwapToken(ctx context.Context, req *Starg
 	opt.NoSend = req.EstimateOnly
 
 	l1Gasfee := big.NewInt(0)
-	if c.Cfg.Network == v1.Network_OPTIMISM {
+	if opStackNetwork(c.Cfg.Network) {
 		optFeeCaller, err := optimism_fee.NewStorageCaller(common.HexToAddress("0x420000000000000000000000000000000000000F"), c.Cli)
 		if err != nil {
 			return nil, err
@@ -116,6 +116,11 @@ func (c *EtheriumClient) StargateBridgeSwapToken(ctx context.Context, req *Starg
 	opt = c.ResoleGas(ctx, req.Gas, opt)
 
 	// 38.677058
+	reserved, err := c.prepareSend(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := tr.Swap(
 		opt,
 		destChainId,
@@ -132,12 +137,13 @@ func (c *EtheriumClient) StargateBridgeSwapToken(ctx context.Context, req *Starg
 		wt.WalletAddr.Bytes(),
 		[]byte{},
 	)
+	reserved.Done(err)
 	if err != nil {
 		return nil, errors.Wrap(err, "tr.Swap")
 	}
 
 	return &StargateBridgeSwapToken{
 		Tx:    tx,
-		ECost: Estimate(tx, nil, "bridge", details),
+		ECost: c.Estimate(ctx, tx, nil, "bridge", details),
 	}, nil
 }
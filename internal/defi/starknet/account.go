@@ -0,0 +1,372 @@
+package starknet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	starknetgo "github.com/hardstylez72/cry/starknet.go"
+	"github.com/hardstylez72/cry/starknet.go/felt"
+	"github.com/hardstylez72/cry/starknet.go/rpc"
+	"github.com/hardstylez72/cry/starknet.go/types"
+	"github.com/pkg/errors"
+)
+
+// cairo 0 account contracts as deployed by ArgentX and Braavos wallets
+const (
+	argentProxyClassHash    = "0x25ec026985a3bf9d0cc1fe17326b245dfdc3ff89b8fde106542a3ea56c5a918"
+	argentImplClassHash     = "0x33434ad846cdd5f23eb73ff09fe6fddd568284a0fb7d1be20ee482f044dabe2"
+	braavosProxyClassHash   = "0x03131fa018d520a037686ce3efddeab8f28895662f019ca3ca18a626650f7d1e"
+	braavosInitialClassHash = "0x5aa23d5bb71ddaa783da7ea79d405315bafa7cf0387a74f4593578c3e9e6570"
+	braavosImplClassHash    = "0x2c2b8f559e1221468140ad7b2352b1a5be32660d0bf1a3ae3a054a4ec5254e4"
+)
+
+var (
+	addressBound = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 251), big.NewInt(256))
+	uint128Mask  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	txVersion    = big.NewInt(1)
+)
+
+type Call struct {
+	Contract string
+	Selector string
+	Calldata []*big.Int
+}
+
+type account struct {
+	subType             v1.ProfileSubType
+	pk                  *big.Int
+	pub                 *big.Int
+	address             *big.Int
+	classHash           *big.Int
+	constructorCalldata []*big.Int
+}
+
+func newAccount(pk string, subType v1.ProfileSubType) (*account, error) {
+	key := types.HexToBN(pk)
+	if key == nil || key.Sign() == 0 {
+		return nil, errors.New("invalid starknet private key")
+	}
+
+	pub, _, err := starknetgo.Curve.PrivateToPoint(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "Curve.PrivateToPoint")
+	}
+
+	a := &account{
+		subType: subType,
+		pk:      key,
+		pub:     pub,
+	}
+
+	switch subType {
+	case v1.ProfileSubType_UrgentX:
+		a.classHash = types.HexToBN(argentProxyClassHash)
+		a.constructorCalldata = []*big.Int{
+			types.HexToBN(argentImplClassHash),
+			types.GetSelectorFromName("initialize"),
+			big.NewInt(2),
+			pub,
+			big.NewInt(0), // guardian
+		}
+	case v1.ProfileSubType_Braavos:
+		a.classHash = types.HexToBN(braavosProxyClassHash)
+		a.constructorCalldata = []*big.Int{
+			types.HexToBN(braavosInitialClassHash),
+			types.GetSelectorFromName("initializer"),
+			big.NewInt(1),
+			pub,
+		}
+	default:
+		return nil, errors.New("unsupported starknet account type: " + subType.String())
+	}
+
+	calldataHash, err := starknetgo.Curve.ComputeHashOnElements(a.constructorCalldata)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := starknetgo.Curve.ComputeHashOnElements([]*big.Int{
+		types.UTF8StrToBig(starknetgo.CONTRACT_ADDRESS_PREFIX),
+		big.NewInt(0), // deployer
+		a.salt(),
+		a.classHash,
+		calldataHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.address = addr.Mod(addr, addressBound)
+
+	return a, nil
+}
+
+func (a *account) salt() *big.Int {
+	return a.pub
+}
+
+func (a *account) Address() string {
+	return fmt.Sprintf("0x%064x", a.address)
+}
+
+// sign returns account specific signature of tx hash
+func (a *account) sign(hash *big.Int, deploy bool) ([]*big.Int, error) {
+	if deploy && a.subType == v1.ProfileSubType_Braavos {
+		// braavos validates deploy_account against hash extended with actual implementation and hw signer
+		extra := []*big.Int{types.HexToBN(braavosImplClassHash), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0)}
+		h, err := starknetgo.Curve.ComputeHashOnElements(append([]*big.Int{hash}, extra...))
+		if err != nil {
+			return nil, err
+		}
+		r, s, err := starknetgo.Curve.Sign(h, a.pk)
+		if err != nil {
+			return nil, err
+		}
+		return append([]*big.Int{r, s}, extra...), nil
+	}
+
+	r, s, err := starknetgo.Curve.Sign(hash, a.pk)
+	if err != nil {
+		return nil, err
+	}
+	return []*big.Int{r, s}, nil
+}
+
+func (c *Client) chainId() *big.Int {
+	return types.UTF8StrToBig(c.NetworkId)
+}
+
+func (c *Client) call(ctx context.Context, contract string, selector string, calldata []*big.Int) ([]*big.Int, error) {
+	res, err := c.GWP.Call(ctx, rpc.FunctionCall{
+		ContractAddress:    feltFromHex(contract),
+		EntryPointSelector: types.GetSelectorFromNameFelt(selector),
+		Calldata:           toFelts(calldata),
+	}, rpc.WithBlockTag("latest"))
+	if err != nil {
+		return nil, errors.Wrap(err, "call "+selector)
+	}
+
+	out := make([]*big.Int, len(res))
+	for i := range res {
+		out[i] = types.HexToBN(res[i])
+	}
+	return out, nil
+}
+
+func (c *Client) isDeployed(ctx context.Context, a *account) (bool, error) {
+	_, err := c.GWP.ClassHashAt(ctx, rpc.WithBlockTag("latest"), new(felt.Felt).SetBigInt(a.address))
+	if err != nil {
+		if errors.Is(err, rpc.ErrContractNotFound) || strings.Contains(err.Error(), "Contract not found") {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *Client) nonce(ctx context.Context, a *account) (*big.Int, error) {
+	n, err := c.GWP.Nonce(ctx, rpc.WithBlockTag("latest"), new(felt.Felt).SetBigInt(a.address))
+	if err != nil {
+		return nil, errors.Wrap(err, "Nonce")
+	}
+	v, ok := new(big.Int).SetString(*n, 0)
+	if !ok {
+		return nil, errors.New("invalid nonce: " + *n)
+	}
+	return v, nil
+}
+
+type executeRes struct {
+	MaxFee *big.Int
+	TxHash *string
+}
+
+// execute signs multicall invoke v1 transaction. If maxFee is nil it is estimated
+func (c *Client) execute(ctx context.Context, a *account, calls []Call, maxFee *big.Int, estimateOnly bool) (*executeRes, error) {
+
+	nonce, err := c.nonce(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	calldata := executeCalldata(calls)
+
+	if maxFee == nil {
+		tx, err := c.invokeTx(a, calldata, big.NewInt(0), nonce)
+		if err != nil {
+			return nil, err
+		}
+		fee, err := c.estimateFee(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		maxFee = feeWithReserve(fee)
+	}
+
+	res := &executeRes{MaxFee: maxFee}
+	if estimateOnly {
+		return res, nil
+	}
+
+	tx, err := c.invokeTx(a, calldata, maxFee, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := c.GWP.AddInvokeTransaction(ctx, *tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "AddInvokeTransaction")
+	}
+
+	hash := out.TransactionHash.String()
+	res.TxHash = &hash
+	return res, nil
+}
+
+func (c *Client) invokeTx(a *account, calldata []*big.Int, maxFee, nonce *big.Int) (*rpc.BroadcastedInvokeV1Transaction, error) {
+	calldataHash, err := starknetgo.Curve.ComputeHashOnElements(calldata)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := starknetgo.Curve.ComputeHashOnElements([]*big.Int{
+		types.UTF8StrToBig(starknetgo.TRANSACTION_PREFIX),
+		txVersion,
+		a.address,
+		big.NewInt(0),
+		calldataHash,
+		maxFee,
+		c.chainId(),
+		nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := a.sign(hash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.BroadcastedInvokeV1Transaction{
+		BroadcastedTxnCommonProperties: rpc.BroadcastedTxnCommonProperties{
+			MaxFee:    new(felt.Felt).SetBigInt(maxFee),
+			Version:   rpc.TransactionV1,
+			Signature: toFelts(signature),
+			Nonce:     new(felt.Felt).SetBigInt(nonce),
+			Type:      rpc.TransactionType_Invoke,
+		},
+		SenderAddress: new(felt.Felt).SetBigInt(a.address),
+		Calldata:      toFelts(calldata),
+	}, nil
+}
+
+func (c *Client) deployAccountTx(a *account, maxFee *big.Int) (*rpc.BroadcastedDeployAccountTransaction, error) {
+	calldataHash, err := starknetgo.Curve.ComputeHashOnElements(append([]*big.Int{a.classHash, a.salt()}, a.constructorCalldata...))
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := starknetgo.Curve.ComputeHashOnElements([]*big.Int{
+		types.UTF8StrToBig("deploy_account"),
+		txVersion,
+		a.address,
+		big.NewInt(0),
+		calldataHash,
+		maxFee,
+		c.chainId(),
+		big.NewInt(0),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := a.sign(hash, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.BroadcastedDeployAccountTransaction{
+		BroadcastedTxnCommonProperties: rpc.BroadcastedTxnCommonProperties{
+			MaxFee:    new(felt.Felt).SetBigInt(maxFee),
+			Version:   rpc.TransactionV1,
+			Signature: toFelts(signature),
+			Nonce:     new(felt.Felt).SetUint64(0),
+			Type:      rpc.TransactionType_DeployAccount,
+		},
+		ContractAddressSalt: new(felt.Felt).SetBigInt(a.salt()),
+		ConstructorCalldata: toFelts(a.constructorCalldata),
+		ClassHash:           new(felt.Felt).SetBigInt(a.classHash),
+	}, nil
+}
+
+func (c *Client) estimateFee(ctx context.Context, tx rpc.BroadcastedTransaction) (*big.Int, error) {
+	estimates, err := c.GWP.EstimateFee(ctx, []rpc.BroadcastedTransaction{tx}, rpc.WithBlockTag("latest"))
+	if err != nil {
+		return nil, errors.Wrap(err, "EstimateFee")
+	}
+	if len(estimates) == 0 {
+		return nil, errors.New("empty fee estimation")
+	}
+
+	fee, ok := new(big.Int).SetString(string(estimates[0].OverallFee), 0)
+	if !ok {
+		return nil, errors.New("invalid fee value: " + string(estimates[0].OverallFee))
+	}
+	return fee, nil
+}
+
+// feeWithReserve gives 50% headroom over estimated fee for max_fee
+func feeWithReserve(fee *big.Int) *big.Int {
+	return new(big.Int).Div(new(big.Int).Mul(fee, big.NewInt(3)), big.NewInt(2))
+}
+
+func maxFeeFromGas(gas *bozdo.Gas) *big.Int {
+	if !gas.RuleSet() {
+		return nil
+	}
+	return new(big.Int).Set(&gas.TotalGas)
+}
+
+// executeCalldata encodes calls in cairo 0 account __execute__ format
+func executeCalldata(calls []Call) []*big.Int {
+	out := []*big.Int{big.NewInt(int64(len(calls)))}
+	var data []*big.Int
+	for _, call := range calls {
+		out = append(out,
+			types.HexToBN(call.Contract),
+			types.GetSelectorFromName(call.Selector),
+			big.NewInt(int64(len(data))),
+			big.NewInt(int64(len(call.Calldata))),
+		)
+		data = append(data, call.Calldata...)
+	}
+	out = append(out, big.NewInt(int64(len(data))))
+	return append(out, data...)
+}
+
+func toUint256(v *big.Int) []*big.Int {
+	return []*big.Int{
+		new(big.Int).And(v, uint128Mask),
+		new(big.Int).Rsh(v, 128),
+	}
+}
+
+func fromUint256(low, high *big.Int) *big.Int {
+	return new(big.Int).Add(low, new(big.Int).Lsh(high, 128))
+}
+
+func toFelts(in []*big.Int) []*felt.Felt {
+	out := make([]*felt.Felt, len(in))
+	for i := range in {
+		out[i] = new(felt.Felt).SetBigInt(in[i])
+	}
+	return out
+}
+
+func feltFromHex(s string) *felt.Felt {
+	return new(felt.Felt).SetBigInt(types.HexToBN(s))
+}
@@ -5,7 +5,6 @@ import (
 	"math/big"
 
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
-	"github.com/hardstylez72/cry/internal/defi/starknet/halper"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/pkg/errors"
 )
@@ -18,16 +17,16 @@ type DeployAccountReq struct {
 }
 
 func (c *Client) IsAccountDeployed(ctx context.Context, pk string, sub v1.ProfileSubType) (*bool, error) {
-	res, err := c.halper.IsAccountDeployed(ctx, &halper.IsAccountDeployedReq{
-		ChainRPC:   MainnetRPC,
-		PrivateKey: pk,
-		Proxy:      c.cfg.Proxy,
-		SubType:    sub.String(),
-	})
+	acc, err := newAccount(pk, sub)
 	if err != nil {
 		return nil, err
 	}
-	return &res.Deployed, nil
+
+	deployed, err := c.isDeployed(ctx, acc)
+	if err != nil {
+		return nil, err
+	}
+	return &deployed, nil
 }
 
 type DeployAccountRes = bozdo.DefaultRes
@@ -37,33 +36,47 @@ func (c *Client) DeployAccount(ctx context.Context, req *DeployAccountReq) (*Dep
 
 	res := &DeployAccountRes{}
 
-	tx, err := c.halper.DeployAccount(ctx, &halper.DeployAccountReq{
-		ChainRPC:     MainnetRPC,
-		PrivateKey:   req.PK,
-		Proxy:        c.cfg.Proxy,
-		EstimateOnly: req.EstimateOnly,
-		SubType:      req.SubType.String(),
-	})
+	acc, err := newAccount(req.PK, req.SubType)
 	if err != nil {
-		return nil, errors.Wrap(err, "DeployAccount error happened")
+		return nil, err
 	}
 
-	fee, ok := big.NewInt(0).SetString(tx.EstimatedMaxFee, 10)
-	if !ok {
-		return nil, errors.New("invalid fee value: " + tx.EstimatedMaxFee)
+	maxFee := maxFeeFromGas(req.Gas)
+	if maxFee == nil {
+		tx, err := c.deployAccountTx(acc, big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		fee, err := c.estimateFee(ctx, tx)
+		if err != nil {
+			return nil, errors.Wrap(err, "DeployAccount estimate")
+		}
+		maxFee = feeWithReserve(fee)
 	}
 
 	res.ECost = &bozdo.EstimatedGasCost{
 		Type:        bozdo.TxTypeStarkNet,
 		Name:        "deploy account",
-		TotalGasWei: fee,
+		TotalGasWei: maxFee,
 	}
 
-	if tx.TxHash != nil && tx.ContractAddr != nil {
-		res.Tx = c.NewTx(bozdo.CodeContract, *tx.TxHash, nil)
+	if req.EstimateOnly {
+		return res, nil
 	}
 
-	return res, err
+	tx, err := c.deployAccountTx(acc, maxFee)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := c.GWP.AddDeployAccountTransaction(ctx, *tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "AddDeployAccountTransaction")
+	}
+
+	res.Tx = c.NewTx(bozdo.CodeContract, out.TransactionHash.String(), nil)
+
+	return res, nil
 }
 
 func (c *Client) NewTx(code bozdo.TxCode, id string, details []bozdo.TxDetail) *bozdo.Transaction {
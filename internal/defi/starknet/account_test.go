@@ -0,0 +1,113 @@
+package starknet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	starknetgo "github.com/hardstylez72/cry/starknet.go"
+	"github.com/hardstylez72/cry/starknet.go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccountPK = "0x3c1e9550e66958296d11b60f8e8e7a7ad990d07fa65d5f7652c4a6c87d4e3cc"
+
+func TestNewAccount(t *testing.T) {
+	tests := []struct {
+		name        string
+		subType     v1.ProfileSubType
+		classHash   string
+		impl        string
+		initializer string
+		calldataLen int64
+	}{
+		{
+			name:        "argent",
+			subType:     v1.ProfileSubType_UrgentX,
+			classHash:   argentProxyClassHash,
+			impl:        argentImplClassHash,
+			initializer: "initialize",
+			calldataLen: 2,
+		},
+		{
+			name:        "braavos",
+			subType:     v1.ProfileSubType_Braavos,
+			classHash:   braavosProxyClassHash,
+			impl:        braavosInitialClassHash,
+			initializer: "initializer",
+			calldataLen: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := newAccount(testAccountPK, tt.subType)
+			assert.NoError(t, err)
+
+			pub, _, err := starknetgo.Curve.PrivateToPoint(types.HexToBN(testAccountPK))
+			assert.NoError(t, err)
+
+			assert.Equal(t, 0, a.classHash.Cmp(types.HexToBN(tt.classHash)))
+			assert.Equal(t, 0, a.salt().Cmp(pub))
+			assert.Equal(t, 0, a.constructorCalldata[0].Cmp(types.HexToBN(tt.impl)))
+			assert.Equal(t, 0, a.constructorCalldata[1].Cmp(types.GetSelectorFromName(tt.initializer)))
+			assert.Equal(t, 0, a.constructorCalldata[2].Cmp(big.NewInt(tt.calldataLen)))
+			assert.Equal(t, 0, a.constructorCalldata[3].Cmp(pub))
+			assert.Len(t, a.constructorCalldata, int(3+tt.calldataLen))
+
+			assert.Equal(t, -1, a.address.Cmp(addressBound))
+			assert.Len(t, a.Address(), 66)
+
+			again, err := newAccount(testAccountPK, tt.subType)
+			assert.NoError(t, err)
+			assert.Equal(t, a.Address(), again.Address())
+		})
+	}
+}
+
+func TestNewAccountInvalid(t *testing.T) {
+	_, err := newAccount("0x0", v1.ProfileSubType_UrgentX)
+	assert.Error(t, err)
+
+	_, err = newAccount(testAccountPK, v1.ProfileSubType_Metamask)
+	assert.Error(t, err)
+}
+
+func TestAccountSign(t *testing.T) {
+	for _, subType := range []v1.ProfileSubType{v1.ProfileSubType_UrgentX, v1.ProfileSubType_Braavos} {
+		a, err := newAccount(testAccountPK, subType)
+		assert.NoError(t, err)
+
+		hash := types.GetSelectorFromName("hash")
+		sig, err := a.sign(hash, false)
+		assert.NoError(t, err)
+		assert.Len(t, sig, 2)
+
+		_, y, err := starknetgo.Curve.PrivateToPoint(a.pk)
+		assert.NoError(t, err)
+		assert.True(t, starknetgo.Curve.Verify(hash, sig[0], sig[1], a.pub, y))
+	}
+}
+
+func TestVerifyAddress(t *testing.T) {
+	c := &Client{}
+	ctx := context.Background()
+
+	a, err := newAccount(testAccountPK, v1.ProfileSubType_Braavos)
+	assert.NoError(t, err)
+
+	addr, err := c.VerifyAddress(ctx, testAccountPK, v1.ProfileSubType_Braavos, "")
+	assert.NoError(t, err)
+	assert.Equal(t, a.Address(), addr)
+
+	addr, err = c.VerifyAddress(ctx, testAccountPK, v1.ProfileSubType_Braavos, types.BigToHex(a.address))
+	assert.NoError(t, err)
+	assert.Equal(t, a.Address(), addr)
+
+	argent, err := newAccount(testAccountPK, v1.ProfileSubType_UrgentX)
+	assert.NoError(t, err)
+
+	_, err = c.VerifyAddress(ctx, testAccountPK, v1.ProfileSubType_Braavos, argent.Address())
+	assert.ErrorIs(t, err, ErrAddressMismatch)
+}
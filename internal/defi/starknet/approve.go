@@ -4,8 +4,9 @@ import (
 	"context"
 	"math/big"
 
-	"github.com/hardstylez72/cry/internal/defi/starknet/halper"
+	"github.com/hardstylez72/cry/internal/defi"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/starknet.go/types"
 	"github.com/pkg/errors"
 )
 
@@ -22,26 +23,68 @@ type ApproveRes struct {
 }
 
 func (c *Client) Approve(ctx context.Context, req *ApproveReq) (*ApproveRes, error) {
-	res, err := c.halper.Approve(ctx, &halper.ApproveReq{
-		BaseTx: halper.BaseTx{
-			ChainRPC:   MainnetRPC,
-			PrivateKey: req.PK,
-			Proxy:      c.cfg.Proxy,
-		},
-		Spender: req.SpenderAddr,
-		Amount:  req.Amount.String(),
-		Token:   req.Token.String(),
-		SubType: req.SubType.String(),
-	})
+	acc, err := newAccount(req.PK, req.SubType)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.Error != nil {
-		return nil, errors.New("approve error: " + *res.Error)
+	call, err := c.approveCall(req.Token, req.SpenderAddr, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.execute(ctx, acc, []Call{*call}, nil, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "approve")
 	}
 
 	return &ApproveRes{
-		TxId: res.TxId,
+		TxId: res.TxHash,
 	}, nil
 }
+
+func (c *Client) allowance(ctx context.Context, token v1.Token, owner, spender string) (*big.Int, error) {
+	ta, ok := c.TokenMap[token]
+	if !ok {
+		return nil, defi.ErrTokenNotSupportedFn(token)
+	}
+
+	res, err := c.call(ctx, ta, "allowance", []*big.Int{types.HexToBN(owner), types.HexToBN(spender)})
+	if err != nil {
+		return nil, err
+	}
+	if len(res) < 2 {
+		return nil, errors.New("invalid allowance response")
+	}
+	return fromUint256(res[0], res[1]), nil
+}
+
+func (c *Client) approveCall(token v1.Token, spender string, amount *big.Int) (*Call, error) {
+	ta, ok := c.TokenMap[token]
+	if !ok {
+		return nil, defi.ErrTokenNotSupportedFn(token)
+	}
+
+	return &Call{
+		Contract: ta,
+		Selector: "approve",
+		Calldata: append([]*big.Int{types.HexToBN(spender)}, toUint256(amount)...),
+	}, nil
+}
+
+// withApprove prepends approve call to multicall when spender allowance is not enough
+func (c *Client) withApprove(ctx context.Context, acc *account, token v1.Token, spender string, amount *big.Int, calls ...Call) ([]Call, error) {
+	allowance, err := c.allowance(ctx, token, acc.Address(), spender)
+	if err != nil {
+		return nil, errors.Wrap(err, "allowance")
+	}
+	if allowance.Cmp(amount) >= 0 {
+		return calls, nil
+	}
+
+	approve, err := c.approveCall(token, spender, amount)
+	if err != nil {
+		return nil, err
+	}
+	return append([]Call{*approve}, calls...), nil
+}
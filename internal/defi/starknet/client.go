@@ -6,15 +6,32 @@ import (
 	"time"
 
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	"github.com/hardstylez72/cry/internal/defi/starknet/halper"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/hardstylez72/cry/internal/server/config"
 	"github.com/hardstylez72/cry/starknet.go/gateway"
 	"github.com/hardstylez72/cry/starknet.go/rpc"
+	"github.com/hardstylez72/cry/starknet.go/types"
+	"github.com/pkg/errors"
 )
 
 const MainnetRPC = gateway.MAINNET_BASE
 
+// JsonRPCEndpoints json-rpc nodes of the mainnet the provider fails over between, the default fallbacks of the
+// settings
+const JsonRPCEndpoints = "https://starknet-mainnet.public.blastapi.io,https://free-rpc.nethermind.io/mainnet-juno"
+
+// JsonRPC json-rpc nodes of the endpoint list of the settings. The rpc endpoint of starknet is the gateway, the
+// nodes are the fallbacks of it, JsonRPCEndpoints if there are none
+func JsonRPC(endpoints string) string {
+	nodes := rpcpool.Parse(endpoints)
+	if len(nodes) < 2 {
+		return JsonRPCEndpoints
+	}
+	return rpcpool.Join(nodes[1:])
+}
+
 type Client struct {
 	GW          *gateway.Gateway
 	GWP         *rpc.Provider
@@ -36,13 +53,14 @@ type ClientConfig struct {
 func NewClient(cfg *ClientConfig) (*Client, error) {
 
 	gw := gateway.NewClient(
-		gateway.WithChain(cfg.RPCEndpoint),
+		gateway.WithChain(rpcpool.Primary(cfg.RPCEndpoint)),
 		gateway.WithHttpClient(*cfg.HttpCli),
 	)
 
+	nodes := JsonRPC(cfg.RPCEndpoint)
 	crpc, err := ethrpc.DialOptions(context.Background(),
-		"https://starknet-mainnet.public.blastapi.io",
-		ethrpc.WithHTTPClient(cfg.HttpCli))
+		rpcpool.Primary(nodes),
+		ethrpc.WithHTTPClient(rpcpool.NewHTTPClient(cfg.HttpCli, nodes, rpcpool.StarkNet)))
 	if err != nil {
 		return nil, err
 	}
@@ -59,9 +77,12 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 	//)
 	gwp := rpc.NewProvider(crpc)
 
-	host := "http://localhost:7272"
-	if config.CFG != nil {
-		host = config.CFG.HalperHost
+	var h halper.HalperService
+	if config.CFG != nil && config.CFG.HalperHost != "" {
+		h = halper.NewService(&halper.Config{
+			Host: config.CFG.HalperHost,
+			RPC:  MainnetRPC,
+		})
 	}
 
 	return &Client{
@@ -69,10 +90,7 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 		GWP:       gwp,
 		NetworkId: networkId,
 		cfg:       cfg,
-		halper: halper.NewService(&halper.Config{
-			Host: host,
-			RPC:  MainnetRPC,
-		}),
+		halper:    h,
 		txViewFn: func(txId string) string {
 			return "https://starkscan.co/tx/" + txId
 		},
@@ -89,27 +107,46 @@ func (c *Client) TxViewFn(id string) string {
 	return c.txViewFn(id)
 }
 
+// GetPublicKey returns account contract address for stark private key
 func (c *Client) GetPublicKey(pk string, subType v1.ProfileSubType) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-	defer cancel()
-	res, err := c.halper.AccountPubKey(ctx, &halper.AccountPubKeyReq{PrivateKey: pk, SubType: subType.String()})
+	acc, err := newAccount(pk, subType)
 	if err != nil {
 		return "", err
 	}
+	return acc.Address(), nil
+}
+
+func (c *Client) Network() v1.Network {
+	return c.network
+}
 
-	if len(res.PublicKey) < 64+2 {
-		addZeros := len(res.PublicKey) - 64
-		base := res.PublicKey[2:]
+var ErrHalperNotConfigured = errors.New("halper service is not configured")
 
-		for i := 0; i < addZeros; i++ {
-			base = "0" + base
-		}
-		return "0x" + base, nil
+var ErrAddressMismatch = errors.New("account address derived from the private key differs from the known one")
+
+// VerifyAddress account address of the private key checked against the known one. The stored address of the profile
+// is the known one, the halper one is asked for when it is not stored yet and the halper is configured. The derived
+// address is returned when there is nothing to check it against
+func (c *Client) VerifyAddress(ctx context.Context, pk string, subType v1.ProfileSubType, stored string) (string, error) {
+	derived, err := c.GetPublicKey(pk, subType)
+	if err != nil {
+		return "", err
 	}
 
-	return res.PublicKey, nil
-}
+	known := stored
+	if known == "" && c.halper != nil {
+		res, err := c.halper.AccountPubKey(ctx, &halper.AccountPubKeyReq{PrivateKey: pk, SubType: subType.String()})
+		if err != nil {
+			return "", errors.Wrap(err, "halper.AccountPubKey")
+		}
+		known = res.PublicKey
+	}
+	if known == "" {
+		return derived, nil
+	}
 
-func (c *Client) Network() v1.Network {
-	return c.network
+	if types.HexToBN(known).Cmp(types.HexToBN(derived)) != 0 {
+		return "", errors.Wrapf(ErrAddressMismatch, "known %s, derived %s", known, derived)
+	}
+	return derived, nil
 }
@@ -0,0 +1,151 @@
+package starknet
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"strings"
+
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// starkIdBasicAlphabet names of these chars are encoded as base 38 numbers
+	starkIdBasicAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789-"
+	// starkIdDays domain is bought for a year
+	starkIdDays = 365
+)
+
+// starkIdDayPrice price of a day of 5+ chars domain in ETH wei
+var starkIdDayPrice = big.NewInt(24657534246575)
+
+func (c *Client) StarkIdIdentityAddress() string {
+	return "0x05dbdedc203e92749e2e746e2d40a768d966bd243df04a6b712e222bc040a9af"
+}
+
+func (c *Client) StarkIdNamingAddress() string {
+	return "0x06ac597f8116f886fa1c97a23fa4e08299975ecaf6b598873ca6792b9bbfb678"
+}
+
+func (c *Client) DomainAvailable(ctx context.Context, name string, taskType v1.TaskType) (bool, error) {
+	if taskType != v1.TaskType_StarkIdMint {
+		return false, errors.New("unsupported domain task type: " + taskType.String())
+	}
+
+	domain, err := starkIdEncode(name)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := c.call(ctx, c.StarkIdNamingAddress(), "domain_to_address", []*big.Int{big.NewInt(1), domain})
+	if err != nil {
+		return false, err
+	}
+	if len(res) < 1 {
+		return false, errors.New("invalid domain_to_address response")
+	}
+	return res[0].Sign() == 0, nil
+}
+
+func (c *Client) DomainPrice(_ context.Context, taskType v1.TaskType) (*big.Int, error) {
+	if taskType != v1.TaskType_StarkIdMint {
+		return nil, errors.New("unsupported domain task type: " + taskType.String())
+	}
+	return starkIdPrice(), nil
+}
+
+// starkIdPrice eth wei the 5+ chars domain is bought with for starkIdDays
+func starkIdPrice() *big.Int {
+	return new(big.Int).Mul(starkIdDayPrice, big.NewInt(starkIdDays))
+}
+
+// MintDomain mints starknet id identity and buys the domain for it in one multicall
+func (c *Client) MintDomain(ctx context.Context, req *defi.MintDomainReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
+	if taskType != v1.TaskType_StarkIdMint {
+		return nil, errors.New("unsupported domain task type: " + taskType.String())
+	}
+
+	acc, err := newAccount(req.WalletPK, req.SubType)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := starkIdEncode(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Name) < 5 {
+		return nil, errors.New("stark id domain is priced for 5+ chars only: " + req.Name)
+	}
+
+	identity := big.NewInt(rand.Int63())
+	price := starkIdPrice()
+
+	mint := Call{
+		Contract: c.StarkIdIdentityAddress(),
+		Selector: "mint",
+		Calldata: []*big.Int{identity},
+	}
+	buy := Call{
+		Contract: c.StarkIdNamingAddress(),
+		Selector: "buy",
+		Calldata: []*big.Int{
+			identity,
+			domain,
+			big.NewInt(starkIdDays),
+			big.NewInt(0),
+			acc.address,
+			big.NewInt(0),
+			big.NewInt(0),
+		},
+	}
+
+	calls, err := c.withApprove(ctx, acc, v1.Token_ETH, c.StarkIdNamingAddress(), price, mint, buy)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.execute(ctx, acc, calls, maxFeeFromGas(req.Gas), req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &bozdo.DefaultRes{
+		ECost: &bozdo.EstimatedGasCost{
+			Type:        bozdo.TxTypeStarkNet,
+			Name:        "domain",
+			GasLimit:    big.NewInt(0),
+			GasPrice:    big.NewInt(0),
+			TotalGasWei: res.MaxFee,
+		},
+	}
+
+	if res.TxHash != nil {
+		result.Tx = c.NewTx(bozdo.CodeContract, *res.TxHash, nil)
+	}
+
+	return result, nil
+}
+
+// starkIdEncode encodes the name of basic alphabet chars, names ending with "a" need special encoding and are rejected
+func starkIdEncode(name string) (*big.Int, error) {
+	if name == "" || strings.HasSuffix(name, "a") {
+		return nil, errors.New("unsupported stark id name: " + name)
+	}
+
+	base := big.NewInt(int64(len(starkIdBasicAlphabet) + 1))
+	encoded := big.NewInt(0)
+	multiplier := big.NewInt(1)
+	for _, char := range name {
+		index := strings.IndexRune(starkIdBasicAlphabet, char)
+		if index == -1 {
+			return nil, errors.New("unsupported stark id name: " + name)
+		}
+		encoded.Add(encoded, new(big.Int).Mul(multiplier, big.NewInt(int64(index))))
+		multiplier.Mul(multiplier, base)
+	}
+	return encoded, nil
+}
@@ -0,0 +1,16 @@
+package starknet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStarkIdEncode(t *testing.T) {
+	encoded, err := starkIdEncode("ben")
+	assert.NoError(t, err)
+	assert.Equal(t, "18925", encoded.String())
+
+	_, err = starkIdEncode("anna")
+	assert.Error(t, err)
+}
@@ -17,6 +17,10 @@ type Account struct {
 }
 
 func (c *Client) DegenerateAccount(ctx context.Context, profileType v1.ProfileSubType, count int64) ([]Account, error) {
+	if c.halper == nil {
+		return generateAccounts(profileType, count)
+	}
+
 	accs, err := c.halper.Generate(ctx, &halper.GenerateReq{
 		SubType: profileType.String(), Count: int(count),
 	})
@@ -35,22 +39,27 @@ func (c *Client) DegenerateAccount(ctx context.Context, profileType v1.ProfileSu
 	return out, nil
 }
 
-//func DegenerateAccount() (*Account, error) {
-//	pk, err := starknetgo.Curve.GetRandomPrivateKey()
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	x, _, err := starknetgo.Curve.PrivateToPoint(pk)
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	return &Account{
-//		PublicKey:  types.BigToHex(x),
-//		PrivateKey: types.BigToHex(pk),
-//	}, nil
-//}
+// generateAccounts makes random stark keys locally, without seed phrase
+func generateAccounts(profileType v1.ProfileSubType, count int64) ([]Account, error) {
+	out := make([]Account, 0, count)
+	for i := int64(0); i < count; i++ {
+		pk, err := starknetgo.Curve.GetRandomPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		acc, err := newAccount(types.BigToHex(pk), profileType)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, Account{
+			Pub: acc.Address(),
+			PK:  types.BigToHex(pk),
+		})
+	}
+	return out, nil
+}
 
 func GetPublicKeyHash(pk string) (string, error) {
 
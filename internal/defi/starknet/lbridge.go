@@ -17,6 +17,9 @@ func (c *Client) LiquidityBridge(ctx context.Context, req *defi.LiquidityBridgeR
 	}
 
 	if req.From == v1.Network_Etherium && req.To == v1.Network_StarkNet {
+		if c.halper == nil {
+			return nil, ErrHalperNotConfigured
+		}
 
 		res, err := c.halper.LiquidityBridge(ctx, &halper.LiquidityBridgeReq{
 			Proxy:        c.cfg.Proxy,
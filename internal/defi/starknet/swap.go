@@ -3,12 +3,13 @@ package starknet
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
-	"github.com/hardstylez72/cry/internal/defi/starknet/halper"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
-	"github.com/hardstylez72/cry/starknet.go/gateway"
+	"github.com/hardstylez72/cry/starknet.go/types"
+	"github.com/pkg/errors"
 )
 
 func (c *Client) Swap10kRouterAddress() string {
@@ -28,61 +29,202 @@ func (c *Client) MySwapRouterAddress() string {
 func (c *Client) ProtosSwapRouterAddress() string {
 	return "0x07a0922657e550ba1ef76531454cb6d203d4d168153a0f05671492982c2f7741"
 }
+
+type swapCall struct {
+	Spender string
+	Call    Call
+}
+
 func (c *Client) Swap(ctx context.Context, req *defi.DefaultSwapReq, platform v1.TaskType) (*bozdo.DefaultRes, error) {
 
-	res, err := c.halper.Swap(ctx, c.castHalperSwapReq(req, platform))
+	acc, err := newAccount(req.WalletPK, req.SubType)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.CastHalperSwapRes(res), nil
+	from, ok := c.TokenMap[req.FromToken]
+	if !ok {
+		return nil, defi.ErrTokenNotSupportedFn(req.FromToken)
+	}
+	to, ok := c.TokenMap[req.ToToken]
+	if !ok {
+		return nil, defi.ErrTokenNotSupportedFn(req.ToToken)
+	}
+
+	var swap *swapCall
+	switch platform {
+	case v1.TaskType_JediSwap:
+		swap, err = c.routerV2Swap(ctx, acc, req, c.JediSwapRouterAddress(), "get_amounts_out", "swap_exact_tokens_for_tokens", from, to)
+	case v1.TaskType_Swap10k:
+		swap, err = c.routerV2Swap(ctx, acc, req, c.Swap10kRouterAddress(), "getAmountsOut", "swapExactTokensForTokens", from, to)
+	case v1.TaskType_ProtossSwap:
+		swap, err = c.routerV2Swap(ctx, acc, req, c.ProtosSwapRouterAddress(), "getAmountsOut", "swapExactTokensForTokens", from, to)
+	case v1.TaskType_SithSwap:
+		swap, err = c.sithSwap(ctx, acc, req, from, to)
+	case v1.TaskType_MySwap:
+		swap, err = c.mySwap(ctx, req, from, to)
+	default:
+		return nil, errors.New("unsupported task type: " + platform.String())
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, platform.String())
+	}
+
+	calls, err := c.withApprove(ctx, acc, req.FromToken, swap.Spender, req.Amount, swap.Call)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.execute(ctx, acc, calls, maxFeeFromGas(req.Gas), req.EstimateOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &bozdo.DefaultRes{
+		ECost: &bozdo.EstimatedGasCost{
+			Type:        bozdo.TxTypeStarkNet,
+			Name:        "swap",
+			GasLimit:    big.NewInt(0),
+			GasPrice:    big.NewInt(0),
+			TotalGasWei: res.MaxFee,
+		},
+	}
+
+	if res.TxHash != nil {
+		result.Tx = c.NewTx(bozdo.CodeSwap, *res.TxHash, nil)
+	}
+
+	return result, nil
 }
 
-func (c *Client) CastHalperSwapRes(res *halper.DefaultSwapRes) *bozdo.DefaultRes {
-	result := &bozdo.DefaultRes{}
+func swapDeadline() *big.Int {
+	return big.NewInt(time.Now().Add(time.Minute * 20).Unix())
+}
+
+// routerV2Swap uniswap v2 like routers: jediswap, 10kswap, protoss
+func (c *Client) routerV2Swap(ctx context.Context, acc *account, req *defi.DefaultSwapReq, router, quoteFn, swapFn, from, to string) (*swapCall, error) {
+	path := []*big.Int{big.NewInt(2), types.HexToBN(from), types.HexToBN(to)}
 
-	if res.ApproveTx != nil {
-		result.ApproveTx = c.NewTx(bozdo.CodeApprove, *res.ApproveTx, nil)
+	quote, err := c.call(ctx, router, quoteFn, append(toUint256(req.Amount), path...))
+	if err != nil {
+		return nil, err
 	}
+	// amounts_len, amounts: Uint256*
+	if len(quote) < 5 {
+		return nil, errors.New("invalid " + quoteFn + " response")
+	}
+	amountOut := fromUint256(quote[3], quote[4])
 
-	if res.SwapTx != nil {
-		result.Tx = c.NewTx(bozdo.CodeSwap, *res.SwapTx, nil)
+	minOut, err := minAmountOut(amountOut, req.Slippage)
+	if err != nil {
+		return nil, err
 	}
 
-	if res.Fee != nil {
+	calldata := append(toUint256(req.Amount), toUint256(minOut)...)
+	calldata = append(calldata, path...)
+	calldata = append(calldata, acc.address, swapDeadline())
 
-		t, ok := big.NewInt(0).SetString(*res.Fee, 10)
-		if ok {
-			result.ECost = &bozdo.EstimatedGasCost{
-				Type:        bozdo.TxTypeStarkNet,
-				Name:        "swap",
-				GasLimit:    big.NewInt(0),
-				GasPrice:    big.NewInt(0),
-				TotalGasWei: t,
-				Details:     nil,
-			}
-		}
+	return &swapCall{
+		Spender: router,
+		Call: Call{
+			Contract: router,
+			Selector: swapFn,
+			Calldata: calldata,
+		},
+	}, nil
+}
+
+func (c *Client) sithSwap(ctx context.Context, acc *account, req *defi.DefaultSwapReq, from, to string) (*swapCall, error) {
+	router := c.SithSwapRouterAddress()
+
+	// returns amount: Uint256, stable: felt
+	quote, err := c.call(ctx, router, "getAmountOut", append(toUint256(req.Amount), types.HexToBN(from), types.HexToBN(to)))
+	if err != nil {
+		return nil, err
+	}
+	if len(quote) < 3 {
+		return nil, errors.New("invalid getAmountOut response")
 	}
+	amountOut := fromUint256(quote[0], quote[1])
+	stable := quote[2]
 
-	return result
+	minOut, err := minAmountOut(amountOut, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+
+	calldata := append(toUint256(req.Amount), toUint256(minOut)...)
+	// routes_len, routes: Route(from_address, to_address, stable)*
+	calldata = append(calldata, big.NewInt(1), types.HexToBN(from), types.HexToBN(to), stable)
+	calldata = append(calldata, acc.address, swapDeadline())
+
+	return &swapCall{
+		Spender: router,
+		Call: Call{
+			Contract: router,
+			Selector: "swapExactTokensForTokensSupportingFeeOnTransferTokens",
+			Calldata: calldata,
+		},
+	}, nil
 }
-func (c *Client) castHalperSwapReq(req *defi.DefaultSwapReq, platform v1.TaskType) *halper.DefaultSwapReq {
-	result := &halper.DefaultSwapReq{
-		Proxy:        c.cfg.Proxy,
-		ChainRPC:     gateway.MAINNET_BASE,
-		PrivateKey:   req.WalletPK,
-		FromToken:    req.FromToken.String(),
-		ToToken:      req.ToToken.String(),
-		Amount:       req.Amount.String(),
-		EstimateOnly: req.EstimateOnly,
-		Slippage:     req.Slippage,
-		Platform:     platform.String(),
-		SubType:      req.SubType.String(),
+
+// mySwapPools https://docs.myswap.xyz
+var mySwapPools = map[v1.Token]map[v1.Token]int64{
+	v1.Token_ETH:  {v1.Token_USDC: 1},
+	v1.Token_USDC: {v1.Token_ETH: 1},
+}
+
+func (c *Client) mySwap(ctx context.Context, req *defi.DefaultSwapReq, from, to string) (*swapCall, error) {
+	router := c.MySwapRouterAddress()
+
+	poolId, ok := mySwapPools[req.FromToken][req.ToToken]
+	if !ok {
+		return nil, errors.New("pool not found: " + req.FromToken.String() + " -> " + req.ToToken.String())
 	}
 
-	if req.Gas.RuleSet() {
-		result.Fee = req.Gas.TotalGas.String()
+	// name, token_a_address, token_a_reserves: Uint256, token_b_address, token_b_reserves: Uint256, fee_percentage, cfmm_type, liq_token
+	pool, err := c.call(ctx, router, "get_pool", []*big.Int{big.NewInt(poolId)})
+	if err != nil {
+		return nil, err
+	}
+	if len(pool) < 7 {
+		return nil, errors.New("invalid get_pool response")
+	}
+
+	reserveIn, reserveOut := fromUint256(pool[2], pool[3]), fromUint256(pool[5], pool[6])
+	if pool[1].Cmp(types.HexToBN(from)) != 0 {
+		reserveIn, reserveOut = reserveOut, reserveIn
+	}
+
+	// constant product with 0.3% pool fee
+	amountInWithFee := new(big.Int).Mul(req.Amount, big.NewInt(997))
+	amountOut := new(big.Int).Div(
+		new(big.Int).Mul(amountInWithFee, reserveOut),
+		new(big.Int).Add(new(big.Int).Mul(reserveIn, big.NewInt(1000)), amountInWithFee),
+	)
+
+	minOut, err := minAmountOut(amountOut, req.Slippage)
+	if err != nil {
+		return nil, err
 	}
 
-	return result
+	calldata := []*big.Int{big.NewInt(poolId), types.HexToBN(from)}
+	calldata = append(calldata, toUint256(req.Amount)...)
+	calldata = append(calldata, toUint256(minOut)...)
+
+	return &swapCall{
+		Spender: router,
+		Call: Call{
+			Contract: router,
+			Selector: "swap",
+			Calldata: calldata,
+		},
+	}, nil
+}
+
+func minAmountOut(amountOut *big.Int, slippage defi.SlippagePercent) (*big.Int, error) {
+	if amountOut.Sign() <= 0 {
+		return nil, errors.New("zero output amount")
+	}
+	return defi.Slippage(amountOut, slippage)
 }
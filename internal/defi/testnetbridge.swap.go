@@ -4,7 +4,6 @@ import (
 	"context"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
 	"github.com/hardstylez72/cry/internal/defi/contracts/testnetbridge"
@@ -102,7 +101,7 @@ func (c *EtheriumClient) TestNetBridgeSwap(ctx context.Context, req *TestNetBrid
 	if err != nil {
 		return nil, err
 	}
-	trx, err := testnetbridge.NewTestnetbridgeTransactor(c.Cfg.Dict.TestNetBridgeSwapAddress, c.Cli)
+	trx, err := testnetbridge.NewTestnetbridgeTransactor(c.Cfg.Dict.TestNetBridgeSwapAddress, c.backend())
 	if err != nil {
 		return nil, err
 	}
@@ -112,9 +111,9 @@ func (c *EtheriumClient) TestNetBridgeSwap(ctx context.Context, req *TestNetBrid
 		return nil, err
 	}
 
-	opt, err := bind.NewKeyedTransactorWithChainID(tr.PrivateKey, chainID)
+	opt, err := tr.TransactOpts(ctx, chainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "TransactOpts")
 	}
 	opt.Context = ctx
 
@@ -132,7 +131,13 @@ func (c *EtheriumClient) TestNetBridgeSwap(ctx context.Context, req *TestNetBrid
 
 	opt.Value = big.NewInt(0).Add(req.Amount, fee.Fee1)
 
+	reserved, err := c.prepareSend(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := trx.SwapAndBridge(opt, req.Amount, goerliEthAmount, dstChainId, tr.WalletAddr, tr.WalletAddr, zeroPaymentAddr, nil)
+	reserved.Done(err)
 	if err != nil {
 		return nil, err
 	}
@@ -80,29 +80,29 @@ func (c *EtheriumClient) TokenLimitChecker(ctx context.Context, req *TokenLimitC
 		return r, nil
 	}
 
-	allowed, err := c.TokenAllowed(ctx, &AllowedReq{
-		Token:       req.Token,
-		WalletAddr:  req.Wallet.WalletAddr,
-		SpenderAddr: req.SpenderAddr,
+	balances, err := c.GetBalances(ctx, &GetBalancesReq{
+		Wallets: []common.Address{req.Wallet.WalletAddr},
+		Tokens:  []Token{req.Token},
+		Spender: &req.SpenderAddr,
 	})
 	if err != nil {
 		return nil, err
 	}
+	b, err := balances.Get(req.Wallet.WalletAddr, req.Token)
+	if err != nil {
+		return nil, err
+	}
 
-	if req.Amount.Cmp(allowed.Allowance) == 1 {
+	if req.Amount.Cmp(b.Allowance) == 1 {
 
-		b, err := c.GetBalance(ctx, &GetBalanceReq{
-			WalletAddress: req.Wallet.WalletAddrHR,
-			Token:         req.Token,
-		})
-		if err != nil {
-			return nil, err
+		amount := b.Balance.WEI
+		if ExactApprove(ctx) {
+			amount = req.Amount
 		}
-
 		tx, err := c.TokenApprove(ctx, &ApproveReq{
 			Token:       req.Token,
 			Wallet:      req.Wallet,
-			Amount:      b.WEI,
+			Amount:      amount,
 			SpenderAddr: req.SpenderAddr,
 		})
 		if err != nil {
@@ -148,7 +148,7 @@ func (c *EtheriumClient) tokenApprove(ctx context.Context, req *ApproveReq) (*Ap
 
 	addr := c.Cfg.TokenMap[req.Token]
 
-	caller, err := erc_20.NewStorageTransactor(addr, c.Cli)
+	caller, err := erc_20.NewStorageTransactor(addr, c.backend())
 	if err != nil {
 		return nil, errors.Wrap(err, "stg.NewStgCaller")
 	}
@@ -158,13 +158,19 @@ func (c *EtheriumClient) tokenApprove(ctx context.Context, req *ApproveReq) (*Ap
 		return nil, err
 	}
 
-	opt, err := bind.NewKeyedTransactorWithChainID(req.Wallet.PrivateKey, chainID)
+	opt, err := req.Wallet.TransactOpts(ctx, chainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "TransactOpts")
 	}
 	opt.Context = ctx
 
+	reserved, err := c.prepareSend(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := caller.Approve(opt, req.SpenderAddr, req.Amount)
+	reserved.Done(err)
 	if err != nil {
 		return nil, errors.Wrap(err, "caller.Allowance")
 	}
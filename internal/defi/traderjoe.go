@@ -3,18 +3,25 @@ package defi
 import (
 	"context"
 	"math/big"
+	"time"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/traderjoe/lbquoter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/traderjoe/lbrouter"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/hardstylez72/cry/internal/traderjoe"
 	"github.com/pkg/errors"
 )
 
+type TraderJoe struct {
+	LBRouter common.Address
+	LBQuoter common.Address
+}
+
 func (c *EtheriumClient) Swapper(ctx context.Context, req *DefaultSwapReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
 	switch taskType {
 	case v1.TaskType_TraderJoeSwap:
@@ -32,22 +39,24 @@ func (c *EtheriumClient) TraderJoeSwap(ctx context.Context, req *DefaultSwapReq)
 	if err != nil {
 		return nil, err
 	}
-	res, err := c.traderJoeService.GetSwapData(ctx, &traderjoe.GetSwapDataReq{
-		FromToken: req.FromToken,
-		ToToken:   req.ToToken,
-		ChainRPC:  c.Cfg.MainNet,
-		Amount:    req.Amount,
-		Recipient: tr.WalletAddr,
-	})
+
+	swap, err := c.buildTraderJoeSwap(ctx, req, tr.WalletAddr)
 	if err != nil {
-		return nil, err
+		// the halper route is taken only when the liquidity book has no route, the other errors are real failures
+		if !errors.Is(err, errTraderJoeNoRoute) || c.traderJoeService == nil {
+			return nil, errors.Wrap(err, "buildTraderJoeSwap")
+		}
+		swap, err = c.traderJoeHalperSwap(ctx, req, tr.WalletAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "traderJoeHalperSwap")
+		}
 	}
 
 	limitTx, err := c.TokenLimitChecker(ctx, &TokenLimitCheckerReq{
 		Token:       req.FromToken,
 		Wallet:      tr,
 		Amount:      req.Amount,
-		SpenderAddr: common.HexToAddress(res.ContractAddr),
+		SpenderAddr: swap.To,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "TokenLimitChecker")
@@ -56,95 +65,147 @@ func (c *EtheriumClient) TraderJoeSwap(ctx context.Context, req *DefaultSwapReq)
 		result.ApproveTx = c.NewTx(limitTx.ApproveTx.Hash(), CodeApprove, nil)
 	}
 
-	to := common.HexToAddress(res.ContractAddr)
-
-	gasPrice, err := c.SuggestGasPrice(ctx)
+	ecost, tx, err := c.sendContractCall(ctx, tr, swap, req.Gas, req.EstimateOnly)
 	if err != nil {
 		return nil, err
 	}
 
-	value, err := hexutil.DecodeBig(res.Value)
+	result.ECost = ecost
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	result.Tx = c.NewTx(tx.Hash(), CodeContract, nil)
+
+	return result, nil
+}
+
+// errTraderJoeNoRoute the liquidity book has no route of the swap, the halper route is tried instead
+var errTraderJoeNoRoute = errors.New("traderjoe: no route found")
+
+// buildTraderJoeSwap finds the best Liquidity Book route with LBQuoter and packs LBRouter calldata
+func (c *EtheriumClient) buildTraderJoeSwap(ctx context.Context, req *DefaultSwapReq, recipient common.Address) (*contractCall, error) {
+
+	if c.Cfg.Dict == nil || c.Cfg.Dict.TraderJoe.LBRouter == (common.Address{}) {
+		return nil, errors.Wrap(errTraderJoeNoRoute, "liquidity book is not set in network "+c.Cfg.Network.String())
+	}
+	joe := c.Cfg.Dict.TraderJoe
+
+	router, err := lbrouter.NewStorageCaller(joe.LBRouter, c.Cli)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "lbrouter.NewStorageCaller")
 	}
 
-	data := common.Hex2Bytes(res.Data[2:])
-	println(common.Bytes2Hex(data))
+	quoter, err := lbquoter.NewStorageCaller(joe.LBQuoter, c.Cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "lbquoter.NewStorageCaller")
+	}
 
-	estimate, err := c.Cli.EstimateGas(ctx, ethereum.CallMsg{
-		From:      tr.WalletAddr,
-		To:        &to,
-		Gas:       0,
-		GasPrice:  nil,
-		GasFeeCap: nil,
-		GasTipCap: nil,
-		Value:     value,
-		Data:      data,
-	})
+	wNative, err := router.GetWNATIVE(&bind.CallOpts{Context: ctx})
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "router.GetWNATIVE")
+	}
+
+	tokenAddr := func(t v1.Token) (common.Address, error) {
+		if t == c.Cfg.MainToken {
+			return wNative, nil
+		}
+		addr, ok := c.Cfg.TokenMap[t]
+		if !ok {
+			return common.Address{}, ErrTokenNotSupportedFn(t)
+		}
+		return addr, nil
 	}
 
-	e := &bozdo.EstimatedGasCost{
-		GasLimit:    new(big.Int).SetUint64(estimate),
-		GasPrice:    gasPrice,
-		TotalGasWei: MinerGasLegacy(gasPrice, estimate),
+	from, err := tokenAddr(req.FromToken)
+	if err != nil {
+		return nil, err
+	}
+	to, err := tokenAddr(req.ToToken)
+	if err != nil {
+		return nil, err
 	}
 
-	result.ECost = e
+	quote, err := quoter.FindBestPathFromAmountIn(&bind.CallOpts{Context: ctx}, []common.Address{from, to}, req.Amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "quoter.FindBestPathFromAmountIn")
+	}
 
-	if req.EstimateOnly {
-		return result, nil
+	if len(quote.Amounts) == 0 || len(quote.BinSteps) == 0 {
+		return nil, errors.Wrap(errTraderJoeNoRoute, req.FromToken.String()+" -> "+req.ToToken.String())
 	}
 
-	var tx *types.Transaction
+	amountOut := quote.Amounts[len(quote.Amounts)-1]
+	if amountOut.Cmp(big.NewInt(0)) <= 0 {
+		return nil, errors.Wrap(errTraderJoeNoRoute, "zero output amount")
+	}
 
-	nonce, err := c.Cli.NonceAt(ctx, tr.WalletAddr, nil)
+	amountOutMin, err := Slippage(amountOut, req.Slippage)
 	if err != nil {
 		return nil, err
 	}
 
-	head, errHead := c.Cli.HeaderByNumber(ctx, nil)
-	if errHead == nil && head.BaseFee != nil {
-		txx := &types.DynamicFeeTx{
-			ChainID:   c.Cfg.networkId,
-			Nonce:     nonce,
-			GasTipCap: nil,
-			GasFeeCap: head.BaseFee,
-			Gas:       estimate,
-			To:        &to,
-			Value:     value,
-			Data:      data,
-		}
+	path := lbrouter.ILBRouterPath{
+		PairBinSteps: quote.BinSteps,
+		Versions:     quote.Versions,
+		TokenPath:    quote.Route,
+	}
 
-		tx = types.NewTx(txx)
-	} else {
-		txx := &types.LegacyTx{
-			Nonce:    nonce,
-			GasPrice: gasPrice,
-			Gas:      estimate,
-			To:       &to,
-			Value:    value,
-			Data:     data,
-		}
-		tx = types.NewTx(txx)
+	routerAbi, err := lbrouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
 	}
 
-	opt, err := bind.NewKeyedTransactorWithChainID(tr.PrivateKey, c.Cfg.networkId)
+	deadline := new(big.Int).SetInt64(time.Now().Add(time.Minute * 5).Unix())
+
+	var data []byte
+	value := big.NewInt(0)
+	switch {
+	case req.FromToken == c.Cfg.MainToken:
+		value = req.Amount
+		data, err = routerAbi.Pack("swapExactNATIVEForTokens", amountOutMin, path, recipient, deadline)
+	case req.ToToken == c.Cfg.MainToken:
+		data, err = routerAbi.Pack("swapExactTokensForNATIVE", req.Amount, amountOutMin, path, recipient, deadline)
+	default:
+		data, err = routerAbi.Pack("swapExactTokensForTokens", req.Amount, amountOutMin, path, recipient, deadline)
+	}
 	if err != nil {
-		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "routerAbi.Pack")
 	}
 
-	tx, err = opt.Signer(tr.WalletAddr, tx)
+	return &contractCall{
+		To:    joe.LBRouter,
+		Value: value,
+		Data:  data,
+	}, nil
+}
+
+func (c *EtheriumClient) traderJoeHalperSwap(ctx context.Context, req *DefaultSwapReq, recipient common.Address) (*contractCall, error) {
+	res, err := c.traderJoeService.GetSwapData(ctx, &traderjoe.GetSwapDataReq{
+		FromToken: req.FromToken,
+		ToToken:   req.ToToken,
+		ChainRPC:  rpcpool.Primary(c.Cfg.MainNet),
+		Amount:    req.Amount,
+		Recipient: recipient,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if err := c.Cli.SendTransaction(ctx, tx); err != nil {
+	value, err := hexutil.DecodeBig(res.Value)
+	if err != nil {
 		return nil, err
 	}
 
-	result.Tx = c.NewTx(tx.Hash(), CodeContract, nil)
+	data, err := hexutil.Decode(res.Data)
+	if err != nil {
+		return nil, err
+	}
 
-	return result, nil
+	return &contractCall{
+		To:    common.HexToAddress(res.ContractAddr),
+		Value: value,
+		Data:  data,
+	}, nil
 }
@@ -5,11 +5,11 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
 	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
+	"github.com/hardstylez72/cry/internal/defi/nonce"
 	"github.com/pkg/errors"
 )
 
@@ -51,7 +51,7 @@ func (c *EtheriumClient) Transfer(ctx context.Context, r *TransferReq) (*Transfe
 
 	tokenAddr := c.Cfg.TokenMap[r.Token]
 
-	trx, err := erc_20.NewStorageTransactor(tokenAddr, c.Cli)
+	trx, err := erc_20.NewStorageTransactor(tokenAddr, c.backend())
 	if err != nil {
 		return nil, err
 	}
@@ -61,28 +61,38 @@ func (c *EtheriumClient) Transfer(ctx context.Context, r *TransferReq) (*Transfe
 		return nil, err
 	}
 
-	opt, err := bind.NewKeyedTransactorWithChainID(wallet.PrivateKey, chainID)
+	opt, err := wallet.TransactOpts(ctx, chainID)
 	if err != nil {
-		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
+		return nil, errors.Wrap(err, "TransactOpts")
 	}
 	opt.Context = ctx
 
 	opt.NoSend = r.EstimateOnly
-	if r.Gas.RuleSet() {
-		opt.GasLimit = r.Gas.GasLimit.Uint64()
-		opt.GasPrice = &r.Gas.GasPrice
+	opt = c.ResoleGas(ctx, r.Gas, opt)
+
+	reserved, err := c.prepareSend(ctx, opt)
+	if err != nil {
+		return nil, err
 	}
 
 	tx, err := trx.Transfer(opt, r.ToAddr, r.Amount)
+	reserved.Done(err)
 	if err != nil {
 		return nil, errors.Wrap(err, "Transfer")
 	}
 
 	eCost := &bozdo.EstimatedGasCost{
+		Type:        bozdo.TxTypeLegacy,
 		GasLimit:    big.NewInt(0).SetUint64(tx.Gas()),
 		GasPrice:    tx.GasPrice(),
 		TotalGasWei: MinerGasLegacy(tx.GasPrice(), tx.Gas()),
 	}
+	if tx.Type() == types.DynamicFeeTxType {
+		eCost.Type = bozdo.TxTypeDynamic
+		eCost.GasPrice = tx.GasFeeCap()
+		eCost.TotalGasWei = MinerGasLegacy(tx.GasFeeCap(), tx.Gas())
+		eCost.PriorityFee = tx.GasTipCap()
+	}
 
 	return &TransferRes{
 		Tx:    c.NewTx(tx.Hash(), CodeTransfer, nil),
@@ -125,11 +135,6 @@ func (c *EtheriumClient) TransferMainToken(ctx context.Context, r *TransferMainT
 		return nil, err
 	}
 
-	nonce, err := c.Cli.NonceAt(ctx, r.Wallet.WalletAddr, nil)
-	if err != nil {
-		return nil, err
-	}
-
 	b, err := c.GetBalance(ctx, &GetBalanceReq{
 		WalletAddress: r.Wallet.WalletAddr.String(),
 		Token:         c.Cfg.MainToken,
@@ -138,6 +143,11 @@ func (c *EtheriumClient) TransferMainToken(ctx context.Context, r *TransferMainT
 		return nil, err
 	}
 
+	fee, err := c.SuggestDynamicFee(ctx, r.Gas.Speed())
+	if err != nil {
+		return nil, err
+	}
+
 	data := []byte{}
 
 	gas, err := c.Cli.EstimateGas(ctx, ethereum.CallMsg{
@@ -155,11 +165,7 @@ func (c *EtheriumClient) TransferMainToken(ctx context.Context, r *TransferMainT
 		return nil, err
 	}
 
-	estimate := &bozdo.EstimatedGasCost{
-		GasLimit:    big.NewInt(0).SetUint64(gas),
-		GasPrice:    gasPrice,
-		TotalGasWei: MinerGasLegacy(gasPrice, gas),
-	}
+	estimate := estimatedGasCost(gas, gasPrice, fee)
 
 	if r.EstimateOnly {
 		return &TransferRes{
@@ -173,26 +179,41 @@ func (c *EtheriumClient) TransferMainToken(ctx context.Context, r *TransferMainT
 		gas = r.Gas.GasLimit.Uint64()
 	}
 
+	reserved, err := nonce.Default.Reserve(ctx, c.Cfg.Network, r.Wallet.WalletAddr, c.Cli)
+	if err != nil {
+		return nil, err
+	}
+	defer reserved.Done(errNotSent)
+
 	am := r.Amount
 	if am.Cmp(b.WEI) == 0 {
-		am = new(big.Int).Sub(b.WEI, MinerGasLegacy(gasPrice, gas))
+		am = new(big.Int).Sub(b.WEI, MinerGasLegacy(maxGasPrice(gasPrice, fee, r.Gas), gas))
 	}
 
-	tx := types.NewTx(&types.LegacyTx{
-		Nonce:    nonce,
+	legacy := &types.LegacyTx{
+		Nonce:    reserved.Nonce,
 		GasPrice: gasPrice,
 		Gas:      gas,
 		To:       &r.ToAddr,
 		Value:    am,
 		Data:     data,
-	})
+	}
+	tx := types.NewTx(legacy)
+	if fee != nil {
+		tx = dynamicFeeTx(c.Cfg.networkId, fee, r.Gas, legacy)
+	}
+
+	if err := c.Simulate(ctx, CallMsg(r.Wallet.WalletAddr, tx)); err != nil {
+		return nil, err
+	}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(c.Cfg.networkId), r.Wallet.PrivateKey)
+	signedTx, err := r.Wallet.Signer.SignTx(ctx, tx, c.Cfg.networkId)
 	if err != nil {
 		return nil, err
 	}
 
 	err = c.Cli.SendTransaction(ctx, signedTx)
+	reserved.Done(err)
 	if err != nil {
 		return nil, err
 	}
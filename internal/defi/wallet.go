@@ -16,9 +16,18 @@ type WalletTransactor struct {
 	WalletAddrHR string
 	PK           string
 	PKb          []byte
+	// Signer signs the txs of the wallet, PrivateKey is nil when the key is held by the external signer
+	Signer Signer
 }
 
 func GetEMVPublicKey(s string) (string, error) {
+	if IsExternalKey(s) {
+		addr, err := ExternalKeyAddr(s)
+		if err != nil {
+			return "", err
+		}
+		return addr.String(), nil
+	}
 	w, err := newWalletTransactor(s)
 	if err != nil {
 		return "", err
@@ -28,6 +37,22 @@ func GetEMVPublicKey(s string) (string, error) {
 
 func newWalletTransactor(privateKey string) (*WalletTransactor, error) {
 
+	if IsExternalKey(privateKey) {
+		walletAddr, err := ExternalKeyAddr(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := NewExternalSigner(walletAddr)
+		if err != nil {
+			return nil, err
+		}
+		return &WalletTransactor{
+			WalletAddr:   walletAddr,
+			WalletAddrHR: walletAddr.String(),
+			Signer:       signer,
+		}, nil
+	}
+
 	pkb, err := hex.DecodeString(privateKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "crypto.HexToECDSA(privateKey)")
@@ -53,5 +78,6 @@ func newWalletTransactor(privateKey string) (*WalletTransactor, error) {
 		WalletAddrHR: walletAddr.String(),
 		PK:           privateKey,
 		PKb:          pkb,
+		Signer:       NewKeySigner(pk),
 	}, nil
 }
@@ -14,7 +14,6 @@ import (
 	"github.com/hardstylez72/cry/internal/orbiter"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/pkg/errors"
-	"github.com/zksync-sdk/zksync2-go/accounts"
 	"github.com/zksync-sdk/zksync2-go/contracts/erc20"
 )
 
@@ -84,6 +83,19 @@ func (c *Client) GetBalance(ctx context.Context, req *defi.GetBalanceReq) (*defi
 	return res, nil
 }
 
+// multicall3Address Multicall3 of zkSync Era, the create2 address of the other networks differs on it
+var multicall3Address = common.HexToAddress("0xF9cda624FBC7e059355ce98a31693d299FACd963")
+
+func (c *Client) GetBalances(ctx context.Context, req *defi.GetBalancesReq) (*defi.GetBalancesRes, error) {
+	return defi.MulticallBalances(ctx, &defi.BalanceSource{
+		Caller:    c.ClientL2,
+		Multicall: multicall3Address,
+		Network:   c.Cfg.Network,
+		MainToken: c.Cfg.MainToken,
+		TokenMap:  c.Cfg.TokenMap,
+	}, req)
+}
+
 func (c *Client) TxViewFn(id string) string {
 	return c.Cfg.TxViewFn(id)
 }
@@ -131,11 +143,12 @@ func (c *Client) OrbiterBridge(ctx context.Context, req *defi.OrbiterBridgeReq)
 		FromToken:   req.FromToken,
 		ToToken:     req.ToToken,
 		Amount:      req.Amount,
+		Expected:    req.Maker,
 	})
 	if err != nil {
 		return nil, err
 	}
-	req.Amount = orbiter.WrapValueWei(req.Amount, opt.Value4DigitCode)
+	req.Amount = opt.Value
 
 	res, err := c.Transfer(ctx, &defi.TransferReq{
 		Pk:           req.WalletPk,
@@ -150,6 +163,7 @@ func (c *Client) OrbiterBridge(ctx context.Context, req *defi.OrbiterBridgeReq)
 	}
 	r.Tx = res.Tx
 	r.ECost = res.ECost
+	r.Maker = opt
 
 	return r, nil
 }
@@ -174,11 +188,6 @@ func (c *Client) TransferToken(ctx context.Context, r *defi.TransferReq) (*defi.
 		return nil, errors.Wrap(err, "newWalletTransactor")
 	}
 
-	w, err := accounts.NewWallet(wtx.PKb, &c.ClientL2, c.ClientL1)
-	if err != nil {
-		return nil, errors.Wrap(err, "zksync2.NewWallet")
-	}
-
 	tokenAbi, err := abi.JSON(strings.NewReader(erc20.IERC20MetaData.ABI))
 	if err != nil {
 		return nil, fmt.Errorf("failed to load ethTokenAbi: %w", err)
@@ -189,7 +198,7 @@ func (c *Client) TransferToken(ctx context.Context, r *defi.TransferReq) (*defi.
 		return nil, fmt.Errorf("failed to pack withdraw function: %w", err)
 	}
 	tx := CreateFunctionCallTransaction(
-		w.Address(),
+		wtx.WalletAddr,
 		to,
 		big.NewInt(0),
 		big.NewInt(0),
@@ -198,7 +207,7 @@ func (c *Client) TransferToken(ctx context.Context, r *defi.TransferReq) (*defi.
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, r.Gas, wtx.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, r.Gas, wtx.Signer, r.EstimateOnly)
 	if err != nil {
 		return nil, errors.Wrap(err, "Make712Tx")
 	}
@@ -212,7 +221,7 @@ func (c *Client) TransferToken(ctx context.Context, r *defi.TransferReq) (*defi.
 		return res, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -231,13 +240,8 @@ func (c *Client) TransferMainToken(ctx context.Context, r *defi.TransferReq) (*d
 		return nil, errors.Wrap(err, "newWalletTransactor")
 	}
 
-	w, err := accounts.NewWallet(wtx.PKb, &c.ClientL2, c.ClientL1)
-	if err != nil {
-		return nil, errors.Wrap(err, "zksync2.NewWallet")
-	}
-
 	tx := CreateFunctionCallTransaction(
-		w.Address(),
+		wtx.WalletAddr,
 		r.ToAddr,
 		big.NewInt(0),
 		big.NewInt(0),
@@ -246,7 +250,7 @@ func (c *Client) TransferMainToken(ctx context.Context, r *defi.TransferReq) (*d
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, r.Gas, wtx.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, r.Gas, wtx.Signer, r.EstimateOnly)
 	if err != nil {
 		return nil, errors.Wrap(err, "Make712Tx")
 	}
@@ -266,7 +270,7 @@ func (c *Client) TransferMainToken(ctx context.Context, r *defi.TransferReq) (*d
 		return res, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
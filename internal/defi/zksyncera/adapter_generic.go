@@ -6,6 +6,7 @@ import (
 
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/pkg/errors"
 )
 
@@ -17,6 +18,20 @@ type TxBridgeMaker interface {
 	MakeBridgeTx(ctx context.Context, req *defi.DefaultBridgeReq) (*bozdo.TxData, error)
 }
 
+type TxLendMaker interface {
+	MakeLendTx(ctx context.Context, req *defi.LendReq, taskType v1.TaskType) (*bozdo.TxData, error)
+}
+
+type TxDomainMaker interface {
+	MakeDomainTx(ctx context.Context, req *defi.MintDomainReq) (*bozdo.TxData, error)
+}
+
+type TxLiquidityMaker interface {
+	MakeAddLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error)
+	MakeRemoveLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error)
+	Position(ctx context.Context, req *LiquidityPositionReq) (*LiquidityPosition, error)
+}
+
 func (c *Client) GenericSwap(ctx context.Context, maker TxSwapMaker, req *defi.DefaultSwapReq) (*bozdo.DefaultRes, error) {
 	result := &bozdo.DefaultRes{}
 
@@ -30,16 +45,23 @@ func (c *Client) GenericSwap(ctx context.Context, maker TxSwapMaker, req *defi.D
 		return nil, errors.Wrap(err, "makeSpaceFiSwapData")
 	}
 
-	tokenLimitChecker, err := c.TokenLimitChecker(ctx, &TokenLimitCheckerReq{
-		Token:       req.FromToken,
-		WalletPK:    req.WalletPK,
-		Amount:      req.Amount,
-		SpenderAddr: txData.ContractAddr,
-	})
+	txData, permitted, err := c.permitSwap(ctx, maker, req, txData)
 	if err != nil {
-		return nil, errors.Wrap(err, "TokenLimitChecker")
+		return nil, errors.Wrap(err, "permitSwap")
+	}
+
+	if !permitted {
+		tokenLimitChecker, err := c.TokenLimitChecker(ctx, &TokenLimitCheckerReq{
+			Token:       req.FromToken,
+			WalletPK:    req.WalletPK,
+			Amount:      req.Amount,
+			SpenderAddr: txData.ContractAddr,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "TokenLimitChecker")
+		}
+		result.ApproveTx = tokenLimitChecker.ApproveTx
 	}
-	result.ApproveTx = tokenLimitChecker.ApproveTx
 
 	tx := CreateFunctionCallTransaction(
 		transactor.WalletAddr,
@@ -51,18 +73,31 @@ func (c *Client) GenericSwap(ctx context.Context, maker TxSwapMaker, req *defi.D
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer)
+	spent := big.NewInt(0)
+	if req.FeeToken != nil && *req.FeeToken == req.FromToken {
+		spent = req.Amount
+	}
+	paymasterFee, err := c.usePaymaster(ctx, tx, req.FeeToken, spent)
+	if err != nil {
+		return nil, errors.Wrap(err, "usePaymaster")
+	}
+
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, errors.Wrap(err, "Make712Tx")
 	}
 
+	if paymasterFee != nil {
+		estimate.Details = append(estimate.Details, *paymasterFee)
+		txData.Details = append(txData.Details, *paymasterFee)
+	}
 	result.ECost = estimate
 
 	if req.EstimateOnly {
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -105,7 +140,170 @@ func (c *Client) GenericBridge(ctx context.Context, maker TxBridgeMaker, req *de
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "Make712Tx")
+	}
+
+	result.ECost = estimate
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	hash, err := c.sendRawTx(ctx, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
+	}
+
+	result.Tx = c.NewTx(hash, defi.CodeContract, txData.Details)
+
+	return result, nil
+}
+
+func (c *Client) GenericLend(ctx context.Context, maker TxLendMaker, req *defi.LendReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
+	result := &bozdo.DefaultRes{}
+
+	transactor, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := maker.MakeLendTx(ctx, req, taskType)
+	if err != nil {
+		return nil, errors.Wrap(err, "MakeLendTx")
+	}
+
+	if taskType == v1.TaskType_LendSupply {
+		tokenLimitChecker, err := c.TokenLimitChecker(ctx, &TokenLimitCheckerReq{
+			Token:       req.Token,
+			WalletPK:    req.WalletPK,
+			Amount:      req.Amount,
+			SpenderAddr: txData.ContractAddr,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "TokenLimitChecker")
+		}
+		result.ApproveTx = tokenLimitChecker.ApproveTx
+	}
+
+	tx := CreateFunctionCallTransaction(
+		transactor.WalletAddr,
+		txData.ContractAddr,
+		big.NewInt(0),
+		big.NewInt(0),
+		txData.Value,
+		txData.Data,
+		nil, nil,
+	)
+
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "Make712Tx")
+	}
+
+	result.ECost = estimate
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	hash, err := c.sendRawTx(ctx, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
+	}
+
+	result.Tx = c.NewTx(hash, defi.CodeLend, txData.Details)
+
+	return result, nil
+}
+
+func (c *Client) GenericDomain(ctx context.Context, maker TxDomainMaker, req *defi.MintDomainReq) (*bozdo.DefaultRes, error) {
+	result := &bozdo.DefaultRes{}
+
+	transactor, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := maker.MakeDomainTx(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "MakeDomainTx")
+	}
+
+	tx := CreateFunctionCallTransaction(
+		transactor.WalletAddr,
+		txData.ContractAddr,
+		big.NewInt(0),
+		big.NewInt(0),
+		txData.Value,
+		txData.Data,
+		nil, nil,
+	)
+
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "Make712Tx")
+	}
+
+	result.ECost = estimate
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	hash, err := c.sendRawTx(ctx, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
+	}
+
+	result.Tx = c.NewTx(hash, defi.CodeContract, txData.Details)
+
+	return result, nil
+}
+
+func (c *Client) GenericLiquidity(ctx context.Context, maker TxLiquidityMaker, req *LiquidityReq) (*LiquidityRes, error) {
+	result := &LiquidityRes{}
+
+	transactor, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	var txData *LiquidityTxData
+	if req.Add {
+		txData, err = maker.MakeAddLiquidityTx(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "MakeAddLiquidityTx")
+		}
+	} else {
+		txData, err = maker.MakeRemoveLiquidityTx(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "MakeRemoveLiquidityTx")
+		}
+	}
+
+	for i := range txData.Approves {
+		approveTx, err := c.approveLiquidity(ctx, transactor, &txData.Approves[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "approveLiquidity")
+		}
+		if approveTx != nil {
+			result.ApproveTx = append(result.ApproveTx, approveTx)
+		}
+	}
+
+	tx := CreateFunctionCallTransaction(
+		transactor.WalletAddr,
+		txData.ContractAddr,
+		big.NewInt(0),
+		big.NewInt(0),
+		txData.Value,
+		txData.Data,
+		nil, nil,
+	)
+
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, errors.Wrap(err, "Make712Tx")
 	}
@@ -116,7 +314,7 @@ func (c *Client) GenericBridge(ctx context.Context, maker TxBridgeMaker, req *de
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -0,0 +1,97 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/pkg/errors"
+)
+
+// KnownSpenders contracts the swap, liquidity, lending and bridge tasks approve the tokens to
+func (c *Client) KnownSpenders() []defi.Spender {
+	s := []defi.Spender{
+		{Name: "SyncSwap router", Address: c.Cfg.SyncSwap.RouterSwap},
+		{Name: "SyncSwap paymaster", Address: syncSwapPaymaster},
+		{Name: "Mute.io router", Address: c.Cfg.Muteio.RouterSwap},
+		{Name: "Maverick router", Address: c.Cfg.Maverick.Router},
+		{Name: "SpaceFi router", Address: c.Cfg.SpaceFI.Router},
+		{Name: "Velocore router", Address: c.Cfg.Velocore.Router},
+		{Name: "iZUMi router", Address: c.Cfg.IZUMI.Router},
+		{Name: "iZUMi liquidity manager", Address: c.Cfg.IZUMI.LiquidityManager},
+		{Name: "VeSync router", Address: c.Cfg.VeSync.Router},
+		{Name: "Ezkalibur router", Address: c.Cfg.Ezkalibur.Router},
+		{Name: "ZkSwap router", Address: c.Cfg.ZkSwap.Router},
+		{Name: "PancakeSwap router", Address: pancakeRouter},
+		{Name: "Stargate router", Address: stargateRouter},
+	}
+	for token, market := range c.Cfg.EraLend.Markets {
+		s = append(s, defi.Spender{Name: "EraLend " + token.String(), Address: market})
+	}
+	for token, market := range c.Cfg.ReactorFusion.Markets {
+		s = append(s, defi.Spender{Name: "ReactorFusion " + token.String(), Address: market})
+	}
+	s = append(s, defi.TaskSpenders(c.Cfg.Network)...)
+	return defi.Spenders(s...)
+}
+
+func (c *Client) Approvals(ctx context.Context, req *defi.ApprovalsReq) (*defi.ApprovalsRes, error) {
+	return defi.MulticallApprovals(ctx, &defi.BalanceSource{
+		Caller:    c.ClientL2,
+		Multicall: multicall3Address,
+		Network:   c.Cfg.Network,
+		MainToken: c.Cfg.MainToken,
+		TokenMap:  c.Cfg.TokenMap,
+	}, c.KnownSpenders(), req)
+}
+
+// RevokeApproval sets the allowance of the token to the spender to zero
+func (c *Client) RevokeApproval(ctx context.Context, req *defi.RevokeApprovalReq) (*bozdo.DefaultRes, error) {
+	result := &bozdo.DefaultRes{}
+
+	addr, ok := c.Cfg.TokenMap[req.Token]
+	if !ok {
+		return nil, defi.ErrTokenNotSupportedFn(req.Token)
+	}
+
+	transactor, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := defi.RevokeData(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := CreateFunctionCallTransaction(
+		transactor.WalletAddr,
+		addr,
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		data,
+		nil, nil,
+	)
+
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "Make712Tx")
+	}
+	estimate.Name = "revoke approval"
+	result.ECost = estimate
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	hash, err := c.sendRawTx(ctx, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
+	}
+
+	result.Tx = c.NewTx(hash, defi.CodeApprove, nil)
+
+	return result, nil
+}
@@ -0,0 +1,39 @@
+package zksyncera
+
+import (
+	"context"
+
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+)
+
+type apiBridgeMaker struct {
+	source   *Client
+	taskType v1.TaskType
+}
+
+// Bridge across, hop and synapse bridges, calldata comes from their quote apis
+func (c *Client) Bridge(ctx context.Context, req *defi.DefaultBridgeReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
+	return c.GenericBridge(ctx, &apiBridgeMaker{source: c, taskType: taskType}, req)
+}
+
+func (m apiBridgeMaker) MakeBridgeTx(ctx context.Context, req *defi.DefaultBridgeReq) (*bozdo.TxData, error) {
+	c := m.source
+
+	wt, err := NewWalletTransactor(req.WalletPK, c.Cfg.networkId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := defi.MakeBridgeTx(ctx, m.taskType, req, c.Cfg.TokenMap, c.Cfg.MainToken, wt.WalletAddr)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Spender != bozdo.ZEROADDR {
+		if err := defi.RegisterSpender(ctx, c.Cfg.Network, defi.Spender{Name: m.taskType.String(), Address: tx.Spender}); err != nil {
+			return nil, err
+		}
+	}
+	return &tx.TxData, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	"github.com/hardstylez72/cry/internal/defi/zksyncera/scan"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/pkg/errors"
@@ -48,6 +49,18 @@ type Maverick struct {
 	Router common.Address
 }
 
+// CompoundMarkets compound v2 like lending: EraLend, ReactorFusion
+type CompoundMarkets struct {
+	Comptroller common.Address
+	Markets     map[v1.Token]common.Address
+}
+
+// NameServices domain registrars: zkSync Name Service (.zks) and Era Name Service (.era)
+type NameServices struct {
+	ZNS     common.Address
+	EraName common.Address
+}
+
 type SpaceFI struct {
 	Router common.Address
 }
@@ -57,8 +70,9 @@ type Velocore struct {
 }
 
 type IZUMI struct {
-	Router common.Address
-	Quoter common.Address
+	Router           common.Address
+	Quoter           common.Address
+	LiquidityManager common.Address
 }
 
 type VeSync struct {
@@ -91,6 +105,11 @@ type Config struct {
 	VeSync    VeSync
 	Ezkalibur Ezkalibur
 	ZkSwap    ZkSwap
+
+	EraLend       CompoundMarkets
+	ReactorFusion CompoundMarkets
+
+	NameServices NameServices
 }
 
 type ClientConfig struct {
@@ -151,6 +170,11 @@ func NewTestNetClient(c *ClientConfig) (*Client, error) {
 	ZkSwap := ZkSwap{
 		Router: common.HexToAddress(""),
 	}
+
+	eraLend := CompoundMarkets{}
+	reactorFusion := CompoundMarkets{}
+	nameServices := NameServices{}
+
 	return newClient(
 		c,
 		syncSwap,
@@ -166,6 +190,9 @@ func NewTestNetClient(c *ClientConfig) (*Client, error) {
 		vesync,
 		ezkalibur,
 		ZkSwap,
+		eraLend,
+		reactorFusion,
+		nameServices,
 	)
 }
 
@@ -208,8 +235,9 @@ func NewMainNetClient(c *ClientConfig) (*Client, error) {
 	}
 
 	izumi := IZUMI{
-		Router: common.HexToAddress("0x943ac2310D9BC703d6AB5e5e76876e212100f894"),
-		Quoter: common.HexToAddress("0x30C089574551516e5F1169C32C6D429C92bf3CD7"),
+		Router:           common.HexToAddress("0x943ac2310D9BC703d6AB5e5e76876e212100f894"),
+		Quoter:           common.HexToAddress("0x30C089574551516e5F1169C32C6D429C92bf3CD7"),
+		LiquidityManager: common.HexToAddress("0x936c9A1B8f88BFDbd5066ad08e5d773BC82EB15F"),
 	}
 
 	vesync := VeSync{
@@ -224,6 +252,29 @@ func NewMainNetClient(c *ClientConfig) (*Client, error) {
 		Router: common.HexToAddress("0x18381c0f738146Fb694DE18D1106BdE2BE040Fa4"),
 	}
 
+	// https://docs.eralend.com
+	eraLend := CompoundMarkets{
+		Comptroller: common.HexToAddress("0x0171cA5b372eb510245F5FA214F5582911934b3D"),
+		Markets: map[v1.Token]common.Address{
+			v1.Token_ETH:  common.HexToAddress("0x1BbD33384869b30A323e15868Ce46013C82B86FB"),
+			v1.Token_USDC: common.HexToAddress("0x1181D7BE04D80A8aE096641Ee1A87f7D557c6aeb"),
+		},
+	}
+
+	// https://docs.reactorfusion.xyz
+	reactorFusion := CompoundMarkets{
+		Comptroller: common.HexToAddress("0x23848c28Af1C3AA7B999fA57e6b6E8599C17F3f2"),
+		Markets: map[v1.Token]common.Address{
+			v1.Token_ETH:  common.HexToAddress("0xC5db68F30D21cBe0C9Eac7BE5eA83468d69297e6"),
+			v1.Token_USDC: common.HexToAddress("0x04e9Db37d8EA0760072e1aCE3F2A219988Fdac29"),
+		},
+	}
+
+	nameServices := NameServices{
+		ZNS:     common.HexToAddress("0xAE23B6E7f91DDeBD3B70d74d20583E3e674Bd94f"),
+		EraName: common.HexToAddress("0x935442AF47F3dc1c11F006D551E13769F12eab13"),
+	}
+
 	return newClient(
 		c,
 		syncSwap,
@@ -239,6 +290,9 @@ func NewMainNetClient(c *ClientConfig) (*Client, error) {
 		vesync,
 		ezkalibur,
 		ZkSwap,
+		eraLend,
+		reactorFusion,
+		nameServices,
 	)
 }
 
@@ -257,6 +311,9 @@ func newClient(
 	VeSync VeSync,
 	ezkalibur Ezkalibur,
 	ZkSwap ZkSwap,
+	eraLend CompoundMarkets,
+	reactorFusion CompoundMarkets,
+	nameServices NameServices,
 ) (*Client, error) {
 
 	config := &ClientConfig{
@@ -266,7 +323,8 @@ func newClient(
 		config = c
 	}
 
-	rpcL2Client, err := rpc.DialOptions(context.Background(), c.RPCEndpoint, rpc.WithHTTPClient(config.HttpCli))
+	httpCli := rpcpool.NewHTTPClient(config.HttpCli, c.RPCEndpoint, rpcpool.EVM)
+	rpcL2Client, err := rpc.DialOptions(context.Background(), rpcpool.Primary(c.RPCEndpoint), rpc.WithHTTPClient(httpCli))
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to connect to blockchain node: "+c.RPCEndpoint)
 	}
@@ -311,6 +369,11 @@ func newClient(
 			VeSync:    VeSync,
 			Ezkalibur: ezkalibur,
 			ZkSwap:    ZkSwap,
+
+			EraLend:       eraLend,
+			ReactorFusion: reactorFusion,
+
+			NameServices: nameServices,
 		},
 		scanner: scanner,
 	}, nil
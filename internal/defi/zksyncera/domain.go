@@ -0,0 +1,150 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/eraname"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/zns"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+type domainMaker struct {
+	*Client
+	taskType v1.TaskType
+}
+
+func (c *Client) MintDomain(ctx context.Context, req *defi.MintDomainReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
+	return c.GenericDomain(ctx, &domainMaker{Client: c, taskType: taskType}, req)
+}
+
+func (c *Client) DomainAvailable(ctx context.Context, name string, taskType v1.TaskType) (bool, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	registrar, err := c.domainRegistrar(taskType)
+	if err != nil {
+		return false, err
+	}
+
+	switch taskType {
+	case v1.TaskType_ZkSyncNameServiceMint:
+		caller, err := zns.NewStorageCaller(registrar, c.ClientL2)
+		if err != nil {
+			return false, errors.Wrap(err, "zns.NewStorageCaller")
+		}
+		// domain nft id is keccak256 of the name, ownerOf reverts for not minted ones
+		owner, err := caller.OwnerOf(opt, new(big.Int).SetBytes(crypto.Keccak256([]byte(name))))
+		if err != nil {
+			if strings.Contains(err.Error(), "revert") {
+				return true, nil
+			}
+			return false, errors.Wrap(err, "zns.OwnerOf")
+		}
+		return owner == ZEROADDR, nil
+	case v1.TaskType_EraNameMint:
+		caller, err := eraname.NewStorageCaller(registrar, c.ClientL2)
+		if err != nil {
+			return false, errors.Wrap(err, "eraname.NewStorageCaller")
+		}
+		owner, err := caller.Domains(opt, name)
+		if err != nil {
+			return false, errors.Wrap(err, "eraname.Domains")
+		}
+		return owner == ZEROADDR, nil
+	default:
+		return false, errors.New("unsupported domain task type: " + taskType.String())
+	}
+}
+
+func (c *Client) DomainPrice(ctx context.Context, taskType v1.TaskType) (*big.Int, error) {
+	registrar, err := c.domainRegistrar(taskType)
+	if err != nil {
+		return nil, err
+	}
+	if taskType != v1.TaskType_EraNameMint {
+		return big.NewInt(0), nil
+	}
+
+	caller, err := eraname.NewStorageCaller(registrar, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "eraname.NewStorageCaller")
+	}
+	price, err := caller.Price(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, errors.Wrap(err, "eraname.Price")
+	}
+	return price, nil
+}
+
+func (c *Client) domainRegistrar(taskType v1.TaskType) (common.Address, error) {
+	var registrar common.Address
+	switch taskType {
+	case v1.TaskType_ZkSyncNameServiceMint:
+		registrar = c.Cfg.NameServices.ZNS
+	case v1.TaskType_EraNameMint:
+		registrar = c.Cfg.NameServices.EraName
+	default:
+		return common.Address{}, errors.New("unsupported domain task type: " + taskType.String())
+	}
+	if registrar == (common.Address{}) {
+		return common.Address{}, errors.New(taskType.String() + " is not supported in network: " + c.Cfg.Network.String())
+	}
+	return registrar, nil
+}
+
+func (c *domainMaker) MakeDomainTx(ctx context.Context, req *defi.MintDomainReq) (*bozdo.TxData, error) {
+	registrar, err := c.domainRegistrar(c.taskType)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0)
+	var data []byte
+
+	switch c.taskType {
+	case v1.TaskType_ZkSyncNameServiceMint:
+		znsAbi, err := zns.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err = znsAbi.Pack("register", w.WalletAddr, req.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "znsAbi.Pack")
+		}
+	case v1.TaskType_EraNameMint:
+		caller, err := eraname.NewStorageCaller(registrar, c.ClientL2)
+		if err != nil {
+			return nil, errors.Wrap(err, "eraname.NewStorageCaller")
+		}
+		value, err = caller.Price(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return nil, errors.Wrap(err, "eraname.Price")
+		}
+		eraAbi, err := eraname.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err = eraAbi.Pack("Register", req.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "eraAbi.Pack")
+		}
+	}
+
+	return &bozdo.TxData{
+		Data:         data,
+		Value:        value,
+		ContractAddr: registrar,
+	}, nil
+}
@@ -6,11 +6,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hardstylez72/cry/internal/defi"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 )
 
 type LP interface {
 	GetSyncSwapPool(ctx context.Context, req *GetSyncSwapPoolReq) (*common.Address, error)
 	SyncSwapLiquidity(ctx context.Context, req *SyncSwapLiquidityReq) (*SyncSwapLiquidityRes, error)
 	SyncSwapLPBalance(ctx context.Context, pool, user common.Address) (*big.Int, error)
+	Liquidity(ctx context.Context, req *LiquidityReq, taskType v1.TaskType) (*LiquidityRes, error)
+	LiquidityPosition(ctx context.Context, req *LiquidityPositionReq, taskType v1.TaskType) (*LiquidityPosition, error)
 	defi.Networker
 }
@@ -0,0 +1,325 @@
+package zksyncera
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/izumiliquiditymanager"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/izumipool"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// izumiLPFee fee tier of the pools used for swaps as well
+	izumiLPFee = 2000
+	// izumiLPRange position range is the current point +- izumiLPRange point deltas
+	izumiLPRange = 20
+	// izumiPositionLookup how many latest positions of the wallet are checked to find the pool position
+	izumiPositionLookup = 20
+)
+
+var maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+type izumiPair struct {
+	X, Y   common.Address
+	XToken v1.Token
+	YToken v1.Token
+	Pool   common.Address
+}
+
+// pair iZUMi pools are keyed by tokenX < tokenY
+func (c *izumiMaker) pair(ctx context.Context, a, b v1.Token) (*izumiPair, error) {
+	addrA, addrB, err := c.liquidityTokens(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &izumiPair{X: addrA, Y: addrB, XToken: a, YToken: b}
+	if bytes.Compare(addrA.Bytes(), addrB.Bytes()) > 0 {
+		p = &izumiPair{X: addrB, Y: addrA, XToken: b, YToken: a}
+	}
+
+	caller, err := izumiliquiditymanager.NewStorageCaller(c.Cfg.IZUMI.LiquidityManager, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumiliquiditymanager.NewStorageCaller")
+	}
+	p.Pool, err = caller.Pool(&bind.CallOpts{Context: ctx}, p.X, p.Y, big.NewInt(izumiLPFee))
+	if err != nil {
+		return nil, errors.Wrap(err, "izumiliquiditymanager.Pool")
+	}
+	if p.Pool == ZEROADDR {
+		return nil, errors.New("iZUMi pool not found: " + a.String() + "-" + b.String())
+	}
+	return p, nil
+}
+
+func (c *izumiMaker) Position(ctx context.Context, req *LiquidityPositionReq) (*LiquidityPosition, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	p, err := c.pair(ctx, req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := izumiliquiditymanager.NewStorageCaller(c.Cfg.IZUMI.LiquidityManager, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumiliquiditymanager.NewStorageCaller")
+	}
+
+	if req.PositionId != nil {
+		l, err := caller.Liquidities(opt, req.PositionId)
+		if err != nil {
+			return nil, errors.Wrap(err, "izumiliquiditymanager.Liquidities")
+		}
+		return &LiquidityPosition{Pool: p.Pool, PositionId: req.PositionId, Liquidity: l.Liquidity}, nil
+	}
+
+	balance, err := caller.BalanceOf(opt, req.WalletAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumiliquiditymanager.BalanceOf")
+	}
+
+	for i := balance.Int64() - 1; i >= 0 && i >= balance.Int64()-izumiPositionLookup; i-- {
+		id, err := caller.TokenOfOwnerByIndex(opt, req.WalletAddr, big.NewInt(i))
+		if err != nil {
+			return nil, errors.Wrap(err, "izumiliquiditymanager.TokenOfOwnerByIndex")
+		}
+		l, err := caller.Liquidities(opt, id)
+		if err != nil {
+			return nil, errors.Wrap(err, "izumiliquiditymanager.Liquidities")
+		}
+		meta, err := caller.PoolMetas(opt, l.PoolId)
+		if err != nil {
+			return nil, errors.Wrap(err, "izumiliquiditymanager.PoolMetas")
+		}
+		if meta.TokenX == p.X && meta.TokenY == p.Y && meta.Fee.Int64() == izumiLPFee {
+			return &LiquidityPosition{Pool: p.Pool, PositionId: id, Liquidity: l.Liquidity}, nil
+		}
+	}
+
+	return &LiquidityPosition{Pool: p.Pool, Liquidity: big.NewInt(0)}, nil
+}
+
+func (c *izumiMaker) MakeAddLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error) {
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	p, err := c.pair(ctx, req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &bind.CallOpts{Context: ctx}
+	pool, err := izumipool.NewStorageCaller(p.Pool, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumipool.NewStorageCaller")
+	}
+	state, err := pool.State(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumipool.State")
+	}
+	delta, err := pool.PointDelta(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumipool.PointDelta")
+	}
+
+	current := state.CurrentPoint.Int64()
+	d := delta.Int64()
+	base := int64(math.Floor(float64(current)/float64(d))) * d
+	pl := base - izumiLPRange*d
+	pr := base + (izumiLPRange+1)*d
+
+	x1, y1 := izumiAmounts(1, pl, pr, current)
+	if x1 == 0 || y1 == 0 {
+		return nil, errors.New("iZUMi pool point is out of the position range")
+	}
+
+	amountX, amountY := req.Amount, req.Amount
+	if p.XToken == req.A {
+		amountY, _ = new(big.Float).Mul(new(big.Float).SetInt(req.Amount), big.NewFloat(y1/x1)).Int(nil)
+	} else {
+		amountX, _ = new(big.Float).Mul(new(big.Float).SetInt(req.Amount), big.NewFloat(x1/y1)).Int(nil)
+	}
+
+	minX, err := defi.Slippage(amountX, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+	minY, err := defi.Slippage(amountY, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+
+	managerAbi, err := izumiliquiditymanager.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	mint, err := managerAbi.Pack("mint", izumiliquiditymanager.LiquidityManagerMintParam{
+		Miner:      w.WalletAddr,
+		TokenX:     p.X,
+		TokenY:     p.Y,
+		Fee:        big.NewInt(izumiLPFee),
+		Pl:         big.NewInt(pl),
+		Pr:         big.NewInt(pr),
+		XLim:       amountX,
+		YLim:       amountY,
+		AmountXMin: minX,
+		AmountYMin: minY,
+		Deadline:   liquidityDeadline(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0)
+	calls := [][]byte{mint}
+	if p.XToken == c.Cfg.MainToken || p.YToken == c.Cfg.MainToken {
+		value = amountX
+		if p.YToken == c.Cfg.MainToken {
+			value = amountY
+		}
+		refund, err := managerAbi.Pack("refundETH")
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, refund)
+	}
+
+	data, err := managerAbi.Pack("multicall", calls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiquidityTxData{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        value,
+			ContractAddr: c.Cfg.IZUMI.LiquidityManager,
+		},
+		Approves: c.liquidityApproves(c.Cfg.IZUMI.LiquidityManager, []v1.Token{p.XToken, p.YToken}, []*big.Int{amountX, amountY}),
+	}, nil
+}
+
+func (c *izumiMaker) MakeRemoveLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error) {
+	if err := checkLiquidityPosition(req); err != nil {
+		return nil, err
+	}
+	if req.Position.PositionId == nil {
+		return nil, errors.New("iZUMi position id is not specified")
+	}
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	p, err := c.pair(ctx, req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &bind.CallOpts{Context: ctx}
+	manager, err := izumiliquiditymanager.NewStorageCaller(c.Cfg.IZUMI.LiquidityManager, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumiliquiditymanager.NewStorageCaller")
+	}
+	position, err := manager.Liquidities(opt, req.Position.PositionId)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumiliquiditymanager.Liquidities")
+	}
+	pool, err := izumipool.NewStorageCaller(p.Pool, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumipool.NewStorageCaller")
+	}
+	state, err := pool.State(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "izumipool.State")
+	}
+
+	liquidity, _ := new(big.Float).SetInt(req.Amount).Float64()
+	x, y := izumiAmounts(liquidity, position.LeftPt.Int64(), position.RightPt.Int64(), state.CurrentPoint.Int64())
+	amountX, _ := big.NewFloat(x).Int(nil)
+	amountY, _ := big.NewFloat(y).Int(nil)
+
+	minX, err := defi.Slippage(amountX, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+	minY, err := defi.Slippage(amountY, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+
+	managerAbi, err := izumiliquiditymanager.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := managerAbi.Pack("decLiquidity", req.Position.PositionId, req.Amount, minX, minY, liquidityDeadline())
+	if err != nil {
+		return nil, err
+	}
+	calls := [][]byte{dec}
+
+	// eth side is collected by the manager, unwrapped and sent with the other token to the wallet
+	if p.XToken == c.Cfg.MainToken || p.YToken == c.Cfg.MainToken {
+		other := p.X
+		if p.XToken == c.Cfg.MainToken {
+			other = p.Y
+		}
+		collect, err := managerAbi.Pack("collect", ZEROADDR, req.Position.PositionId, maxUint128, maxUint128)
+		if err != nil {
+			return nil, err
+		}
+		unwrap, err := managerAbi.Pack("unwrapWETH9", big.NewInt(0), w.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+		sweep, err := managerAbi.Pack("sweepToken", other, big.NewInt(0), w.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, collect, unwrap, sweep)
+	} else {
+		collect, err := managerAbi.Pack("collect", w.WalletAddr, req.Position.PositionId, maxUint128, maxUint128)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, collect)
+	}
+
+	data, err := managerAbi.Pack("multicall", calls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiquidityTxData{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        big.NewInt(0),
+			ContractAddr: c.Cfg.IZUMI.LiquidityManager,
+		},
+	}, nil
+}
+
+// izumiAmounts token amounts of the liquidity in range [pl, pr) at the current point, price is 1.0001^point
+func izumiAmounts(liquidity float64, pl, pr, current int64) (float64, float64) {
+	sqrtP := math.Pow(1.0001, float64(current)/2)
+	sqrtPl := math.Pow(1.0001, float64(pl)/2)
+	sqrtPr := math.Pow(1.0001, float64(pr)/2)
+
+	switch {
+	case current < pl:
+		return liquidity * (1/sqrtPl - 1/sqrtPr), 0
+	case current >= pr:
+		return 0, liquidity * (sqrtPr - sqrtPl)
+	default:
+		return liquidity * (1/sqrtP - 1/sqrtPr), liquidity * (sqrtP - sqrtPl)
+	}
+}
@@ -0,0 +1,22 @@
+package zksyncera
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_izumiAmounts(t *testing.T) {
+	x, y := izumiAmounts(1000, -100, 100, 0)
+	assert.Greater(t, x, float64(0))
+	assert.Greater(t, y, float64(0))
+	assert.InDelta(t, x, y, 0.01)
+
+	x, y = izumiAmounts(1000, 100, 200, 0)
+	assert.Greater(t, x, float64(0))
+	assert.Equal(t, float64(0), y)
+
+	x, y = izumiAmounts(1000, -200, -100, 0)
+	assert.Equal(t, float64(0), x)
+	assert.Greater(t, y, float64(0))
+}
@@ -0,0 +1,100 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/bridge/layerzero"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// LayerZeroBridge sends tokens of any oft or onft contract to the same wallet in the destination chain
+func (c *Client) LayerZeroBridge(ctx context.Context, req *defi.LayerZeroBridgeReq) (*bozdo.DefaultRes, error) {
+	result := &bozdo.DefaultRes{}
+
+	transactor, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+
+	sendReq := &layerzero.SendReq{
+		Kind:       req.Kind,
+		Contract:   req.Contract,
+		DstChainId: req.DstChainId,
+		Wallet:     transactor.WalletAddr,
+		Network:    c.Cfg.Network,
+		MainToken:  c.Cfg.MainToken,
+		TokenId:    req.TokenId,
+	}
+
+	if req.Kind == v1.LayerZeroContract_ONFT {
+		if sendReq.TokenId == nil {
+			sendReq.TokenId, err = layerzero.LastONFT(ctx, c.ClientL2, req.Contract, transactor.WalletAddr)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		balance, err := layerzero.OFTBalance(ctx, c.ClientL2, req.Kind, req.Contract, transactor.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+		sendReq.Amount, err = defi.ResolveAmount(req.Amount, balance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txData, err := layerzero.MakeSendTx(ctx, c.ClientL2, sendReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "layerzero.MakeSendTx")
+	}
+
+	if txData.Approve != nil {
+		if err := defi.RegisterSpender(ctx, c.Cfg.Network, defi.Spender{Name: "LayerZero OFT adapter", Address: txData.Approve.Spender}); err != nil {
+			return nil, err
+		}
+		approveTx, err := c.approveLiquidity(ctx, transactor, &LiquidityApprove{
+			Token:   txData.Approve.Token,
+			Spender: txData.Approve.Spender,
+			Amount:  txData.Approve.Amount,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "approveLiquidity")
+		}
+		result.ApproveTx = approveTx
+	}
+
+	tx := CreateFunctionCallTransaction(
+		transactor.WalletAddr,
+		txData.ContractAddr,
+		big.NewInt(0),
+		big.NewInt(0),
+		txData.Value,
+		txData.Data,
+		nil, nil,
+	)
+
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
+	if err != nil {
+		return nil, errors.Wrap(err, "Make712Tx")
+	}
+
+	result.ECost = estimate
+
+	if req.EstimateOnly {
+		return result, nil
+	}
+
+	hash, err := c.sendRawTx(ctx, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
+	}
+
+	result.Tx = c.NewTx(hash, defi.CodeContract, txData.Details)
+
+	return result, nil
+}
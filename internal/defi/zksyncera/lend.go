@@ -0,0 +1,179 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/compound/comptroller"
+	"github.com/hardstylez72/cry/internal/defi/contracts/compound/ctoken"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// compoundSafetyPercent withdrawal is allowed only if redeeming this percent of the amount keeps the account without shortfall
+const compoundSafetyPercent = 150
+
+var expScale = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+type compoundMaker struct {
+	*Client
+	markets *CompoundMarkets
+}
+
+func (c *Client) Lend(ctx context.Context, req *defi.LendReq, taskType v1.TaskType) (*bozdo.DefaultRes, error) {
+	markets, err := c.lendMarkets(req.Protocol)
+	if err != nil {
+		return nil, err
+	}
+	return c.GenericLend(ctx, &compoundMaker{Client: c, markets: markets}, req, taskType)
+}
+
+func (c *Client) LendWithdrawable(ctx context.Context, req *defi.LendWithdrawableReq) (*big.Int, error) {
+	markets, err := c.lendMarkets(req.Protocol)
+	if err != nil {
+		return nil, err
+	}
+	return (&compoundMaker{Client: c, markets: markets}).withdrawable(ctx, req.Token, req.WalletAddress)
+}
+
+func (c *Client) lendMarkets(protocol v1.LendProtocol) (*CompoundMarkets, error) {
+	var markets *CompoundMarkets
+	switch protocol {
+	case v1.LendProtocol_EraLend:
+		markets = &c.Cfg.EraLend
+	case v1.LendProtocol_ReactorFusion:
+		markets = &c.Cfg.ReactorFusion
+	default:
+		return nil, errors.New("unsupported lend protocol: " + protocol.String())
+	}
+	if markets.Comptroller == (common.Address{}) {
+		return nil, errors.New(protocol.String() + " is not supported in network: " + c.Cfg.Network.String())
+	}
+	return markets, nil
+}
+
+func (c *compoundMaker) market(token v1.Token) (common.Address, error) {
+	market, ok := c.markets.Markets[token]
+	if !ok {
+		return common.Address{}, defi.ErrTokenNotSupportedFn(token)
+	}
+	return market, nil
+}
+
+func (c *compoundMaker) MakeLendTx(ctx context.Context, req *defi.LendReq, taskType v1.TaskType) (*bozdo.TxData, error) {
+	market, err := c.market(req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctokenAbi, err := ctoken.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0)
+	var data []byte
+
+	switch taskType {
+	case v1.TaskType_LendSupply:
+		if req.Token == c.Cfg.MainToken {
+			value = req.Amount
+			data, err = ctokenAbi.Pack("mint")
+		} else {
+			data, err = ctokenAbi.Pack("mint0", req.Amount)
+		}
+	case v1.TaskType_LendWithdraw:
+		if err := c.checkWithdrawable(ctx, req); err != nil {
+			return nil, err
+		}
+		data, err = ctokenAbi.Pack("redeemUnderlying", req.Amount)
+	default:
+		return nil, errors.New("unsupported task type: " + taskType.String())
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "ctokenAbi.Pack")
+	}
+
+	return &bozdo.TxData{
+		Data:         data,
+		Value:        value,
+		ContractAddr: market,
+	}, nil
+}
+
+func (c *compoundMaker) checkWithdrawable(ctx context.Context, req *defi.LendReq) error {
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return err
+	}
+	withdrawable, err := c.withdrawable(ctx, req.Token, w.WalletAddr)
+	if err != nil {
+		return err
+	}
+	if req.Amount.Cmp(withdrawable) > 0 {
+		return errors.New("amount exceeds health factor safe withdrawal of " + withdrawable.String())
+	}
+	return nil
+}
+
+// withdrawable supplied underlying amount; for markets used as collateral it is halved until redeeming stays without shortfall
+func (c *compoundMaker) withdrawable(ctx context.Context, token v1.Token, user common.Address) (*big.Int, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	market, err := c.market(token)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := ctoken.NewStorageCaller(market, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "ctoken.NewStorageCaller")
+	}
+	balance, err := caller.BalanceOf(opt, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "ctoken.BalanceOf")
+	}
+	rate, err := caller.ExchangeRateStored(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "ctoken.ExchangeRateStored")
+	}
+	if rate.Sign() == 0 {
+		return big.NewInt(0), nil
+	}
+
+	amount := new(big.Int).Mul(balance, rate)
+	amount.Div(amount, expScale)
+
+	ctrl, err := comptroller.NewStorageCaller(c.markets.Comptroller, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "comptroller.NewStorageCaller")
+	}
+	member, err := ctrl.CheckMembership(opt, user, market)
+	if err != nil {
+		return nil, errors.Wrap(err, "comptroller.CheckMembership")
+	}
+	if !member {
+		return amount, nil
+	}
+
+	for i := 0; i < 8 && amount.Sign() > 0; i++ {
+		redeemTokens := new(big.Int).Mul(amount, big.NewInt(compoundSafetyPercent))
+		redeemTokens.Mul(redeemTokens, expScale)
+		redeemTokens.Div(redeemTokens, new(big.Int).Mul(rate, big.NewInt(100)))
+
+		code, _, shortfall, err := ctrl.GetHypotheticalAccountLiquidity(opt, user, market, redeemTokens, big.NewInt(0))
+		if err != nil {
+			return nil, errors.Wrap(err, "comptroller.GetHypotheticalAccountLiquidity")
+		}
+		if code.Sign() == 0 && shortfall.Sign() == 0 {
+			return amount, nil
+		}
+		amount = new(big.Int).Div(amount, big.NewInt(2))
+	}
+
+	return big.NewInt(0), nil
+}
@@ -0,0 +1,310 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_721"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/univ2pair"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+type LiquidityReq struct {
+	A      v1.Token
+	B      v1.Token
+	Stable bool
+	Add    bool
+	// Amount add: amount of token A, remove: liquidity of the position
+	Amount *big.Int
+	// Position remove only
+	Position *LiquidityPosition
+	WalletPK string
+	Slippage defi.SlippagePercent
+
+	EstimateOnly bool
+	Gas          *bozdo.Gas
+}
+
+type LiquidityRes struct {
+	Tx        *bozdo.Transaction
+	ApproveTx []*bozdo.Transaction
+	ECost     *bozdo.EstimatedGasCost
+}
+
+type LiquidityPositionReq struct {
+	A          v1.Token
+	B          v1.Token
+	Stable     bool
+	WalletAddr common.Address
+	// PositionId, BinId known position of range pools, the latest one is used if empty
+	PositionId *big.Int
+	BinId      *big.Int
+}
+
+// LiquidityPosition lp token balance of the pool or liquidity of the range position (nft)
+type LiquidityPosition struct {
+	Pool       common.Address
+	PositionId *big.Int
+	BinId      *big.Int
+	Liquidity  *big.Int
+}
+
+type LiquidityApprove struct {
+	Token   common.Address
+	Spender common.Address
+	Amount  *big.Int
+	// TokenId position nft is approved instead of erc20 amount
+	TokenId *big.Int
+}
+
+type LiquidityTxData struct {
+	bozdo.TxData
+	Approves []LiquidityApprove
+}
+
+func (c *Client) Liquidity(ctx context.Context, req *LiquidityReq, taskType v1.TaskType) (*LiquidityRes, error) {
+	maker, err := c.liquidityMaker(taskType)
+	if err != nil {
+		return nil, err
+	}
+	return c.GenericLiquidity(ctx, maker, req)
+}
+
+func (c *Client) LiquidityPosition(ctx context.Context, req *LiquidityPositionReq, taskType v1.TaskType) (*LiquidityPosition, error) {
+	maker, err := c.liquidityMaker(taskType)
+	if err != nil {
+		return nil, err
+	}
+	return maker.Position(ctx, req)
+}
+
+func (c *Client) liquidityMaker(taskType v1.TaskType) (TxLiquidityMaker, error) {
+	switch taskType {
+	case v1.TaskType_MuteioLP:
+		return &muteioMaker{c}, nil
+	case v1.TaskType_SpaceFiLP:
+		return &spaceFiMaker{c}, nil
+	case v1.TaskType_VelocoreLP:
+		return &velocoreMaker{c}, nil
+	case v1.TaskType_IzumiLP:
+		return &izumiMaker{c}, nil
+	case v1.TaskType_MaverickLP:
+		return &maverickFiMaker{c}, nil
+	default:
+		return nil, errors.New("unsupported liquidity task type: " + taskType.String())
+	}
+}
+
+func (c *Client) liquidityTokens(a, b v1.Token) (common.Address, common.Address, error) {
+	addrA, supported := c.Cfg.TokenMap[a]
+	if !supported {
+		return common.Address{}, common.Address{}, defi.ErrTokenNotSupportedFn(a)
+	}
+	addrB, supported := c.Cfg.TokenMap[b]
+	if !supported {
+		return common.Address{}, common.Address{}, defi.ErrTokenNotSupportedFn(b)
+	}
+	if a == b {
+		return common.Address{}, common.Address{}, errors.New("pair of the same token: " + a.String())
+	}
+	return addrA, addrB, nil
+}
+
+// liquidityApproves erc20 approvals of the pair tokens, native token needs none
+func (c *Client) liquidityApproves(spender common.Address, tokens []v1.Token, amounts []*big.Int) []LiquidityApprove {
+	approves := make([]LiquidityApprove, 0, len(tokens))
+	for i, token := range tokens {
+		if token == c.Cfg.MainToken || amounts[i].Sign() == 0 {
+			continue
+		}
+		approves = append(approves, LiquidityApprove{
+			Token:   c.Cfg.TokenMap[token],
+			Spender: spender,
+			Amount:  amounts[i],
+		})
+	}
+	return approves
+}
+
+// approveLiquidity approves erc20 amount or position nft to the spender if it is not approved yet
+func (c *Client) approveLiquidity(ctx context.Context, w *WalletTransactor, req *LiquidityApprove) (*bozdo.Transaction, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	var data []byte
+	if req.TokenId != nil {
+		caller, err := erc_721.NewStorageCaller(req.Token, c.ClientL2)
+		if err != nil {
+			return nil, errors.Wrap(err, "erc_721.NewStorageCaller")
+		}
+		approved, err := caller.GetApproved(opt, req.TokenId)
+		if err != nil {
+			return nil, errors.Wrap(err, "erc_721.GetApproved")
+		}
+		if approved == req.Spender {
+			return nil, nil
+		}
+		abi, err := erc_721.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err = abi.Pack("approve", req.Spender, req.TokenId)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		caller, err := erc_20.NewStorageCaller(req.Token, c.ClientL2)
+		if err != nil {
+			return nil, errors.Wrap(err, "erc_20.NewStorageCaller")
+		}
+		allowance, err := caller.Allowance(opt, w.WalletAddr, req.Spender)
+		if err != nil {
+			return nil, errors.Wrap(err, "erc_20.Allowance")
+		}
+		if allowance.Cmp(req.Amount) >= 0 {
+			return nil, nil
+		}
+		abi, err := erc_20.StorageMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		data, err = abi.Pack("approve", req.Spender, req.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	call := CreateFunctionCallTransaction(w.WalletAddr, req.Token, nil, big.NewInt(0), nil, data, nil, nil)
+
+	raw, _, err := c.Make712Tx(ctx, call, nil, w.Signer, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "Make712Tx")
+	}
+
+	hash, err := c.sendRawTx(ctx, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
+	}
+
+	if err := c.WaitTxComplete(ctx, hash.String()); err != nil {
+		return nil, err
+	}
+
+	return c.NewTx(hash, defi.CodeApprove, nil), nil
+}
+
+// univ2Reserves reserves of the pair ordered as tokenA, tokenB and lp token total supply
+func (c *Client) univ2Reserves(ctx context.Context, pair, tokenA common.Address) (*big.Int, *big.Int, *big.Int, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	caller, err := univ2pair.NewStorageCaller(pair, c.ClientL2)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "univ2pair.NewStorageCaller")
+	}
+	reserves, err := caller.GetReserves(opt)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "univ2pair.GetReserves")
+	}
+	token0, err := caller.Token0(opt)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "univ2pair.Token0")
+	}
+	totalSupply, err := caller.TotalSupply(opt)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "univ2pair.TotalSupply")
+	}
+
+	if token0 == tokenA {
+		return reserves.Reserve0, reserves.Reserve1, totalSupply, nil
+	}
+	return reserves.Reserve1, reserves.Reserve0, totalSupply, nil
+}
+
+// univ2AddAmounts amount of token B matching the pool ratio and min amounts of both tokens
+func (c *Client) univ2AddAmounts(ctx context.Context, pair, tokenA common.Address, req *LiquidityReq) (amountB, minA, minB *big.Int, err error) {
+	reserveA, reserveB, _, err := c.univ2Reserves(ctx, pair, tokenA)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if reserveA.Sign() == 0 || reserveB.Sign() == 0 {
+		return nil, nil, nil, errors.New("pool " + pair.String() + " has no liquidity")
+	}
+
+	amountB = new(big.Int).Mul(req.Amount, reserveB)
+	amountB.Div(amountB, reserveA)
+
+	minA, err = defi.Slippage(req.Amount, req.Slippage)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	minB, err = defi.Slippage(amountB, req.Slippage)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return amountB, minA, minB, nil
+}
+
+// univ2RemoveAmounts min amounts of the pair tokens withdrawn for the liquidity
+func (c *Client) univ2RemoveAmounts(ctx context.Context, pair, tokenA common.Address, req *LiquidityReq) (minA, minB *big.Int, err error) {
+	reserveA, reserveB, totalSupply, err := c.univ2Reserves(ctx, pair, tokenA)
+	if err != nil {
+		return nil, nil, err
+	}
+	if totalSupply.Sign() == 0 {
+		return nil, nil, errors.New("pool " + pair.String() + " has no liquidity")
+	}
+
+	amountA := new(big.Int).Mul(req.Amount, reserveA)
+	amountA.Div(amountA, totalSupply)
+	amountB := new(big.Int).Mul(req.Amount, reserveB)
+	amountB.Div(amountB, totalSupply)
+
+	minA, err = defi.Slippage(amountA, req.Slippage)
+	if err != nil {
+		return nil, nil, err
+	}
+	minB, err = defi.Slippage(amountB, req.Slippage)
+	if err != nil {
+		return nil, nil, err
+	}
+	return minA, minB, nil
+}
+
+// univ2Position lp token balance of the pair
+func (c *Client) univ2Position(ctx context.Context, pair, wallet common.Address) (*LiquidityPosition, error) {
+	if pair == ZEROADDR {
+		return nil, errors.New("pool not found")
+	}
+	caller, err := erc_20.NewStorageCaller(pair, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.NewStorageCaller")
+	}
+	balance, err := caller.BalanceOf(&bind.CallOpts{Context: ctx}, wallet)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc_20.BalanceOf")
+	}
+	return &LiquidityPosition{
+		Pool:      pair,
+		Liquidity: balance,
+	}, nil
+}
+
+func liquidityDeadline() *big.Int {
+	return new(big.Int).SetInt64(time.Now().Add(time.Minute * 5).Unix())
+}
+
+func checkLiquidityPosition(req *LiquidityReq) error {
+	if req.Position == nil {
+		return errors.New("liquidity position is not specified")
+	}
+	if req.Position.Liquidity != nil && req.Amount.Cmp(req.Position.Liquidity) > 0 {
+		return errors.New("amount exceeds position liquidity of " + req.Position.Liquidity.String())
+	}
+	return nil
+}
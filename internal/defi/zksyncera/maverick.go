@@ -184,3 +184,36 @@ type getMaverickSwapDataRes struct {
 	OutputAmount float64   `json:"outputAmount"`
 	Message      string    `json:"message"`
 }
+
+// WithPermit prepends selfPermit of the from token to the router multicall
+func (c *maverickFiMaker) WithPermit(txData *bozdo.TxData, permit *defi.Permit) (*bozdo.TxData, error) {
+	constractabi, err := maverickrouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := constractabi.Methods["multicall"].Inputs.Unpack(txData.Data[4:])
+	if err != nil {
+		return nil, errors.Wrap(err, "multicall unpack")
+	}
+	calls, ok := args[0].([][]byte)
+	if !ok {
+		return nil, errors.New("multicall: unexpected calls")
+	}
+
+	p0, err := constractabi.Pack("selfPermit", permit.Token, permit.Value, permit.Deadline, permit.V, permit.R, permit.S)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := constractabi.Pack("multicall", append([][]byte{p0}, calls...))
+	if err != nil {
+		return nil, err
+	}
+	return &bozdo.TxData{
+		Data:         data,
+		Value:        txData.Value,
+		ContractAddr: txData.ContractAddr,
+		Details:      txData.Details,
+	}, nil
+}
@@ -0,0 +1,311 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_721"
+	"github.com/hardstylez72/cry/internal/defi/contracts/maverickrouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/maverickpool"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+// maverickStaticKind liquidity is added to the static bin of the active tick
+const maverickStaticKind = 0
+
+// pool maverick api returns the swap path token -> pool -> token
+func (c *maverickFiMaker) pool(ctx context.Context, a, b v1.Token) (common.Address, error) {
+	addrA, addrB, err := c.liquidityTokens(a, b)
+	if err != nil {
+		return common.Address{}, err
+	}
+	d, err := getMaverickSwapData(ctx, addrA, addrB, "1", "0.1")
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "getMaverickSwapData")
+	}
+	return common.HexToAddress(d.Path[1]), nil
+}
+
+// activeBin static bin of the pool active tick
+func (c *maverickFiMaker) activeBin(ctx context.Context, pool *maverickpool.StorageCaller) (*big.Int, error) {
+	opt := &bind.CallOpts{Context: ctx}
+	state, err := pool.GetState(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.GetState")
+	}
+	binId, err := pool.BinPositions(opt, state.ActiveTick, big.NewInt(maverickStaticKind))
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.BinPositions")
+	}
+	if binId.Sign() == 0 {
+		return nil, errors.New("maverick pool has no static bin at the active tick")
+	}
+	return binId, nil
+}
+
+func (c *maverickFiMaker) Position(ctx context.Context, req *LiquidityPositionReq) (*LiquidityPosition, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	poolAddr, err := c.pool(ctx, req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := maverickpool.NewStorageCaller(poolAddr, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.NewStorageCaller")
+	}
+
+	positionId, binId := req.PositionId, req.BinId
+	if positionId == nil || binId == nil {
+		router, err := maverickrouter.NewStorageCaller(c.Cfg.Maverick.Router, c.ClientL2)
+		if err != nil {
+			return nil, errors.Wrap(err, "maverickrouter.NewStorageCaller")
+		}
+		nftAddr, err := router.Position(opt)
+		if err != nil {
+			return nil, errors.Wrap(err, "maverickrouter.Position")
+		}
+		nft, err := erc_721.NewStorageCaller(nftAddr, c.ClientL2)
+		if err != nil {
+			return nil, errors.Wrap(err, "erc_721.NewStorageCaller")
+		}
+		balance, err := nft.BalanceOf(opt, req.WalletAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "erc_721.BalanceOf")
+		}
+		if balance.Sign() == 0 {
+			return &LiquidityPosition{Pool: poolAddr, Liquidity: big.NewInt(0)}, nil
+		}
+		positionId, err = nft.TokenOfOwnerByIndex(opt, req.WalletAddr, new(big.Int).Sub(balance, big.NewInt(1)))
+		if err != nil {
+			return nil, errors.Wrap(err, "erc_721.TokenOfOwnerByIndex")
+		}
+		binId, err = c.activeBin(ctx, pool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	liquidity, err := pool.BalanceOf(opt, positionId, binId)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.BalanceOf")
+	}
+
+	return &LiquidityPosition{
+		Pool:       poolAddr,
+		PositionId: positionId,
+		BinId:      binId,
+		Liquidity:  liquidity,
+	}, nil
+}
+
+func (c *maverickFiMaker) MakeAddLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error) {
+	opt := &bind.CallOpts{Context: ctx}
+
+	if _, _, err := c.liquidityTokens(req.A, req.B); err != nil {
+		return nil, err
+	}
+	poolAddr, err := c.pool(ctx, req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := maverickpool.NewStorageCaller(poolAddr, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.NewStorageCaller")
+	}
+	tokenA, err := pool.TokenA(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.TokenA")
+	}
+	binId, err := c.activeBin(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	bin, err := pool.GetBin(opt, binId)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.GetBin")
+	}
+
+	// pool tokenA is the first token of the pair
+	aIsPoolA := c.Cfg.TokenMap[req.A] == tokenA
+	reserveA, reserveB := bin.ReserveA, bin.ReserveB
+	if !aIsPoolA {
+		reserveA, reserveB = reserveB, reserveA
+	}
+	if reserveA.Sign() == 0 {
+		return nil, errors.New("maverick active bin has no " + req.A.String())
+	}
+	amountB := new(big.Int).Mul(req.Amount, reserveB)
+	amountB.Div(amountB, reserveA)
+
+	deltaA, deltaB := req.Amount, amountB
+	if !aIsPoolA {
+		deltaA, deltaB = amountB, req.Amount
+	}
+	minA, err := defi.Slippage(deltaA, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+	minB, err := defi.Slippage(deltaB, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+
+	routerAbi, err := maverickrouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	// position id 0 mints a new position nft
+	add, err := routerAbi.Pack("addLiquidityToPool", poolAddr, big.NewInt(0), []maverickrouter.IPoolAddLiquidityParams{{
+		Kind:    maverickStaticKind,
+		Pos:     0,
+		IsDelta: true,
+		DeltaA:  deltaA,
+		DeltaB:  deltaB,
+	}}, minA, minB, liquidityDeadline())
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0)
+	calls := [][]byte{add}
+	switch c.Cfg.MainToken {
+	case req.A, req.B:
+		value = req.Amount
+		if req.B == c.Cfg.MainToken {
+			value = amountB
+		}
+		refund, err := routerAbi.Pack("refundETH")
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, refund)
+	}
+
+	data, err := routerAbi.Pack("multicall", calls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiquidityTxData{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        value,
+			ContractAddr: c.Cfg.Maverick.Router,
+		},
+		Approves: c.liquidityApproves(c.Cfg.Maverick.Router, []v1.Token{req.A, req.B}, []*big.Int{req.Amount, amountB}),
+	}, nil
+}
+
+func (c *maverickFiMaker) MakeRemoveLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error) {
+	if err := checkLiquidityPosition(req); err != nil {
+		return nil, err
+	}
+	if req.Position.PositionId == nil || req.Position.BinId == nil {
+		return nil, errors.New("maverick position is not specified")
+	}
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	addrA, addrB, err := c.liquidityTokens(req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &bind.CallOpts{Context: ctx}
+	pool, err := maverickpool.NewStorageCaller(req.Position.Pool, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.NewStorageCaller")
+	}
+	bin, err := pool.GetBin(opt, req.Position.BinId)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickpool.GetBin")
+	}
+	if bin.TotalSupply.Sign() == 0 {
+		return nil, errors.New("maverick bin has no liquidity")
+	}
+
+	amountA := new(big.Int).Mul(req.Amount, bin.ReserveA)
+	amountA.Div(amountA, bin.TotalSupply)
+	amountB := new(big.Int).Mul(req.Amount, bin.ReserveB)
+	amountB.Div(amountB, bin.TotalSupply)
+	minA, err := defi.Slippage(amountA, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+	minB, err := defi.Slippage(amountB, req.Slippage)
+	if err != nil {
+		return nil, err
+	}
+
+	router, err := maverickrouter.NewStorageCaller(c.Cfg.Maverick.Router, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickrouter.NewStorageCaller")
+	}
+	nftAddr, err := router.Position(opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "maverickrouter.Position")
+	}
+
+	routerAbi, err := maverickrouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	// eth side is withdrawn to the router, unwrapped and sent with the other token to the wallet
+	withEth := req.A == c.Cfg.MainToken || req.B == c.Cfg.MainToken
+	recipient := w.WalletAddr
+	if withEth {
+		recipient = ZEROADDR
+	}
+
+	remove, err := routerAbi.Pack("removeLiquidity", req.Position.Pool, recipient, req.Position.PositionId, []maverickrouter.IPoolRemoveLiquidityParams{{
+		BinId:  req.Position.BinId,
+		Amount: req.Amount,
+	}}, minA, minB, liquidityDeadline())
+	if err != nil {
+		return nil, err
+	}
+	calls := [][]byte{remove}
+
+	if withEth {
+		other := addrA
+		if req.A == c.Cfg.MainToken {
+			other = addrB
+		}
+		unwrap, err := routerAbi.Pack("unwrapWETH9", big.NewInt(0), w.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+		sweep, err := routerAbi.Pack("sweepToken", other, big.NewInt(0), w.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, unwrap, sweep)
+	}
+
+	data, err := routerAbi.Pack("multicall", calls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiquidityTxData{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        big.NewInt(0),
+			ContractAddr: c.Cfg.Maverick.Router,
+		},
+		Approves: []LiquidityApprove{{
+			Token:   nftAddr,
+			Spender: c.Cfg.Maverick.Router,
+			TokenId: req.Position.PositionId,
+		}},
+	}, nil
+}
@@ -42,7 +42,7 @@ func (c *Client) MerklyMintNft(ctx context.Context, req *merkly.MintNFTReq) (*bo
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "Make712Tx")
 	}
@@ -55,7 +55,7 @@ func (c *Client) MerklyMintNft(ctx context.Context, req *merkly.MintNFTReq) (*bo
 		return result, mintId, txData.Value, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -99,7 +99,7 @@ func (c *Client) MerklyBridgeNft(ctx context.Context, req *merkly.BridgeNFTReq)
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, errors.Wrap(err, "Make712Tx")
 	}
@@ -112,7 +112,7 @@ func (c *Client) MerklyBridgeNft(ctx context.Context, req *merkly.BridgeNFTReq)
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
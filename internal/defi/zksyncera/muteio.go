@@ -45,7 +45,7 @@ func (c *Client) MuteIOSwap(ctx context.Context, req *defi.DefaultSwapReq) (*boz
 // my
 func (c *Client) muteIoSwapToEth(ctx context.Context, req *defi.DefaultSwapReq) (*bozdo.DefaultRes, error) {
 
-	w, wtx, err := c.Wallet(req.WalletPK)
+	wtx, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
 	if err != nil {
 		return nil, errors.Wrap(err, "newWalletTransactor")
 	}
@@ -62,7 +62,7 @@ func (c *Client) muteIoSwapToEth(ctx context.Context, req *defi.DefaultSwapReq)
 
 	min := req.Amount
 	path := []common.Address{c.Cfg.TokenMap[req.FromToken], c.Cfg.Weth}
-	to := w.Address()
+	to := wtx.WalletAddr
 	deadline := new(big.Int).SetInt64(time.Now().Add(time.Second * 20).Unix())
 	stableMap := []bool{false, false}
 
@@ -72,7 +72,7 @@ func (c *Client) muteIoSwapToEth(ctx context.Context, req *defi.DefaultSwapReq)
 	}
 
 	tx := CreateFunctionCallTransaction(
-		w.Address(),
+		wtx.WalletAddr,
 		c.Cfg.Muteio.RouterSwap,
 		big.NewInt(0),
 		big.NewInt(0),
@@ -81,7 +81,7 @@ func (c *Client) muteIoSwapToEth(ctx context.Context, req *defi.DefaultSwapReq)
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, fmt.Errorf("Make712Tx: %w", err)
 	}
@@ -92,7 +92,7 @@ func (c *Client) muteIoSwapToEth(ctx context.Context, req *defi.DefaultSwapReq)
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -109,11 +109,6 @@ func (c *Client) muteIoSwapFromEth(ctx context.Context, req *defi.DefaultSwapReq
 		return nil, errors.Wrap(err, "newWalletTransactor")
 	}
 
-	w, wtx, err := c.Wallet(req.WalletPK)
-	if err != nil {
-		return nil, errors.Wrap(err, "newWalletTransactor")
-	}
-
 	muteiorouterabi, err := muteiorouter.StorageMetaData.GetAbi()
 	if err != nil {
 		return nil, err
@@ -125,7 +120,7 @@ func (c *Client) muteIoSwapFromEth(ctx context.Context, req *defi.DefaultSwapReq
 	}
 
 	path := []common.Address{c.Cfg.Weth, c.Cfg.TokenMap[req.ToToken]}
-	to := w.GetAddress()
+	to := wtx.WalletAddr
 	deadline := new(big.Int).SetInt64(time.Now().Add(time.Second * 20).Unix())
 	stableMap := []bool{false, false}
 
@@ -142,7 +137,7 @@ func (c *Client) muteIoSwapFromEth(ctx context.Context, req *defi.DefaultSwapReq
 	value = bozdo.BigIntSum(value, fee)
 
 	tx := CreateFunctionCallTransaction(
-		w.Address(),
+		wtx.WalletAddr,
 		c.Cfg.Muteio.RouterSwap,
 		big.NewInt(0),
 		big.NewInt(0),
@@ -151,7 +146,7 @@ func (c *Client) muteIoSwapFromEth(ctx context.Context, req *defi.DefaultSwapReq
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, fmt.Errorf("Make712Tx: %w", err)
 	}
@@ -162,7 +157,7 @@ func (c *Client) muteIoSwapFromEth(ctx context.Context, req *defi.DefaultSwapReq
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -0,0 +1,124 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/nonce"
+	"github.com/pkg/errors"
+)
+
+// signedHoldTimeout the nonce of the signed tx that is never sent is handed out again after it
+const signedHoldTimeout = time.Minute
+
+var errNotSent = errors.New("tx is not sent")
+
+// signedNonces reservations of the signed 712 txs by their raw bytes hash, Make712Tx holds the nonce until the tx
+// is sent by sendRawTx
+var signedNonces sync.Map
+
+// holdSigned keeps the reservation of the signed tx for sendRawTx, it is released if the tx is not sent in time
+func holdSigned(raw []byte, reserved *nonce.Reservation) {
+	k := crypto.Keccak256Hash(raw)
+	signedNonces.Store(k, reserved)
+	time.AfterFunc(signedHoldTimeout, func() {
+		if r, ok := signedNonces.LoadAndDelete(k); ok {
+			r.(*nonce.Reservation).Done(errNotSent)
+		}
+	})
+}
+
+// takeSigned reservation Make712Tx holds for the signed tx, the nonce is claimed if the tx is signed elsewhere
+func (c *Client) takeSigned(raw []byte, tx *raw712) (*nonce.Reservation, error) {
+	if r, ok := signedNonces.LoadAndDelete(crypto.Keccak256Hash(raw)); ok {
+		return r.(*nonce.Reservation), nil
+	}
+	return nonce.Default.Claim(c.Cfg.Network, tx.from, tx.nonce)
+}
+
+// sendRawTx simulates the signed 712 tx and sends it releasing the nonce Make712Tx reserved for it. The reverting tx
+// fails the send before it reaches the node
+func (c *Client) sendRawTx(ctx context.Context, raw []byte) (common.Hash, error) {
+	tx, err := decodeRaw712(raw)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	reserved, err := c.takeSigned(raw, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := c.simulate(ctx, tx); err != nil {
+		reserved.Done(errNotSent)
+		return common.Hash{}, err
+	}
+	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	reserved.Done(err)
+	return hash, err
+}
+
+// simulate runs the call of the tx with eth_call on the pending block
+func (c *Client) simulate(ctx context.Context, tx *raw712) error {
+	arg := map[string]interface{}{
+		"from": tx.from,
+		"to":   tx.to,
+	}
+	if len(tx.data) > 0 {
+		arg["data"] = hexutil.Bytes(tx.data)
+	}
+	if tx.value != nil && tx.value.Sign() > 0 {
+		arg["value"] = (*hexutil.Big)(tx.value)
+	}
+	var res hexutil.Bytes
+	if err := c.rpcL2.CallContext(ctx, &res, "eth_call", arg, "pending"); err != nil {
+		return defi.RevertError(err)
+	}
+	return nil
+}
+
+// raw712 call of the signed 712 tx
+type raw712 struct {
+	nonce uint64
+	from  common.Address
+	to    common.Address
+	value *big.Int
+	data  []byte
+}
+
+// decodeRaw712 the rlp list after the type byte starts with the nonce, has the call at the 4-6th positions and the
+// sender at the 11th
+func decodeRaw712(raw []byte) (*raw712, error) {
+	if len(raw) == 0 || raw[0] != 0x71 {
+		return nil, errors.New("not an eip712 tx")
+	}
+	var fields []rlp.RawValue
+	if err := rlp.DecodeBytes(raw[1:], &fields); err != nil {
+		return nil, errors.Wrap(err, "rlp.DecodeBytes")
+	}
+	if len(fields) < 12 {
+		return nil, errors.New("eip712 tx is too short")
+	}
+	tx := &raw712{value: new(big.Int)}
+	if err := rlp.DecodeBytes(fields[0], &tx.nonce); err != nil {
+		return nil, errors.Wrap(err, "nonce")
+	}
+	if err := rlp.DecodeBytes(fields[4], &tx.to); err != nil {
+		return nil, errors.Wrap(err, "to")
+	}
+	if err := rlp.DecodeBytes(fields[5], tx.value); err != nil {
+		return nil, errors.Wrap(err, "value")
+	}
+	if err := rlp.DecodeBytes(fields[6], &tx.data); err != nil {
+		return nil, errors.Wrap(err, "data")
+	}
+	if err := rlp.DecodeBytes(fields[11], &tx.from); err != nil {
+		return nil, errors.Wrap(err, "from")
+	}
+	return tx, nil
+}
@@ -7,6 +7,9 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
 	"github.com/pkg/errors"
@@ -19,9 +22,9 @@ import (
 // транзакция через софт https://explorer.zksync.io/tx/0xf471fea71aa35a1fc0716b40a41aaf286d186df1b5220ce13d16fd4a4b53591b
 func (c *Client) BridgeToEthereumNetwork(ctx context.Context, req *L1L2BridgeReq) (*L1L2BridgeRes, error) {
 
-	w, wtx, err := c.Wallet(req.WalletPK)
+	wtx, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
 	if err != nil {
-		return nil, errors.Wrap(err, "zksync2.NewWallet")
+		return nil, errors.Wrap(err, "newWalletTransactor")
 	}
 
 	ethTokenAbi, err := abi.JSON(strings.NewReader(ethtoken.IEthTokenMetaData.ABI))
@@ -29,12 +32,12 @@ func (c *Client) BridgeToEthereumNetwork(ctx context.Context, req *L1L2BridgeReq
 		return nil, fmt.Errorf("failed to load ethTokenAbi: %w", err)
 	}
 
-	data, err := ethTokenAbi.Pack("withdraw", w.GetAddress())
+	data, err := ethTokenAbi.Pack("withdraw", wtx.WalletAddr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pack withdraw function: %w", err)
 	}
 	tx := CreateFunctionCallTransaction(
-		w.Address(),
+		wtx.WalletAddr,
 		utils.L2EthTokenAddress,
 		big.NewInt(0),
 		big.NewInt(0),
@@ -44,7 +47,7 @@ func (c *Client) BridgeToEthereumNetwork(ctx context.Context, req *L1L2BridgeReq
 	)
 
 	result := &L1L2BridgeRes{}
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, errors.Wrap(err, "Make712Tx")
 	}
@@ -55,7 +58,7 @@ func (c *Client) BridgeToEthereumNetwork(ctx context.Context, req *L1L2BridgeReq
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -144,3 +147,112 @@ func (c *Client) BridgeFromEthereumNetwork(ctx context.Context, req *L1L2BridgeR
 		TxHash: c.NewTx(tx.Hash(), defi.CodeContract, nil),
 	}, nil
 }
+
+var (
+	l1MessengerAddress = common.HexToAddress("0x0000000000000000000000000000000000008008")
+	l2EthTokenAddress  = common.HexToAddress("0x000000000000000000000000000000000000800a")
+	l1MessageSentTopic = crypto.Keccak256Hash([]byte("L1MessageSent(address,bytes32,bytes)"))
+)
+
+type withdrawalReceipt struct {
+	Status         hexutil.Uint64 `json:"status"`
+	L1BatchNumber  *hexutil.Big   `json:"l1BatchNumber"`
+	L1BatchTxIndex *hexutil.Big   `json:"l1BatchTxIndex"`
+	Logs           []struct {
+		Address common.Address `json:"address"`
+		Topics  []common.Hash  `json:"topics"`
+		Data    hexutil.Bytes  `json:"data"`
+	} `json:"logs"`
+	L2ToL1Logs []struct {
+		Sender common.Address `json:"sender"`
+		Key    common.Hash    `json:"key"`
+	} `json:"l2ToL1Logs"`
+}
+
+type batchDetails struct {
+	ExecuteTxHash *common.Hash `json:"executeTxHash"`
+}
+
+type l2ToL1LogProof struct {
+	Id    int64         `json:"id"`
+	Proof []common.Hash `json:"proof"`
+}
+
+// WithdrawalProof message of the eth withdrawal and its proof, available once the batch of the withdrawal is executed on ethereum
+func (c *Client) WithdrawalProof(ctx context.Context, l2Tx common.Hash) (*defi.ZkSyncWithdrawal, error) {
+
+	var receipt *withdrawalReceipt
+	if err := c.rpcL2.CallContext(ctx, &receipt, "eth_getTransactionReceipt", l2Tx); err != nil {
+		return nil, errors.Wrap(err, "eth_getTransactionReceipt")
+	}
+	if receipt == nil {
+		return nil, defi.ErrTxNotFound
+	}
+	if receipt.Status != 1 {
+		return nil, defi.ErrTxStatusFailed
+	}
+	if receipt.L1BatchNumber == nil || receipt.L1BatchTxIndex == nil {
+		return nil, &defi.WithdrawalNotReady{Reason: "batch of the withdrawal is not sealed"}
+	}
+
+	var details *batchDetails
+	if err := c.rpcL2.CallContext(ctx, &details, "zks_getL1BatchDetails", receipt.L1BatchNumber.ToInt().Uint64()); err != nil {
+		return nil, errors.Wrap(err, "zks_getL1BatchDetails")
+	}
+	if details == nil || details.ExecuteTxHash == nil {
+		return nil, &defi.WithdrawalNotReady{Reason: "batch " + receipt.L1BatchNumber.ToInt().String() + " is not executed"}
+	}
+
+	ethTokenKey := common.BytesToHash(l2EthTokenAddress.Bytes())
+	logIndex := -1
+	for i, l := range receipt.L2ToL1Logs {
+		if l.Sender == l1MessengerAddress && l.Key == ethTokenKey {
+			logIndex = i
+			break
+		}
+	}
+	if logIndex < 0 {
+		return nil, errors.New("l2 to l1 log of the withdrawal is not found")
+	}
+
+	var message []byte
+	bytesTy, err := abi.NewType("bytes", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != l1MessengerAddress || len(l.Topics) < 2 || l.Topics[0] != l1MessageSentTopic || l.Topics[1] != ethTokenKey {
+			continue
+		}
+		out, err := abi.Arguments{{Type: bytesTy}}.Unpack(l.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "L1MessageSent")
+		}
+		message = out[0].([]byte)
+		break
+	}
+	if message == nil {
+		return nil, errors.New("L1MessageSent event of the withdrawal is not found")
+	}
+
+	var proof *l2ToL1LogProof
+	if err := c.rpcL2.CallContext(ctx, &proof, "zks_getL2ToL1LogProof", l2Tx, logIndex); err != nil {
+		return nil, errors.Wrap(err, "zks_getL2ToL1LogProof")
+	}
+	if proof == nil {
+		return nil, &defi.WithdrawalNotReady{Reason: "proof of the withdrawal is not available"}
+	}
+
+	merkleProof := make([][32]byte, len(proof.Proof))
+	for i := range proof.Proof {
+		merkleProof[i] = proof.Proof[i]
+	}
+
+	return &defi.ZkSyncWithdrawal{
+		BatchNumber:     receipt.L1BatchNumber.ToInt(),
+		MessageIndex:    big.NewInt(proof.Id),
+		TxNumberInBatch: uint16(receipt.L1BatchTxIndex.ToInt().Uint64()),
+		Message:         message,
+		Proof:           merkleProof,
+	}, nil
+}
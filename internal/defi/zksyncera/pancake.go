@@ -19,9 +19,11 @@ type pancakeMaker struct {
 	Cli *Client
 }
 
+var pancakeRouter = common.HexToAddress("0x5aEaF2883FBf30f3D62471154eDa3C0c1b05942d")
+
 func (c *Client) PancakeSwap(ctx context.Context, req *defi.DefaultSwapReq) (*bozdo.DefaultRes, error) {
 	return c.GenericSwap(ctx, &pancakeMaker{
-		CA:  common.HexToAddress("0x5aEaF2883FBf30f3D62471154eDa3C0c1b05942d"),
+		CA:  pancakeRouter,
 		Cli: c,
 	}, req)
 }
@@ -0,0 +1,147 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/erc_20"
+	"github.com/hardstylez72/cry/internal/exchange/pub"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/server/config"
+	"github.com/pkg/errors"
+	"github.com/zksync-sdk/zksync2-go/contracts/erc20"
+	"github.com/zksync-sdk/zksync2-go/types"
+)
+
+// syncSwapPaymaster approval based paymaster of syncswap, takes usdc and usdt
+var syncSwapPaymaster = common.HexToAddress("0x0c08f298A75A090DC4C0BB4CaA4204B8B9D156c1")
+
+// paymasterFeeReserve the allowance covers the doubled fee, the paymaster takes only the actual one
+const paymasterFeeReserve = 2
+
+// paymasterPriceAge the eth price the fee is converted with is read within, the listener reads it every minute
+const paymasterPriceAge = 5 * time.Minute
+
+var paymasterApprovalBased = crypto.Keccak256([]byte("approvalBased(address,uint256,bytes)"))[:4]
+
+// PaymasterTasks task types whose txs can pay the fee through the paymaster, the swaps of GenericSwap
+var PaymasterTasks = map[v1.TaskType]bool{
+	v1.TaskType_SyncSwap:      true,
+	v1.TaskType_MaverickSwap:  true,
+	v1.TaskType_VeSyncSwap:    true,
+	v1.TaskType_VelocoreSwap:  true,
+	v1.TaskType_IzumiSwap:     true,
+	v1.TaskType_PancakeSwap:   true,
+	v1.TaskType_SpaceFISwap:   true,
+	v1.TaskType_ZkSwap:        true,
+	v1.TaskType_EzkaliburSwap: true,
+}
+
+func paymasterAddress() common.Address {
+	if config.CFG != nil && config.CFG.ZkSyncPaymaster != "" {
+		return common.HexToAddress(config.CFG.ZkSyncPaymaster)
+	}
+	return syncSwapPaymaster
+}
+
+// usePaymaster makes the tx pay the fee in the token through the approval based paymaster. The account approves
+// the paymaster the allowance from the paymaster input itself, so no separate approve tx is sent.
+// spent is the amount of the fee token the tx itself takes, the fee must fit into the rest of the balance
+func (c *Client) usePaymaster(ctx context.Context, tx *types.CallMsg, feeToken *v1.Token, spent *big.Int) (*bozdo.TxDetail, error) {
+	if feeToken == nil || *feeToken == c.Cfg.MainToken {
+		return nil, nil
+	}
+	switch *feeToken {
+	case v1.Token_USDC, v1.Token_USDT:
+	default:
+		return nil, errors.New("fee can not be paid in " + feeToken.String())
+	}
+	tokenAddr, ok := c.Cfg.TokenMap[*feeToken]
+	if !ok {
+		return nil, defi.ErrTokenNotSupportedFn(*feeToken)
+	}
+
+	gasPrice, err := c.ClientL2.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "SuggestGasPrice")
+	}
+	tx.GasPrice = gasPrice
+	gas, err := c.ClientL2.EstimateGasL2(ctx, *tx)
+	if err != nil {
+		return nil, errors.Wrap(defi.EstimateError(err), "EstimateGasL2")
+	}
+
+	if !pub.Fresh(paymasterPriceAge) {
+		return nil, errors.New("eth price is not available to convert the fee into " + feeToken.String())
+	}
+	price := pub.Price().ETH
+	if price <= 0 {
+		return nil, errors.New("eth price is unknown")
+	}
+
+	decimals, err := c.tokenDecimals(ctx, tokenAddr)
+	if err != nil {
+		return nil, err
+	}
+	unit := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+
+	// the fee tokens are usd stablecoins, a token is worth a dollar
+	feeUsd := defi.EthToUsd(defi.WEIToEther(defi.MinerGasLegacy(gasPrice, gas)), price)
+	minAllowance, _ := new(big.Float).Mul(feeUsd, new(big.Float).Mul(unit, big.NewFloat(paymasterFeeReserve))).Int(nil)
+	minAllowance.Add(minAllowance, big.NewInt(1))
+
+	token, err := erc20.NewIERC20(tokenAddr, c.ClientL2)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc20.NewIERC20")
+	}
+	balance, err := token.BalanceOf(nil, tx.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "erc20.BalanceOf")
+	}
+	if balance.Cmp(bozdo.BigIntSum(spent, minAllowance)) < 0 {
+		return nil, errors.New("not enough " + feeToken.String() + " to pay the fee")
+	}
+
+	input, err := paymasterInput(tokenAddr, minAllowance)
+	if err != nil {
+		return nil, err
+	}
+	tx.Meta.PaymasterParams = &types.PaymasterParams{
+		Paymaster:      paymasterAddress(),
+		PaymasterInput: input,
+	}
+
+	d := bozdo.NewPaymasterFeeDetails(new(big.Float).Quo(new(big.Float).SetInt(minAllowance), unit).String(), *feeToken)
+	return &d, nil
+}
+
+// tokenDecimals decimals of the fee token from the token map
+func (c *Client) tokenDecimals(ctx context.Context, token common.Address) (uint8, error) {
+	caller, err := erc_20.NewStorageCaller(token, c.ClientL2)
+	if err != nil {
+		return 0, errors.Wrap(err, "erc_20.NewStorageCaller")
+	}
+	decimals, err := caller.Decimals(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, errors.Wrap(err, "token.Decimals")
+	}
+	return decimals, nil
+}
+
+func paymasterInput(token common.Address, minAllowance *big.Int) ([]byte, error) {
+	addressT, _ := abi.NewType("address", "", nil)
+	uintT, _ := abi.NewType("uint256", "", nil)
+	bytesT, _ := abi.NewType("bytes", "", nil)
+	args, err := abi.Arguments{{Type: addressT}, {Type: uintT}, {Type: bytesT}}.Pack(token, minAllowance, []byte{})
+	if err != nil {
+		return nil, errors.Wrap(err, "approvalBased.Pack")
+	}
+	return append(append([]byte{}, paymasterApprovalBased...), args...), nil
+}
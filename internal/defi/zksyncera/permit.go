@@ -0,0 +1,106 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/pkg/errors"
+)
+
+// permit2Address Permit2 deployment of zkSync Era, the canonical address of the evm networks is not the one here
+var permit2Address = common.HexToAddress("0x0000000000225e31D15943971F47aD3022F714Fa")
+
+// TxPermitSwapMaker the swap maker whose router takes the eip-2612 permit along with the swap
+type TxPermitSwapMaker interface {
+	WithPermit(txData *bozdo.TxData, permit *defi.Permit) (*bozdo.TxData, error)
+}
+
+// TxPermit2SwapMaker the swap maker whose router takes the Permit2 permit along with the swap
+type TxPermit2SwapMaker interface {
+	WithPermit2(txData *bozdo.TxData, permit *defi.Permit2) (*bozdo.TxData, error)
+}
+
+// permitSwap embeds the signed permit of the from token into the swap when the allowance is not enough, the eip-2612
+// one first and the Permit2 one then. False is returned when the permit is not needed or neither the token nor Permit2
+// can give it, so the classic approve is left to be made
+func (c *Client) permitSwap(ctx context.Context, maker TxSwapMaker, req *defi.DefaultSwapReq, txData *bozdo.TxData) (*bozdo.TxData, bool, error) {
+	pm, ok := maker.(TxPermitSwapMaker)
+	pm2, ok2 := maker.(TxPermit2SwapMaker)
+	if (!ok && !ok2) || req.FromToken == c.Cfg.MainToken {
+		return txData, false, nil
+	}
+
+	token, ok := c.Cfg.TokenMap[req.FromToken]
+	if !ok {
+		return nil, false, defi.ErrTokenNotSupportedFn(req.FromToken)
+	}
+
+	transactor, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	allowed, err := c.TokenAllowed(ctx, &AllowedReq{
+		Token:       req.FromToken,
+		WalletAddr:  transactor.WalletAddr,
+		SpenderAddr: txData.ContractAddr,
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "TokenAllowed")
+	}
+	if allowed.Allowance.Cmp(req.Amount) >= 0 {
+		return txData, false, nil
+	}
+
+	deadline := big.NewInt(time.Now().Add(time.Minute * 20).Unix())
+
+	if ok {
+		permit, err := defi.SignPermit(ctx, c.ClientL2, &defi.PermitReq{
+			Token:    token,
+			Spender:  txData.ContractAddr,
+			Value:    req.Amount,
+			Deadline: deadline,
+			ChainID:  c.NetworkId,
+			Signer:   transactor.Signer,
+		})
+		if err != nil && !errors.Is(err, defi.ErrPermitNotSupported) {
+			return nil, false, errors.Wrap(err, "SignPermit")
+		}
+		if err == nil {
+			txData, err = pm.WithPermit(txData, permit)
+			if err != nil {
+				return nil, false, errors.Wrap(err, "WithPermit")
+			}
+			return txData, true, nil
+		}
+	}
+
+	if ok2 {
+		permit, err := defi.SignPermit2(ctx, c.ClientL2, &defi.Permit2Req{
+			Permit2:    permit2Address,
+			Token:      token,
+			Spender:    txData.ContractAddr,
+			Amount:     req.Amount,
+			Expiration: deadline,
+			Deadline:   deadline,
+			ChainID:    c.NetworkId,
+			Signer:     transactor.Signer,
+		})
+		if err != nil && !errors.Is(err, defi.ErrPermitNotSupported) {
+			return nil, false, errors.Wrap(err, "SignPermit2")
+		}
+		if err == nil {
+			txData, err = pm2.WithPermit2(txData, permit)
+			if err != nil {
+				return nil, false, errors.Wrap(err, "WithPermit2")
+			}
+			return txData, true, nil
+		}
+	}
+
+	return txData, false, nil
+}
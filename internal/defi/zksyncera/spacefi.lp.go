@@ -0,0 +1,152 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/spacefirouter"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/univ2factory"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+func (c *spaceFiMaker) pair(ctx context.Context, a, b common.Address, stable bool) (common.Address, error) {
+	if stable {
+		return common.Address{}, errors.New("SpaceFi has no stable pools")
+	}
+	opt := &bind.CallOpts{Context: ctx}
+
+	router, err := spacefirouter.NewStorageCaller(c.Cfg.SpaceFI.Router, c.ClientL2)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "spacefirouter.NewStorageCaller")
+	}
+	factory, err := router.Factory(opt)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "spacefirouter.Factory")
+	}
+	caller, err := univ2factory.NewStorageCaller(factory, c.ClientL2)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "univ2factory.NewStorageCaller")
+	}
+	pair, err := caller.GetPair(opt, a, b)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "univ2factory.GetPair")
+	}
+	return pair, nil
+}
+
+func (c *spaceFiMaker) Position(ctx context.Context, req *LiquidityPositionReq) (*LiquidityPosition, error) {
+	a, b, err := c.liquidityTokens(req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := c.pair(ctx, a, b, req.Stable)
+	if err != nil {
+		return nil, err
+	}
+	return c.univ2Position(ctx, pair, req.WalletAddr)
+}
+
+func (c *spaceFiMaker) MakeAddLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error) {
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	a, b, err := c.liquidityTokens(req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := c.pair(ctx, a, b, req.Stable)
+	if err != nil {
+		return nil, err
+	}
+	amountB, minA, minB, err := c.univ2AddAmounts(ctx, pair, a, req)
+	if err != nil {
+		return nil, err
+	}
+
+	routerAbi, err := spacefirouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := liquidityDeadline()
+	value := big.NewInt(0)
+	var data []byte
+	switch c.Cfg.MainToken {
+	case req.B:
+		value = amountB
+		data, err = routerAbi.Pack("addLiquidityETH", a, req.Amount, minA, minB, w.WalletAddr, deadline)
+	case req.A:
+		value = req.Amount
+		data, err = routerAbi.Pack("addLiquidityETH", b, amountB, minB, minA, w.WalletAddr, deadline)
+	default:
+		data, err = routerAbi.Pack("addLiquidity", a, b, req.Amount, amountB, minA, minB, w.WalletAddr, deadline)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiquidityTxData{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        value,
+			ContractAddr: c.Cfg.SpaceFI.Router,
+		},
+		Approves: c.liquidityApproves(c.Cfg.SpaceFI.Router, []v1.Token{req.A, req.B}, []*big.Int{req.Amount, amountB}),
+	}, nil
+}
+
+func (c *spaceFiMaker) MakeRemoveLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error) {
+	if err := checkLiquidityPosition(req); err != nil {
+		return nil, err
+	}
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	a, b, err := c.liquidityTokens(req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	pair := req.Position.Pool
+	minA, minB, err := c.univ2RemoveAmounts(ctx, pair, a, req)
+	if err != nil {
+		return nil, err
+	}
+
+	routerAbi, err := spacefirouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := liquidityDeadline()
+	var data []byte
+	switch c.Cfg.MainToken {
+	case req.B:
+		data, err = routerAbi.Pack("removeLiquidityETH", a, req.Amount, minA, minB, w.WalletAddr, deadline)
+	case req.A:
+		data, err = routerAbi.Pack("removeLiquidityETH", b, req.Amount, minB, minA, w.WalletAddr, deadline)
+	default:
+		data, err = routerAbi.Pack("removeLiquidity", a, b, req.Amount, minA, minB, w.WalletAddr, deadline)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiquidityTxData{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        big.NewInt(0),
+			ContractAddr: c.Cfg.SpaceFI.Router,
+		},
+		Approves: []LiquidityApprove{{
+			Token:   pair,
+			Spender: c.Cfg.SpaceFI.Router,
+			Amount:  req.Amount,
+		}},
+	}, nil
+}
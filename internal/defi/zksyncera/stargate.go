@@ -23,11 +23,13 @@ func (c *Client) StargateBridge(ctx context.Context, req *defi.DefaultBridgeReq)
 	return c.GenericBridge(ctx, &stargateBridgeMaker{c}, req)
 }
 
+var stargateRouter = common.HexToAddress("0xDAc7479e5F7c01CC59bbF7c1C4EDF5604ADA1FF2")
+
 func (m stargateBridgeMaker) MakeBridgeTx(ctx context.Context, req *defi.DefaultBridgeReq) (*bozdo.TxData, error) {
 
 	c := m.source
 
-	contractAddr := common.HexToAddress("0xDAc7479e5F7c01CC59bbF7c1C4EDF5604ADA1FF2")
+	contractAddr := stargateRouter
 
 	wt, err := NewWalletTransactor(req.WalletPK, c.Cfg.networkId)
 	if err != nil {
@@ -135,9 +137,9 @@ func (m stargateBridgeMaker) GetStargateBridgeFee(ctx context.Context, req *GetS
 
 	c := m.source
 
-	w, _, err := c.Wallet(req.WalletPK)
+	wtx, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
 	if err != nil {
-		return nil, errors.Wrap(err, "zksync2.NewWallet")
+		return nil, errors.Wrap(err, "newWalletTransactor")
 	}
 
 	provider := c.ClientL1
@@ -150,7 +152,7 @@ func (m stargateBridgeMaker) GetStargateBridgeFee(ctx context.Context, req *GetS
 		return nil, err
 	}
 
-	toAddress := w.Address().Bytes()
+	toAddress := wtx.WalletAddr.Bytes()
 	payload := common.HexToAddress("0").Bytes()
 	distChain, ok := layerzero.LayerZeroChainMap[req.ToChain]
 	if !ok {
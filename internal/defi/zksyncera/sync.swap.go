@@ -122,3 +122,36 @@ func (c *syncSwapMaker) MakeSwapTx(ctx context.Context, req *defi.DefaultSwapReq
 		ContractAddr: c.Cfg.SyncSwap.RouterSwap,
 	}, nil
 }
+
+// WithPermit turns the swap into swapWithPermit, the router permits itself the from token
+func (c *syncSwapMaker) WithPermit(txData *bozdo.TxData, permit *defi.Permit) (*bozdo.TxData, error) {
+	syncswaprouterabi, err := syncswaprouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := syncswaprouterabi.Methods["swap"].Inputs.Unpack(txData.Data[4:])
+	if err != nil {
+		return nil, errors.Wrap(err, "swap unpack")
+	}
+	path := *abi.ConvertType(args[0], new([]syncswaprouter.IRouterSwapPath)).(*[]syncswaprouter.IRouterSwapPath)
+
+	data, err := syncswaprouterabi.Pack("swapWithPermit", path, args[1], args[2], syncswaprouter.IRouterSplitPermitParams{
+		Token:         permit.Token,
+		ApproveAmount: permit.Value,
+		Deadline:      permit.Deadline,
+		V:             permit.V,
+		R:             permit.R,
+		S:             permit.S,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "swapWithPermit pack")
+	}
+
+	return &bozdo.TxData{
+		Data:         data,
+		Value:        txData.Value,
+		ContractAddr: txData.ContractAddr,
+		Details:      txData.Details,
+	}, nil
+}
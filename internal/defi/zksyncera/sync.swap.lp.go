@@ -145,7 +145,7 @@ func (c *Client) syncSwapLiquidityXXXETHAdd(ctx context.Context, req *SyncSwapLi
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, fmt.Errorf("Make712Tx: %w", err)
 	}
@@ -156,7 +156,7 @@ func (c *Client) syncSwapLiquidityXXXETHAdd(ctx context.Context, req *SyncSwapLi
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -233,7 +233,7 @@ func (c *Client) syncSwapLiquidityXXXETHRemove(ctx context.Context, req *SyncSwa
 		nil, nil,
 	)
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, transactor.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, fmt.Errorf("Make712Tx: %w", err)
 	}
@@ -244,7 +244,7 @@ func (c *Client) syncSwapLiquidityXXXETHRemove(ctx context.Context, req *SyncSwa
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -51,18 +51,22 @@ func (c *Client) TokenLimitChecker(ctx context.Context, req *TokenLimitCheckerRe
 
 	if req.Amount.Cmp(allowed.Allowance) == 1 {
 
-		b, err := c.GetBalance(ctx, &defi.GetBalanceReq{
-			WalletAddress: tx.WalletAddrHR,
-			Token:         req.Token,
-		})
-		if err != nil {
-			return nil, err
+		amount := req.Amount
+		if !defi.ExactApprove(ctx) {
+			b, err := c.GetBalance(ctx, &defi.GetBalanceReq{
+				WalletAddress: tx.WalletAddrHR,
+				Token:         req.Token,
+			})
+			if err != nil {
+				return nil, err
+			}
+			amount = b.WEI
 		}
 
 		tx, err := c.TokenApprove(ctx, &ApproveReq{
 			Token:       req.Token,
 			Wallet:      tx,
-			Amount:      b.WEI,
+			Amount:      amount,
 			SpenderAddr: req.SpenderAddr,
 		})
 		if err != nil {
@@ -158,19 +162,16 @@ func (c *Client) TokenApprove(ctx context.Context, req *ApproveReq) (*ApproveRes
 		return nil, err
 	}
 
-	w, wtx, err := c.Wallet(req.Wallet.PK)
-	if err != nil {
-		return nil, err
-	}
+	wtx := req.Wallet
 
-	call := CreateFunctionCallTransaction(w.Address(), addr, nil, big.NewInt(0), nil, data, nil, nil)
+	call := CreateFunctionCallTransaction(wtx.WalletAddr, addr, nil, big.NewInt(0), nil, data, nil, nil)
 
-	tx, _, err := c.Make712Tx(ctx, call, nil, wtx.Signer)
+	tx, _, err := c.Make712Tx(ctx, call, nil, wtx.Signer, false)
 	if err != nil {
 		return nil, err
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, tx)
+	hash, err := c.sendRawTx(ctx, tx)
 	if err != nil {
 		return nil, errors.Wrap(err, "caller.Allowance")
 	}
@@ -0,0 +1,90 @@
+package zksyncera
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+	"github.com/zksync-sdk/zksync2-go/types"
+)
+
+// txType712 type of the zkSync Era eip-712 tx
+const txType712 = 113
+
+// tx712Types eip-712 types of the zkSync Era tx, the addresses are signed as uint256
+var tx712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+	},
+	"Transaction": {
+		{Name: "txType", Type: "uint256"},
+		{Name: "from", Type: "uint256"},
+		{Name: "to", Type: "uint256"},
+		{Name: "gasLimit", Type: "uint256"},
+		{Name: "gasPerPubdataByteLimit", Type: "uint256"},
+		{Name: "maxFeePerGas", Type: "uint256"},
+		{Name: "maxPriorityFeePerGas", Type: "uint256"},
+		{Name: "paymaster", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "value", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+		{Name: "factoryDeps", Type: "bytes32[]"},
+		{Name: "paymasterInput", Type: "bytes"},
+	},
+}
+
+// tx712TypedData typed data of the 712 tx the signer of the wallet signs, the txs deploying the contracts are not made
+// here so the factory deps are always empty
+func tx712TypedData(tx *types.Transaction712) (apitypes.TypedData, error) {
+	if tx.From == nil || tx.To == nil || tx.Meta == nil {
+		return apitypes.TypedData{}, errors.New("712 tx without from, to or meta")
+	}
+	if len(tx.Meta.FactoryDeps) > 0 {
+		return apitypes.TypedData{}, errors.New("712 tx with factory deps is not supported")
+	}
+
+	gasPerPubdata := big.NewInt(0)
+	if tx.Meta.GasPerPubdata != nil {
+		gasPerPubdata = tx.Meta.GasPerPubdata.ToInt()
+	}
+	paymaster := common.Address{}
+	paymasterInput := hexutil.Bytes{}
+	if p := tx.Meta.PaymasterParams; p != nil {
+		paymaster = p.Paymaster
+		paymasterInput = p.PaymasterInput
+	}
+	value := tx.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	return apitypes.TypedData{
+		Types:       tx712Types,
+		PrimaryType: "Transaction",
+		Domain: apitypes.TypedDataDomain{
+			Name:    "zkSync",
+			Version: "2",
+			ChainId: (*math.HexOrDecimal256)(tx.ChainID),
+		},
+		Message: apitypes.TypedDataMessage{
+			"txType":                 big.NewInt(txType712).String(),
+			"from":                   tx.From.Big().String(),
+			"to":                     tx.To.Big().String(),
+			"gasLimit":               tx.Gas.String(),
+			"gasPerPubdataByteLimit": gasPerPubdata.String(),
+			"maxFeePerGas":           tx.GasFeeCap.String(),
+			"maxPriorityFeePerGas":   tx.GasTipCap.String(),
+			"paymaster":              paymaster.Big().String(),
+			"nonce":                  tx.Nonce.String(),
+			"value":                  value.String(),
+			"data":                   hexutil.Bytes(tx.Data).String(),
+			"factoryDeps":            []interface{}{},
+			"paymasterInput":         paymasterInput.String(),
+		},
+	}, nil
+}
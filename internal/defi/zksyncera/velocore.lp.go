@@ -0,0 +1,138 @@
+package zksyncera
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/contracts/zksyncera/velocorerouter"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+func (c *velocoreMaker) pair(ctx context.Context, a, b common.Address, stable bool) (common.Address, error) {
+	caller, err := velocorerouter.NewStorageCaller(c.Cfg.Velocore.Router, c.ClientL2)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "velocorerouter.NewStorageCaller")
+	}
+	pair, err := caller.PairFor(&bind.CallOpts{Context: ctx}, a, b, stable)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "velocorerouter.PairFor")
+	}
+	return pair, nil
+}
+
+func (c *velocoreMaker) Position(ctx context.Context, req *LiquidityPositionReq) (*LiquidityPosition, error) {
+	a, b, err := c.liquidityTokens(req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := c.pair(ctx, a, b, req.Stable)
+	if err != nil {
+		return nil, err
+	}
+	return c.univ2Position(ctx, pair, req.WalletAddr)
+}
+
+func (c *velocoreMaker) MakeAddLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error) {
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	a, b, err := c.liquidityTokens(req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := c.pair(ctx, a, b, req.Stable)
+	if err != nil {
+		return nil, err
+	}
+	amountB, minA, minB, err := c.univ2AddAmounts(ctx, pair, a, req)
+	if err != nil {
+		return nil, err
+	}
+
+	routerAbi, err := velocorerouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := liquidityDeadline()
+	value := big.NewInt(0)
+	var data []byte
+	switch c.Cfg.MainToken {
+	case req.B:
+		value = amountB
+		data, err = routerAbi.Pack("addLiquidityETH", a, req.Stable, req.Amount, minA, minB, w.WalletAddr, deadline)
+	case req.A:
+		value = req.Amount
+		data, err = routerAbi.Pack("addLiquidityETH", b, req.Stable, amountB, minB, minA, w.WalletAddr, deadline)
+	default:
+		data, err = routerAbi.Pack("addLiquidity", a, b, req.Stable, req.Amount, amountB, minA, minB, w.WalletAddr, deadline)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiquidityTxData{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        value,
+			ContractAddr: c.Cfg.Velocore.Router,
+		},
+		Approves: c.liquidityApproves(c.Cfg.Velocore.Router, []v1.Token{req.A, req.B}, []*big.Int{req.Amount, amountB}),
+	}, nil
+}
+
+func (c *velocoreMaker) MakeRemoveLiquidityTx(ctx context.Context, req *LiquidityReq) (*LiquidityTxData, error) {
+	if err := checkLiquidityPosition(req); err != nil {
+		return nil, err
+	}
+	w, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	a, b, err := c.liquidityTokens(req.A, req.B)
+	if err != nil {
+		return nil, err
+	}
+	pair := req.Position.Pool
+	minA, minB, err := c.univ2RemoveAmounts(ctx, pair, a, req)
+	if err != nil {
+		return nil, err
+	}
+
+	routerAbi, err := velocorerouter.StorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := liquidityDeadline()
+	var data []byte
+	switch c.Cfg.MainToken {
+	case req.B:
+		data, err = routerAbi.Pack("removeLiquidityETH", a, req.Stable, req.Amount, minA, minB, w.WalletAddr, deadline)
+	case req.A:
+		data, err = routerAbi.Pack("removeLiquidityETH", b, req.Stable, req.Amount, minB, minA, w.WalletAddr, deadline)
+	default:
+		data, err = routerAbi.Pack("removeLiquidity", a, b, req.Stable, req.Amount, minA, minB, w.WalletAddr, deadline)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiquidityTxData{
+		TxData: bozdo.TxData{
+			Data:         data,
+			Value:        big.NewInt(0),
+			ContractAddr: c.Cfg.Velocore.Router,
+		},
+		Approves: []LiquidityApprove{{
+			Token:   pair,
+			Spender: c.Cfg.Velocore.Router,
+			Amount:  req.Amount,
+		}},
+	}, nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/pkg/errors"
 	"github.com/zksync-sdk/zksync2-go/accounts"
 	"github.com/zksync-sdk/zksync2-go/types"
@@ -16,21 +18,40 @@ import (
 type WalletTransactor struct {
 	WalletAddr   common.Address
 	WalletAddrHR string
-	PK           string
-	PKb          []byte
-	Signer       *accounts.BaseSigner
+	// PK, PKb empty when the key is held by the external signer
+	PK  string
+	PKb []byte
+	// Signer signs the 712 txs and the permits of the wallet as eip-712 typed data
+	Signer defi.Signer
 }
 
 func NewWalletTransactor(privateKey string, networkId *big.Int) (*WalletTransactor, error) {
 
+	if defi.IsExternalKey(privateKey) {
+		walletAddr, err := defi.ExternalKeyAddr(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := defi.NewExternalSigner(walletAddr)
+		if err != nil {
+			return nil, err
+		}
+		return &WalletTransactor{
+			WalletAddr:   walletAddr,
+			WalletAddrHR: walletAddr.String(),
+			Signer:       signer,
+		}, nil
+	}
+
 	pkb, err := hex.DecodeString(privateKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "hex.DecodeString")
 	}
-	signer, err := accounts.NewBaseSignerFromRawPrivateKey(pkb, networkId.Int64())
+	pk, err := crypto.ToECDSA(pkb)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "crypto.ToECDSA")
 	}
+	signer := defi.NewKeySigner(pk)
 
 	return &WalletTransactor{
 		WalletAddr:   signer.Address(),
@@ -41,11 +62,15 @@ func NewWalletTransactor(privateKey string, networkId *big.Int) (*WalletTransact
 	}, nil
 }
 
+// Wallet wallet of the sdk, it signs the l1 txs with the private key so the wallet of the external signer has none
 func (c *Client) Wallet(pk string) (*accounts.Wallet, *WalletTransactor, error) {
 	wtx, err := NewWalletTransactor(pk, c.NetworkId)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "newWalletTransactor")
 	}
+	if wtx.PKb == nil {
+		return nil, nil, defi.ErrExternalKeyNotSupported
+	}
 	w, err := accounts.NewWallet(wtx.PKb, &c.ClientL2, c.ClientL1)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "accounts.NewWallet")
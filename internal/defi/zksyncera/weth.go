@@ -9,8 +9,8 @@ import (
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
 	"github.com/hardstylez72/cry/internal/defi/contracts/weth"
+	"github.com/hardstylez72/cry/internal/defi/nonce"
 	"github.com/pkg/errors"
-	"github.com/zksync-sdk/zksync2-go/accounts"
 	"github.com/zksync-sdk/zksync2-go/types"
 	"github.com/zksync-sdk/zksync2-go/utils"
 )
@@ -25,7 +25,7 @@ func (c *Client) SwapWETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRes
 
 func (c *Client) WrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRes, error) {
 
-	w, wtx, err := c.Wallet(req.WalletPK)
+	wtx, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (c *Client) WrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRes,
 
 	tx := &types.CallMsg{
 		CallMsg: ethereum.CallMsg{
-			From:       w.Address(),
+			From:       wtx.WalletAddr,
 			To:         &c.Cfg.Weth,
 			Gas:        0,
 			GasPrice:   big.NewInt(0),
@@ -57,7 +57,7 @@ func (c *Client) WrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRes,
 		},
 	}
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, fmt.Errorf("Make712Tx: %w", err)
 	}
@@ -68,7 +68,7 @@ func (c *Client) WrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRes,
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -79,7 +79,7 @@ func (c *Client) WrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRes,
 
 func (c *Client) UnWrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRes, error) {
 
-	w, wtx, err := c.Wallet(req.WalletPK)
+	wtx, err := NewWalletTransactor(req.WalletPK, c.NetworkId)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func (c *Client) UnWrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRe
 
 	tx := &types.CallMsg{
 		CallMsg: ethereum.CallMsg{
-			From:       w.Address(),
+			From:       wtx.WalletAddr,
 			To:         &c.Cfg.Weth,
 			Gas:        0,
 			GasPrice:   big.NewInt(0),
@@ -111,7 +111,7 @@ func (c *Client) UnWrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRe
 		},
 	}
 
-	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer)
+	raw, estimate, err := c.Make712Tx(ctx, tx, req.Gas, wtx.Signer, req.EstimateOnly)
 	if err != nil {
 		return nil, fmt.Errorf("Make712Tx: %w", err)
 	}
@@ -122,7 +122,7 @@ func (c *Client) UnWrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRe
 		return result, nil
 	}
 
-	hash, err := c.ClientL2.SendRawTransaction(ctx, raw)
+	hash, err := c.sendRawTx(ctx, raw)
 	if err != nil {
 		return nil, errors.Wrap(err, "rpcL2.SendRawTransaction")
 	}
@@ -131,11 +131,28 @@ func (c *Client) UnWrapETH(ctx context.Context, req *defi.WETHReq) (*defi.WETHRe
 	return result, nil
 }
 
-func (c *Client) Make712Tx(ctx context.Context, tx *types.CallMsg, gasOpt *bozdo.Gas, signer *accounts.BaseSigner) ([]byte, *bozdo.EstimatedGasCost, error) {
-	nonce, err := c.ClientL2.NonceAt(ctx, tx.From, nil)
+// Make712Tx signs the tx holding its nonce until sendRawTx sends it, the estimation takes the next nonce only
+func (c *Client) Make712Tx(ctx context.Context, tx *types.CallMsg, gasOpt *bozdo.Gas, signer defi.Signer, estimateOnly bool) ([]byte, *bozdo.EstimatedGasCost, error) {
+	var reserved *nonce.Reservation
+	var next uint64
+	var err error
+	if estimateOnly {
+		next, err = nonce.Default.Next(ctx, c.Cfg.Network, tx.From, c.ClientL2)
+	} else {
+		reserved, err = nonce.Default.Reserve(ctx, c.Cfg.Network, tx.From, c.ClientL2)
+		if reserved != nil {
+			next = reserved.Nonce
+		}
+	}
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to GetGasPrice: %w", err)
+		return nil, nil, err
 	}
+	signed := false
+	defer func() {
+		if !signed {
+			reserved.Done(errNotSent)
+		}
+	}()
 
 	var gas, gasPrice *big.Int
 	if gasOpt.RuleSet() {
@@ -151,12 +168,12 @@ func (c *Client) Make712Tx(ctx context.Context, tx *types.CallMsg, gasOpt *bozdo
 		gasLimit, err := c.ClientL2.EstimateGasL2(ctx, *tx)
 		gas = big.NewInt(0).SetUint64(gasLimit)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to EstimateGas: %w", err)
+			return nil, nil, fmt.Errorf("failed to EstimateGas: %w", defi.EstimateError(err))
 		}
 	}
 
 	prepared := &types.Transaction712{
-		Nonce:      new(big.Int).SetUint64(nonce),
+		Nonce:      new(big.Int).SetUint64(next),
 		GasTipCap:  big.NewInt(100_000_000), // TODO: Estimate correct one
 		GasFeeCap:  gasPrice,
 		Gas:        gas,
@@ -169,7 +186,21 @@ func (c *Client) Make712Tx(ctx context.Context, tx *types.CallMsg, gasOpt *bozdo
 		Meta:       tx.Meta,
 	}
 
-	signature, err := signer.SignTypedData(signer.Domain(), prepared)
+	estimate := &bozdo.EstimatedGasCost{
+		GasLimit:    gas,
+		GasPrice:    gasPrice,
+		TotalGasWei: defi.MinerGasLegacy(gasPrice, gas.Uint64()),
+	}
+	// the estimated tx is never sent, the signer is not asked to sign it
+	if estimateOnly {
+		return nil, estimate, nil
+	}
+
+	typed, err := tx712TypedData(prepared)
+	if err != nil {
+		return nil, nil, err
+	}
+	signature, err := signer.SignTypedData(ctx, typed)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "Signer.SignTypedData")
 	}
@@ -177,11 +208,11 @@ func (c *Client) Make712Tx(ctx context.Context, tx *types.CallMsg, gasOpt *bozdo
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "prepared.RLPValues")
 	}
+	if reserved != nil {
+		holdSigned(rawTx, reserved)
+	}
+	signed = true
 
-	return rawTx, &bozdo.EstimatedGasCost{
-		GasLimit:    gas,
-		GasPrice:    gasPrice,
-		TotalGasWei: defi.MinerGasLegacy(gasPrice, gas.Uint64()),
-	}, nil
+	return rawTx, estimate, nil
 
 }
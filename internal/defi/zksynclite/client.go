@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/pkg/errors"
 )
@@ -34,7 +35,8 @@ const (
 
 func NewClient(c *Config) (*Client, error) {
 
-	rpcClient, err := rpc.DialOptions(context.Background(), c.RPCETHURL, rpc.WithHTTPClient(c.HttpCli))
+	httpCli := rpcpool.NewHTTPClient(c.HttpCli, c.RPCETHURL, rpcpool.NoChecks)
+	rpcClient, err := rpc.DialOptions(context.Background(), rpcpool.Primary(c.RPCETHURL), rpc.WithHTTPClient(httpCli))
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to connect to ETH: "+c.RPCETHURL)
 	}
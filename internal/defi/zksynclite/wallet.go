@@ -6,10 +6,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/pkg/errors"
 )
 
 type WalletTransactor struct {
+	// PrivateKey nil when the key is held by the external signer
 	PrivateKey   *ecdsa.PrivateKey
 	PublicKey    *ecdsa.PublicKey
 	WalletAddr   common.Address
@@ -20,6 +22,18 @@ type WalletTransactor struct {
 
 func newWalletTransactor(privateKey string) (*WalletTransactor, error) {
 
+	// the client reads the account only, the address is all it needs of the wallet the external signer holds
+	if defi.IsExternalKey(privateKey) {
+		walletAddr, err := defi.ExternalKeyAddr(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		return &WalletTransactor{
+			WalletAddr:   walletAddr,
+			WalletAddrHR: walletAddr.String(),
+		}, nil
+	}
+
 	pkb, err := hex.DecodeString(privateKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "crypto.HexToECDSA(privateKey)")
@@ -2,6 +2,7 @@ package pub
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/hardstylez72/cry/internal/exchange/binance"
@@ -17,6 +18,7 @@ var p = Pairs{
 	BNB:   250,
 	ETH:   1900,
 	MATIC: 0.7,
+	METIS: 15,
 }
 
 type Pairs struct {
@@ -24,12 +26,22 @@ type Pairs struct {
 	BNB   float64
 	ETH   float64
 	MATIC float64
+	METIS float64
 }
 
+// updated unix time of the prices read last, zero while the defaults are used
+var updated atomic.Int64
+
 func Price() *Pairs {
 	return &p
 }
 
+// Fresh the prices are read within maxAge, not the defaults
+func Fresh(maxAge time.Duration) bool {
+	at := updated.Load()
+	return at != 0 && time.Since(time.Unix(at, 0)) <= maxAge
+}
+
 func NewIListener() *Listener {
 	return &Listener{
 		cli: binance.NewWsClient(),
@@ -49,6 +61,7 @@ func (l *Listener) Listen(ctx context.Context) {
 				log.Log.Error("pub.Listen.GetData", err)
 			} else {
 				p = *d
+				updated.Store(time.Now().Unix())
 			}
 
 			select {
@@ -85,10 +98,16 @@ func (l *Listener) GetData() (*Pairs, error) {
 		return nil, err
 	}
 
+	metis, err := l.cli.GetCoinPrice(ctx, "METISUSDT")
+	if err != nil {
+		return nil, err
+	}
+
 	return &Pairs{
 		AVAX:  avax,
 		BNB:   bnb,
 		ETH:   eth,
 		MATIC: matic,
+		METIS: metis,
 	}, nil
 }
@@ -112,3 +112,12 @@ func TestRandDurationRange(t *testing.T) {
 		prev = res
 	}
 }
+
+func TestRandName(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		res := RandName()
+		assert.GreaterOrEqual(t, len(res), 5)
+		assert.Regexp(t, "^[a-z]+[0-9]*$", res)
+		assert.NotContains(t, "aeiou", res[len(res)-1:])
+	}
+}
@@ -2,9 +2,16 @@ package lib
 
 import (
 	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 )
 
+const (
+	nameConsonants = "bdfgklmnprstvz"
+	nameVowels     = "aeiou"
+)
+
 func RandFloat(v float64, delta int) float64 {
 
 	mind := (100.0 - float64(delta)) / 100.0
@@ -32,3 +39,21 @@ func RandDurationRange(min, max time.Duration) time.Duration {
 	delay := time.Duration(d)
 	return delay
 }
+
+// RandName random pronounceable lowercase name like "dorivek" or "malupin42", it never ends with a vowel
+func RandName() string {
+	r := rand.New(s)
+
+	var b strings.Builder
+	syllables := 2 + r.Intn(2)
+	for i := 0; i < syllables; i++ {
+		b.WriteByte(nameConsonants[r.Intn(len(nameConsonants))])
+		b.WriteByte(nameVowels[r.Intn(len(nameVowels))])
+	}
+	b.WriteByte(nameConsonants[r.Intn(len(nameConsonants))])
+
+	if r.Intn(2) == 0 {
+		b.WriteString(strconv.Itoa(10 + r.Intn(990)))
+	}
+	return b.String()
+}
@@ -15,7 +15,15 @@ const (
 	StatusFAILED    = "FAILED"
 )
 
-func (s *Service) WaitConfirm(ctx context.Context, txId string) (string, error) {
+type Delivery struct {
+	Status    string
+	DstTxHash string
+	// Error destination tx error of the failed message
+	Error string
+}
+
+// WaitConfirm polls the message of the source tx until it is delivered or failed, in flight status is returned when ctx is done
+func (s *Service) WaitConfirm(ctx context.Context, txId string) (*Delivery, error) {
 
 	ticker := time.NewTicker(time.Second * 10)
 	defer ticker.Stop()
@@ -23,7 +31,7 @@ func (s *Service) WaitConfirm(ctx context.Context, txId string) (string, error)
 	for {
 		select {
 		case <-ctx.Done():
-			return StatusINFLIGHT, nil
+			return &Delivery{Status: StatusINFLIGHT}, nil
 		case <-ticker.C:
 			tx, err := s.GetTxById(ctx, txId)
 			if err != nil {
@@ -31,14 +39,15 @@ func (s *Service) WaitConfirm(ctx context.Context, txId string) (string, error)
 				continue
 			}
 
-			switch tx.Data.Message.Status {
+			m := tx.Data.Message
+			switch m.Status {
 			case StatusINFLIGHT:
 				continue
 			case StatusFAILED:
-				b, _ := json.Marshal(&tx.Data.Message.DstTxError)
-				return StatusFAILED, errors.New(string(b))
+				b, _ := json.Marshal(&m.DstTxError)
+				return &Delivery{Status: StatusFAILED, DstTxHash: m.DstTxHash, Error: string(b)}, nil
 			case StatusDELIVERED:
-				return StatusDELIVERED, nil
+				return &Delivery{Status: StatusDELIVERED, DstTxHash: m.DstTxHash}, nil
 			}
 		}
 	}
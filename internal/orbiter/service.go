@@ -1,39 +1,30 @@
 package orbiter
 
 import (
-	_ "embed"
-	"encoding/json"
+	"context"
 	"math/big"
+	"net/http"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/hardstylez72/cry/internal/lib"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/pkg/errors"
 )
 
-//go:embed chain.json
-var chainsJson []byte
-
-//go:embed maker-1.json
-var maker1 []byte
-
-////go:embed chain.json
-//var maker2 []byte
-//
-////go:embed chain.json
-//var maker3 []byte
-//
-////go:embed chain.json
-//var maker4 []byte
-
 type Service struct {
-	chainMap *lib.BiMap[v1.Network, string]
-	makerMap map[string]map[string]MakerPair
+	c *Config
+
+	mu     sync.RWMutex
+	makers *makerConfig
 }
 
-func NewService() (*Service, error) {
+// NewService loads the maker config from the configured sources, the embedded one is used when they are not set
+func NewService(c *Config) (*Service, error) {
 
 	supportedTokens.Set(v1.Token_ETH)
 	supportedNetworks.Set(v1.Network_ZKSYNCLITE)
@@ -43,22 +34,24 @@ func NewService() (*Service, error) {
 	supportedNetworks.Set(v1.Network_Etherium)
 	supportedNetworks.Set(v1.Network_ZKSYNCERA)
 
-	chains := make([]Chain, 0)
-	if err := json.Unmarshal(chainsJson, &chains); err != nil {
-		return nil, err
+	if c == nil {
+		c = &Config{}
+	}
+	if c.HttpCli == nil {
+		c.HttpCli = &http.Client{Timeout: 30 * time.Second}
 	}
 
-	makerMap := map[string]map[string]MakerPair{}
-	if err := json.Unmarshal(maker1, &makerMap); err != nil {
+	s := &Service{c: c}
+	if err := s.Reload(context.Background()); err != nil {
 		return nil, err
 	}
+	return s, nil
+}
 
-	chainMap := MakeChainMap(chains)
-
-	return &Service{
-		chainMap: chainMap,
-		makerMap: makerMap,
-	}, nil
+func (s *Service) current() *makerConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.makers
 }
 
 func (s *Service) SwapOptions(from, to v1.Network, a, b v1.Token) (*MakerPair, bool) {
@@ -83,7 +76,7 @@ func (s *Service) SwapOptions(from, to v1.Network, a, b v1.Token) (*MakerPair, b
 		return nil, false
 	}
 	key := fromId + "-" + toId
-	coinPairMap, supported := s.makerMap[key]
+	coinPairMap, supported := s.current().makerMap[key]
 	if !supported {
 		return nil, false
 	}
@@ -95,7 +88,7 @@ func (s *Service) SwapOptions(from, to v1.Network, a, b v1.Token) (*MakerPair, b
 	return &opt, true
 }
 func (s *Service) GetNetwork(n v1.Network) (string, bool) {
-	fromNetwork, exist := s.chainMap.Get(n)
+	fromNetwork, exist := s.current().chainMap.Get(n)
 	if !exist {
 		return "", false
 	}
@@ -140,7 +133,7 @@ func (s *Service) GetToTokens(from, to v1.Network, t v1.Token) []v1.Token {
 
 	key := fromNetwork + "-" + toNetwork
 
-	tm, exist := s.makerMap[key]
+	tm, exist := s.current().makerMap[key]
 	if !exist {
 		return out
 	}
@@ -178,19 +171,21 @@ func (s *Service) GetFromTokens(from, to v1.Network) []v1.Token {
 		return out
 	}
 
-	fromNetwork, exist := s.chainMap.Get(from)
+	makers := s.current()
+
+	fromNetwork, exist := makers.chainMap.Get(from)
 	if !exist {
 		return out
 	}
 
-	toNetwork, exist := s.chainMap.Get(to)
+	toNetwork, exist := makers.chainMap.Get(to)
 	if !exist {
 		return out
 	}
 
 	key := fromNetwork + "-" + toNetwork
 
-	tm, exist := s.makerMap[key]
+	tm, exist := s.current().makerMap[key]
 	if !exist {
 		return out
 	}
@@ -247,6 +242,8 @@ type MakeTxReq struct {
 	FromToken   v1.Token
 	ToToken     v1.Token
 	Amount      *big.Int
+	// Expected maker the cost was estimated with, the tx is refused if the current config has another one
+	Expected *Tx
 }
 
 type Chain struct {
@@ -304,25 +301,37 @@ type Tx struct {
 	Value4DigitCode   string
 	MakerReceiverAddr string
 	MakerSenderAddr   string
+	// Value amount with the identification code, the one to send to the maker
+	Value *big.Int
+	// Received amount the maker is expected to send on the destination chain
+	Received *big.Int
+	// Maker pair and the config version it was taken from
+	Maker   MakerPair
+	Version string
 }
 
 func (s *Service) MakeTx(req *MakeTxReq) (*Tx, error) {
+	makers := s.current()
+
 	opt, ok := s.SwapOptions(req.FromNetwork, req.ToNetwork, req.FromToken, req.ToToken)
 	if !ok {
 		return nil, errors.New("swap is not possible")
 	}
 
-	am, _ := WeiToToken(req.Amount, req.FromToken).Float64()
+	if req.Expected != nil && req.Expected.Maker != *opt {
+		return nil, errors.New("orbiter maker config changed from version " + req.Expected.Version + " to " + makers.version + ", refusing to send funds to maker " + opt.MakerAddress)
+	}
 
-	if am <= opt.MinPrice {
-		return nil, errors.New("amount to transfer is less than minimum: " + lib.FloatToString(opt.MinPrice))
+	if !common.IsHexAddress(opt.MakerAddress) {
+		return nil, errors.New("invalid orbiter maker address: " + opt.MakerAddress)
 	}
 
-	if am >= opt.MaxPrice {
-		return nil, errors.New("amount to transfer is more than maximum: " + lib.FloatToString(opt.MaxPrice))
+	now := time.Now().Unix()
+	if int64(opt.StartTime) > now || (opt.EndTime > 0 && opt.EndTime < now) {
+		return nil, errors.New("orbiter maker is not active: " + opt.MakerAddress)
 	}
 
-	code, ok := s.chainMap.Get(req.ToNetwork)
+	code, ok := makers.chainMap.Get(req.ToNetwork)
 	if !ok {
 		return nil, errors.New("swap is not possible")
 	}
@@ -332,21 +341,83 @@ func (s *Service) MakeTx(req *MakeTxReq) (*Tx, error) {
 		return nil, errors.New("swap is not possible")
 	}
 
-	tmp := 9000 + c
+	tmp := strconv.Itoa(9000 + c)
+
+	// the code replaces the lowest digits, limits are checked against what is actually sent
+	value := WrapValueWei(req.Amount, tmp)
+	dst, ok := s.DecodeValueWei(value)
+	if !ok || dst != req.ToNetwork {
+		return nil, errors.New("orbiter identification code of " + value.String() + " does not point to " + req.ToNetwork.String())
+	}
+
+	am, _ := WeiToToken(value, req.FromToken).Float64()
+
+	if am <= opt.MinPrice {
+		return nil, errors.New("amount to transfer is less than minimum: " + lib.FloatToString(opt.MinPrice))
+	}
+
+	if am >= opt.MaxPrice {
+		return nil, errors.New("amount to transfer is more than maximum: " + lib.FloatToString(opt.MaxPrice))
+	}
+
+	received, err := ReceivedAmount(opt, value, req.ToToken)
+	if err != nil {
+		return nil, err
+	}
+	if received.Sign() <= 0 {
+		return nil, errors.New("amount to transfer does not cover orbiter fees")
+	}
 
 	return &Tx{
-		Value4DigitCode:   strconv.Itoa(tmp),
+		Value4DigitCode:   tmp,
 		MakerReceiverAddr: opt.MakerAddress,
 		MakerSenderAddr:   opt.Sender,
+		Value:             value,
+		Received:          received,
+		Maker:             *opt,
+		Version:           makers.version,
 	}, nil
 }
 
+// DecodeValueWei destination network of the amount, the maker reads it from the last 4 digits as 9000 + internal id
+func (s *Service) DecodeValueWei(value *big.Int) (v1.Network, bool) {
+	str := value.String()
+	if len(str) < 4 {
+		return v1.Network_Etherium, false
+	}
+	code, err := strconv.Atoi(str[len(str)-4:])
+	if err != nil || code < 9000 {
+		return v1.Network_Etherium, false
+	}
+	return s.current().chainMap.GetKey(strconv.Itoa(code - 9000))
+}
+
+// ReceivedAmount what the maker sends for the value: trading fee is withheld and gas fee is taken in per mille of the value
+func ReceivedAmount(opt *MakerPair, value *big.Int, token v1.Token) (*big.Int, error) {
+	gasFee, err := strconv.ParseFloat(strings.Trim(string(opt.GasFee), `"`), 64)
+	if err != nil {
+		return nil, errors.New("invalid orbiter gas fee: " + string(opt.GasFee))
+	}
+
+	v := new(big.Float).SetInt(value)
+	fee := new(big.Float).Mul(v, big.NewFloat(gasFee/1000))
+	v.Sub(v, fee)
+	v.Sub(v, new(big.Float).Mul(big.NewFloat(opt.TradingFee), tokenDecimals(token)))
+
+	out, _ := v.Int(nil)
+	return out, nil
+}
+
 func WeiToToken(wei *big.Int, token v1.Token) *big.Float {
+	return new(big.Float).Quo(new(big.Float).SetInt(wei), tokenDecimals(token))
+}
+
+func tokenDecimals(token v1.Token) *big.Float {
 	switch token {
 	case v1.Token_USDT, v1.Token_USDC:
-		return new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether*1e-12))
+		return big.NewFloat(params.Ether * 1e-12)
 	default:
-		return new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether))
+		return big.NewFloat(params.Ether)
 	}
 }
 
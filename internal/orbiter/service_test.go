@@ -4,10 +4,12 @@ import (
 	"math/big"
 	"reflect"
 	"testing"
+
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 )
 
 func TestNewService(t *testing.T) {
-	NewService()
+	NewService(nil)
 }
 
 func TestWrapValueWei(t *testing.T) {
@@ -45,3 +47,41 @@ func TestWrapValueWei(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeTxExpectedMaker(t *testing.T) {
+	s, err := NewService(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &MakeTxReq{
+		FromNetwork: v1.Network_ARBITRUM,
+		ToNetwork:   v1.Network_OPTIMISM,
+		FromToken:   v1.Token_ETH,
+		ToToken:     v1.Token_ETH,
+		Amount:      big.NewInt(1e17),
+	}
+	tx, err := s.MakeTx(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dst, ok := s.DecodeValueWei(tx.Value); !ok || dst != req.ToNetwork {
+		t.Errorf("DecodeValueWei() = %v, want %v", dst, req.ToNetwork)
+	}
+	if tx.Received.Cmp(tx.Value) >= 0 {
+		t.Errorf("Received = %v, want less than %v", tx.Received, tx.Value)
+	}
+
+	req.Expected = tx
+	if _, err := s.MakeTx(req); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := *tx
+	changed.Maker.TradingFee++
+	req.Expected = &changed
+	if _, err := s.MakeTx(req); err == nil {
+		t.Error("MakeTx() sends to a maker the config does not have")
+	}
+}
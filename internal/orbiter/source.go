@@ -0,0 +1,212 @@
+package orbiter
+
+import (
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hardstylez72/cry/internal/lib"
+	"github.com/hardstylez72/cry/internal/log"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+//go:embed chain.json
+var chainsJson []byte
+
+//go:embed maker-1.json
+var maker1 []byte
+
+const embeddedSource = "embedded"
+
+type Config struct {
+	// ChainSource and MakerSource file path or http url of chain.json and maker json, embedded ones when empty
+	ChainSource string
+	MakerSource string
+	// ReloadInterval how often Run reloads the sources, no reload when 0
+	ReloadInterval time.Duration
+	HttpCli        *http.Client
+}
+
+type makerConfig struct {
+	chainMap *lib.BiMap[v1.Network, string]
+	makerMap map[string]map[string]MakerPair
+
+	version  string
+	source   string
+	loadedAt time.Time
+}
+
+type ConfigVersion struct {
+	Version  string
+	Source   string
+	LoadedAt time.Time
+}
+
+// Version of the maker config in use, hash of the loaded chain and maker json
+func (s *Service) Version() ConfigVersion {
+	c := s.current()
+	return ConfigVersion{
+		Version:  c.version,
+		Source:   c.source,
+		LoadedAt: c.loadedAt,
+	}
+}
+
+// Reload reads and validates the sources, the config in use is kept if they are invalid
+func (s *Service) Reload(ctx context.Context) error {
+	chainsRaw, err := s.read(ctx, s.c.ChainSource, chainsJson)
+	if err != nil {
+		return errors.Wrap(err, "orbiter chain config")
+	}
+	makersRaw, err := s.read(ctx, s.c.MakerSource, maker1)
+	if err != nil {
+		return errors.Wrap(err, "orbiter maker config")
+	}
+
+	c, err := parseMakerConfig(chainsRaw, makersRaw)
+	if err != nil {
+		return err
+	}
+	c.source = sourceName(s.c.MakerSource)
+	c.loadedAt = time.Now()
+
+	s.mu.Lock()
+	prev := s.makers
+	s.makers = c
+	s.mu.Unlock()
+
+	if prev == nil || prev.version != c.version {
+		log.Log.Info("orbiter maker config loaded", zap.String("version", c.version), zap.String("source", c.source))
+	}
+	return nil
+}
+
+// Run reloads the config every ReloadInterval until ctx is done
+func (s *Service) Run(ctx context.Context) {
+	if s.c.ReloadInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(s.c.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Reload(ctx); err != nil {
+				log.Log.Error("orbiter.Reload", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *Service) read(ctx context.Context, source string, embedded []byte) ([]byte, error) {
+	if source == "" {
+		return embedded, nil
+	}
+
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.ReadFile(source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.c.HttpCli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.New(source + ": " + res.Status)
+	}
+	return body, nil
+}
+
+func parseMakerConfig(chainsRaw, makersRaw []byte) (*makerConfig, error) {
+	chains := make([]Chain, 0)
+	if err := json.Unmarshal(chainsRaw, &chains); err != nil {
+		return nil, errors.Wrap(err, "invalid orbiter chain config")
+	}
+
+	makerMap := map[string]map[string]MakerPair{}
+	if err := json.Unmarshal(makersRaw, &makerMap); err != nil {
+		return nil, errors.Wrap(err, "invalid orbiter maker config")
+	}
+
+	chainMap := MakeChainMap(chains)
+	if err := validateMakerConfig(chainMap, makerMap); err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	h.Write(chainsRaw)
+	h.Write(makersRaw)
+
+	return &makerConfig{
+		chainMap: chainMap,
+		makerMap: makerMap,
+		version:  hex.EncodeToString(h.Sum(nil))[:12],
+	}, nil
+}
+
+func validateMakerConfig(chainMap *lib.BiMap[v1.Network, string], makerMap map[string]map[string]MakerPair) error {
+	if len(chainMap.Keys()) == 0 {
+		return errors.New("orbiter chain config has no known networks")
+	}
+	for _, n := range chainMap.Keys() {
+		id, _ := chainMap.Get(n)
+		if _, err := strconv.Atoi(id); err != nil {
+			return errors.New("invalid orbiter internal id of " + n.String() + ": " + id)
+		}
+	}
+
+	if len(makerMap) == 0 {
+		return errors.New("orbiter maker config is empty")
+	}
+	for route, pairs := range makerMap {
+		if len(strings.Split(route, "-")) != 2 {
+			return errors.New("invalid orbiter maker route: " + route)
+		}
+		for pair, m := range pairs {
+			name := route + " " + pair
+			if len(strings.Split(pair, "-")) != 2 {
+				return errors.New("invalid orbiter maker pair: " + name)
+			}
+			if m.MakerAddress == "" {
+				return errors.New("orbiter maker has no address: " + name)
+			}
+			if m.MinPrice < 0 || m.MaxPrice <= m.MinPrice {
+				return errors.New("invalid orbiter maker limits: " + name)
+			}
+			if m.TradingFee < 0 {
+				return errors.New("invalid orbiter maker trading fee: " + name)
+			}
+		}
+	}
+	return nil
+}
+
+func sourceName(source string) string {
+	if source == "" {
+		return embeddedSource
+	}
+	return source
+}
@@ -128,6 +128,8 @@ type Flow struct {
 	NextId    *string                `protobuf:"bytes,6,opt,name=next_id,json=nextId,proto3,oneof" json:"next_id,omitempty"`
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// tokens are approved for the amount the task spends instead of the whole balance
+	ExactApprove *bool `protobuf:"varint,9,opt,name=exact_approve,json=exactApprove,proto3,oneof" json:"exact_approve,omitempty"`
 }
 
 func (x *Flow) Reset() {
@@ -204,6 +206,13 @@ func (x *Flow) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Flow) GetExactApprove() bool {
+	if x != nil && x.ExactApprove != nil {
+		return *x.ExactApprove
+	}
+	return false
+}
+
 type WalletByWalletMode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -302,6 +311,26 @@ type Task struct {
 	//	*Task_MySwapTask
 	//	*Task_ProtosSwapTask
 	//	*Task_StarkNetBridgeTask
+	//	*Task_LendSupplyTask
+	//	*Task_LendWithdrawTask
+	//	*Task_MuteioLPTask
+	//	*Task_SpaceFiLPTask
+	//	*Task_VelocoreLPTask
+	//	*Task_IzumiLPTask
+	//	*Task_MaverickLPTask
+	//	*Task_ZkSyncNameServiceMintTask
+	//	*Task_EraNameMintTask
+	//	*Task_StarkIdMintTask
+	//	*Task_LayerZeroBridgeTask
+	//	*Task_StgLockTask
+	//	*Task_StgExtendLockTask
+	//	*Task_StargateLiquidityTask
+	//	*Task_AcrossBridgeTask
+	//	*Task_HopBridgeTask
+	//	*Task_SynapseBridgeTask
+	//	*Task_OfficialBridgeDepositTask
+	//	*Task_OfficialBridgeWithdrawTask
+	//	*Task_RevokeApprovalsTask
 	Task isTask_Task `protobuf_oneof:"task"`
 }
 
@@ -596,6 +625,146 @@ func (x *Task) GetStarkNetBridgeTask() *LiquidityBridgeTask {
 	return nil
 }
 
+func (x *Task) GetLendSupplyTask() *LendTask {
+	if x, ok := x.GetTask().(*Task_LendSupplyTask); ok {
+		return x.LendSupplyTask
+	}
+	return nil
+}
+
+func (x *Task) GetLendWithdrawTask() *LendTask {
+	if x, ok := x.GetTask().(*Task_LendWithdrawTask); ok {
+		return x.LendWithdrawTask
+	}
+	return nil
+}
+
+func (x *Task) GetMuteioLPTask() *DefaultLP {
+	if x, ok := x.GetTask().(*Task_MuteioLPTask); ok {
+		return x.MuteioLPTask
+	}
+	return nil
+}
+
+func (x *Task) GetSpaceFiLPTask() *DefaultLP {
+	if x, ok := x.GetTask().(*Task_SpaceFiLPTask); ok {
+		return x.SpaceFiLPTask
+	}
+	return nil
+}
+
+func (x *Task) GetVelocoreLPTask() *DefaultLP {
+	if x, ok := x.GetTask().(*Task_VelocoreLPTask); ok {
+		return x.VelocoreLPTask
+	}
+	return nil
+}
+
+func (x *Task) GetIzumiLPTask() *DefaultLP {
+	if x, ok := x.GetTask().(*Task_IzumiLPTask); ok {
+		return x.IzumiLPTask
+	}
+	return nil
+}
+
+func (x *Task) GetMaverickLPTask() *DefaultLP {
+	if x, ok := x.GetTask().(*Task_MaverickLPTask); ok {
+		return x.MaverickLPTask
+	}
+	return nil
+}
+
+func (x *Task) GetZkSyncNameServiceMintTask() *DomainMintTask {
+	if x, ok := x.GetTask().(*Task_ZkSyncNameServiceMintTask); ok {
+		return x.ZkSyncNameServiceMintTask
+	}
+	return nil
+}
+
+func (x *Task) GetEraNameMintTask() *DomainMintTask {
+	if x, ok := x.GetTask().(*Task_EraNameMintTask); ok {
+		return x.EraNameMintTask
+	}
+	return nil
+}
+
+func (x *Task) GetStarkIdMintTask() *DomainMintTask {
+	if x, ok := x.GetTask().(*Task_StarkIdMintTask); ok {
+		return x.StarkIdMintTask
+	}
+	return nil
+}
+
+func (x *Task) GetLayerZeroBridgeTask() *LayerZeroBridgeTask {
+	if x, ok := x.GetTask().(*Task_LayerZeroBridgeTask); ok {
+		return x.LayerZeroBridgeTask
+	}
+	return nil
+}
+
+func (x *Task) GetStgLockTask() *StgLockTask {
+	if x, ok := x.GetTask().(*Task_StgLockTask); ok {
+		return x.StgLockTask
+	}
+	return nil
+}
+
+func (x *Task) GetStgExtendLockTask() *StgLockTask {
+	if x, ok := x.GetTask().(*Task_StgExtendLockTask); ok {
+		return x.StgExtendLockTask
+	}
+	return nil
+}
+
+func (x *Task) GetStargateLiquidityTask() *StargateLiquidityTask {
+	if x, ok := x.GetTask().(*Task_StargateLiquidityTask); ok {
+		return x.StargateLiquidityTask
+	}
+	return nil
+}
+
+func (x *Task) GetAcrossBridgeTask() *DefaultBridge {
+	if x, ok := x.GetTask().(*Task_AcrossBridgeTask); ok {
+		return x.AcrossBridgeTask
+	}
+	return nil
+}
+
+func (x *Task) GetHopBridgeTask() *DefaultBridge {
+	if x, ok := x.GetTask().(*Task_HopBridgeTask); ok {
+		return x.HopBridgeTask
+	}
+	return nil
+}
+
+func (x *Task) GetSynapseBridgeTask() *DefaultBridge {
+	if x, ok := x.GetTask().(*Task_SynapseBridgeTask); ok {
+		return x.SynapseBridgeTask
+	}
+	return nil
+}
+
+func (x *Task) GetOfficialBridgeDepositTask() *OfficialBridgeTask {
+	if x, ok := x.GetTask().(*Task_OfficialBridgeDepositTask); ok {
+		return x.OfficialBridgeDepositTask
+	}
+	return nil
+}
+
+func (x *Task) GetOfficialBridgeWithdrawTask() *OfficialBridgeTask {
+	if x, ok := x.GetTask().(*Task_OfficialBridgeWithdrawTask); ok {
+		return x.OfficialBridgeWithdrawTask
+	}
+	return nil
+}
+
+func (x *Task) GetRevokeApprovalsTask() *RevokeApprovalsTask {
+	if x, ok := x.GetTask().(*Task_RevokeApprovalsTask); ok {
+		return x.RevokeApprovalsTask
+	}
+	return nil
+}
+
 type isTask_Task interface {
 	isTask_Task()
 }
@@ -732,6 +901,86 @@ type Task_StarkNetBridgeTask struct {
 	StarkNetBridgeTask *LiquidityBridgeTask `protobuf:"bytes,36,opt,name=starkNetBridgeTask,proto3,oneof"`
 }
 
+type Task_LendSupplyTask struct {
+	LendSupplyTask *LendTask `protobuf:"bytes,37,opt,name=lendSupplyTask,proto3,oneof"`
+}
+
+type Task_LendWithdrawTask struct {
+	LendWithdrawTask *LendTask `protobuf:"bytes,38,opt,name=lendWithdrawTask,proto3,oneof"`
+}
+
+type Task_MuteioLPTask struct {
+	MuteioLPTask *DefaultLP `protobuf:"bytes,39,opt,name=muteioLPTask,proto3,oneof"`
+}
+
+type Task_SpaceFiLPTask struct {
+	SpaceFiLPTask *DefaultLP `protobuf:"bytes,40,opt,name=spaceFiLPTask,proto3,oneof"`
+}
+
+type Task_VelocoreLPTask struct {
+	VelocoreLPTask *DefaultLP `protobuf:"bytes,41,opt,name=velocoreLPTask,proto3,oneof"`
+}
+
+type Task_IzumiLPTask struct {
+	IzumiLPTask *DefaultLP `protobuf:"bytes,42,opt,name=izumiLPTask,proto3,oneof"`
+}
+
+type Task_MaverickLPTask struct {
+	MaverickLPTask *DefaultLP `protobuf:"bytes,43,opt,name=maverickLPTask,proto3,oneof"`
+}
+
+type Task_ZkSyncNameServiceMintTask struct {
+	ZkSyncNameServiceMintTask *DomainMintTask `protobuf:"bytes,44,opt,name=zkSyncNameServiceMintTask,proto3,oneof"`
+}
+
+type Task_EraNameMintTask struct {
+	EraNameMintTask *DomainMintTask `protobuf:"bytes,45,opt,name=eraNameMintTask,proto3,oneof"`
+}
+
+type Task_StarkIdMintTask struct {
+	StarkIdMintTask *DomainMintTask `protobuf:"bytes,46,opt,name=starkIdMintTask,proto3,oneof"`
+}
+
+type Task_LayerZeroBridgeTask struct {
+	LayerZeroBridgeTask *LayerZeroBridgeTask `protobuf:"bytes,47,opt,name=layerZeroBridgeTask,proto3,oneof"`
+}
+
+type Task_StgLockTask struct {
+	StgLockTask *StgLockTask `protobuf:"bytes,48,opt,name=stgLockTask,proto3,oneof"`
+}
+
+type Task_StgExtendLockTask struct {
+	StgExtendLockTask *StgLockTask `protobuf:"bytes,49,opt,name=stgExtendLockTask,proto3,oneof"`
+}
+
+type Task_StargateLiquidityTask struct {
+	StargateLiquidityTask *StargateLiquidityTask `protobuf:"bytes,50,opt,name=stargateLiquidityTask,proto3,oneof"`
+}
+
+type Task_AcrossBridgeTask struct {
+	AcrossBridgeTask *DefaultBridge `protobuf:"bytes,51,opt,name=acrossBridgeTask,proto3,oneof"`
+}
+
+type Task_HopBridgeTask struct {
+	HopBridgeTask *DefaultBridge `protobuf:"bytes,52,opt,name=hopBridgeTask,proto3,oneof"`
+}
+
+type Task_SynapseBridgeTask struct {
+	SynapseBridgeTask *DefaultBridge `protobuf:"bytes,53,opt,name=synapseBridgeTask,proto3,oneof"`
+}
+
+type Task_OfficialBridgeDepositTask struct {
+	OfficialBridgeDepositTask *OfficialBridgeTask `protobuf:"bytes,54,opt,name=officialBridgeDepositTask,proto3,oneof"`
+}
+
+type Task_OfficialBridgeWithdrawTask struct {
+	OfficialBridgeWithdrawTask *OfficialBridgeTask `protobuf:"bytes,55,opt,name=officialBridgeWithdrawTask,proto3,oneof"`
+}
+
+type Task_RevokeApprovalsTask struct {
+	RevokeApprovalsTask *RevokeApprovalsTask `protobuf:"bytes,56,opt,name=revokeApprovalsTask,proto3,oneof"`
+}
+
 func (*Task_StargateBridgeTask) isTask_Task() {}
 
 func (*Task_MockTask) isTask_Task() {}
@@ -798,13 +1047,54 @@ func (*Task_ProtosSwapTask) isTask_Task() {}
 
 func (*Task_StarkNetBridgeTask) isTask_Task() {}
 
+func (*Task_LendSupplyTask) isTask_Task() {}
+
+func (*Task_LendWithdrawTask) isTask_Task() {}
+
+func (*Task_MuteioLPTask) isTask_Task() {}
+
+func (*Task_SpaceFiLPTask) isTask_Task() {}
+
+func (*Task_VelocoreLPTask) isTask_Task() {}
+
+func (*Task_IzumiLPTask) isTask_Task() {}
+
+func (*Task_MaverickLPTask) isTask_Task() {}
+
+func (*Task_ZkSyncNameServiceMintTask) isTask_Task() {}
+
+func (*Task_EraNameMintTask) isTask_Task() {}
+
+func (*Task_StarkIdMintTask) isTask_Task() {}
+
+func (*Task_LayerZeroBridgeTask) isTask_Task() {}
+
+func (*Task_StgLockTask) isTask_Task() {}
+
+func (*Task_StgExtendLockTask) isTask_Task() {}
+
+func (*Task_StargateLiquidityTask) isTask_Task() {}
+
+func (*Task_AcrossBridgeTask) isTask_Task() {}
+
+func (*Task_HopBridgeTask) isTask_Task() {}
+
+func (*Task_SynapseBridgeTask) isTask_Task() {}
+
+func (*Task_OfficialBridgeDepositTask) isTask_Task() {}
+
+func (*Task_OfficialBridgeWithdrawTask) isTask_Task() {}
+
+func (*Task_RevokeApprovalsTask) isTask_Task() {}
+
 type CreateFlowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Label string  `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Tasks []*Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Label        string  `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Tasks        []*Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	ExactApprove *bool   `protobuf:"varint,3,opt,name=exact_approve,json=exactApprove,proto3,oneof" json:"exact_approve,omitempty"`
 }
 
 func (x *CreateFlowRequest) Reset() {
@@ -853,6 +1143,13 @@ func (x *CreateFlowRequest) GetTasks() []*Task {
 	return nil
 }
 
+func (x *CreateFlowRequest) GetExactApprove() bool {
+	if x != nil && x.ExactApprove != nil {
+		return *x.ExactApprove
+	}
+	return false
+}
+
 type UpdateFlowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1184,7 +1481,7 @@ var file_v1_flow_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52,
 	0x04, 0x66, 0x6c, 0x6f, 0x77, 0x3a, 0x0c, 0x92, 0x41, 0x09, 0x0a, 0x07, 0xd2, 0x01, 0x04, 0x66,
-	0x6c, 0x6f, 0x77, 0x22, 0xb5, 0x02, 0x0a, 0x04, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02,
+	0x6c, 0x6f, 0x77, 0x22, 0xf1, 0x02, 0x0a, 0x04, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
 	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
 	0x65, 0x6c, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
@@ -1198,237 +1495,330 @@ var file_v1_flow_proto_rawDesc = []byte{
 	0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
-	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x3a, 0x31, 0x92,
-	0x41, 0x2e, 0x0a, 0x2c, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0xd2, 0x01, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0xd2, 0x01, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0xd2, 0x01, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x12,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74, 0x77, 0x65,
-	0x65, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x13, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74,
-	0x77, 0x65, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74,
-	0x77, 0x65, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x3a, 0x36, 0x92, 0x41, 0x33, 0x0a, 0x31, 0xd2, 0x01, 0x13, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42,
-	0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0xd2, 0x01, 0x18,
-	0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfc, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x61, 0x73,
-	0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x74, 0x61, 0x73,
-	0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52,
-	0x12, 0x73, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x2d, 0x0a, 0x09, 0x6d, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x73, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d, 0x6f,
-	0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f, 0x63, 0x6b, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65,
-	0x6c, 0x61, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x61, 0x79,
-	0x54, 0x61, 0x73, 0x6b, 0x12, 0x50, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00,
-	0x52, 0x14, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x41, 0x0a, 0x0f, 0x6f, 0x6b, 0x65, 0x78, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f, 0x6b, 0x65, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x0f, 0x6f, 0x6b, 0x65, 0x78, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x53, 0x0a, 0x15, 0x74, 0x65, 0x73,
-	0x74, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x54, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x77, 0x61,
-	0x70, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x15, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x44,
-	0x0a, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61,
-	0x73, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x48, 0x00, 0x52, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x12, 0x44, 0x0a, 0x10, 0x6f, 0x6b, 0x65, 0x78, 0x42, 0x69, 0x6e, 0x61,
-	0x6e, 0x61, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f, 0x6b, 0x65, 0x78, 0x42, 0x69, 0x6e, 0x61, 0x6e, 0x61,
-	0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x6b, 0x65, 0x78, 0x42, 0x69,
-	0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x77,
-	0x61, 0x70, 0x31, 0x69, 0x6e, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x31, 0x69, 0x6e,
-	0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x31, 0x69,
-	0x6e, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x53,
-	0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70,
-	0x48, 0x00, 0x52, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x7a, 0x0a, 0x22, 0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69,
-	0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74,
-	0x61, 0x73, 0x6b, 0x2e, 0x5a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69,
-	0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65,
-	0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x22, 0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63,
-	0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f,
-	0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x47, 0x0a, 0x11,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x0d, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0c, 0x65, 0x78, 0x61, 0x63, 0x74, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x88, 0x01, 0x01, 0x3a, 0x31, 0x92, 0x41, 0x2e, 0x0a, 0x2c, 0xd2, 0x01,
+	0x02, 0x69, 0x64, 0xd2, 0x01, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0xd2, 0x01, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0xd2, 0x01, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0xd2, 0x01, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x30,
+	0x0a, 0x13, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73,
+	0x12, 0x3a, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x18, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x36, 0x92, 0x41,
+	0x33, 0x0a, 0x31, 0xd2, 0x01, 0x13, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x65, 0x74, 0x77, 0x65,
+	0x65, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0xd2, 0x01, 0x18, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd1, 0x1d, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x12, 0x73, 0x74, 0x61,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x2d, 0x0a, 0x09, 0x6d, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x61,
+	0x73, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x30,
+	0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54,
+	0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x50, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x14, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x41, 0x0a, 0x0f, 0x6f, 0x6b, 0x65, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x4f, 0x6b, 0x65, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x48, 0x00, 0x52, 0x0f, 0x6f, 0x6b, 0x65, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x53, 0x0a, 0x15, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x48, 0x00, 0x52, 0x15, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x10,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x44, 0x0a, 0x10, 0x6f, 0x6b, 0x65, 0x78, 0x42, 0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x4f, 0x6b, 0x65, 0x78, 0x42, 0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x6b, 0x65, 0x78, 0x42, 0x69, 0x6e, 0x61, 0x6e, 0x61,
+	0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x31, 0x69,
+	0x6e, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x31, 0x69, 0x6e, 0x63, 0x68, 0x54, 0x61,
+	0x73, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x31, 0x69, 0x6e, 0x63, 0x68, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0c,
+	0x73, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x7a, 0x0a, 0x22,
+	0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61,
+	0x73, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x5a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61,
+	0x73, 0x6b, 0x48, 0x00, 0x52, 0x22, 0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x47, 0x0a, 0x11, 0x6f, 0x72, 0x62, 0x69,
+	0x74, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74,
+	0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x11,
 	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f,
-	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x48, 0x00, 0x52, 0x11, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x80, 0x01, 0x0a, 0x24, 0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63,
-	0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x5a, 0x6b, 0x53, 0x79,
-	0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x46, 0x72, 0x6f, 0x6d, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b,
-	0x48, 0x00, 0x52, 0x24, 0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69,
-	0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x74, 0x68, 0x65,
-	0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x45, 0x54, 0x48,
-	0x54, 0x61, 0x73, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73,
-	0x6b, 0x2e, 0x57, 0x45, 0x54, 0x48, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x77, 0x45,
-	0x54, 0x48, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0e, 0x6d, 0x75, 0x74, 0x65, 0x69, 0x6f,
-	0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x75, 0x74, 0x65, 0x69, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x39, 0x0a, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x4c,
-	0x50, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x50, 0x48, 0x00, 0x52, 0x0e,
-	0x73, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x4c, 0x50, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3f,
-	0x0a, 0x10, 0x6d, 0x61, 0x76, 0x65, 0x72, 0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x10, 0x6d,
-	0x61, 0x76, 0x65, 0x72, 0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x3d, 0x0a, 0x0f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0f, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3f,
-	0x0a, 0x10, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x10, 0x76,
-	0x65, 0x6c, 0x6f, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x39, 0x0a, 0x0d, 0x69, 0x7a, 0x75, 0x6d, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x7a, 0x75,
-	0x6d, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0e, 0x76, 0x65,
-	0x53, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53,
-	0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x41, 0x0a, 0x11, 0x65, 0x7a, 0x6b, 0x61, 0x6c,
-	0x69, 0x62, 0x75, 0x72, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x19, 0x20, 0x01,
+	0x6b, 0x12, 0x80, 0x01, 0x0a, 0x24, 0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x5a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x24,
+	0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x45, 0x54, 0x48, 0x54, 0x61, 0x73, 0x6b,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x57, 0x45,
+	0x54, 0x48, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x77, 0x45, 0x54, 0x48, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0e, 0x6d, 0x75, 0x74, 0x65, 0x69, 0x6f, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x61, 0x73, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52,
+	0x0e, 0x6d, 0x75, 0x74, 0x65, 0x69, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x39, 0x0a, 0x0e, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x4c, 0x50, 0x54, 0x61, 0x73,
+	0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x50, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x79, 0x6e, 0x63,
+	0x53, 0x77, 0x61, 0x70, 0x4c, 0x50, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3f, 0x0a, 0x10, 0x6d, 0x61,
+	0x76, 0x65, 0x72, 0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x61, 0x76, 0x65, 0x72,
+	0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3d, 0x0a, 0x0f, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x46, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3f, 0x0a, 0x10, 0x76, 0x65,
+	0x6c, 0x6f, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x10, 0x76, 0x65, 0x6c, 0x6f, 0x63,
+	0x6f, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x39, 0x0a, 0x0d, 0x69,
+	0x7a, 0x75, 0x6d, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x17, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x11, 0x65, 0x7a, 0x6b, 0x61, 0x6c, 0x69, 0x62,
-	0x75, 0x72, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x0a, 0x0a, 0x7a, 0x6b,
-	0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x7a, 0x75, 0x6d, 0x69, 0x53, 0x77,
+	0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0e, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x63,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x41, 0x0a, 0x11, 0x65, 0x7a, 0x6b, 0x61, 0x6c, 0x69, 0x62, 0x75, 0x72,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
 	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x48, 0x00, 0x52, 0x0a, 0x7a, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x41, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4a, 0x6f, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x54, 0x61, 0x73, 0x6b, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73,
+	0x70, 0x48, 0x00, 0x52, 0x11, 0x65, 0x7a, 0x6b, 0x61, 0x6c, 0x69, 0x62, 0x75, 0x72, 0x53, 0x77,
+	0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x0a, 0x0a, 0x7a, 0x6b, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x61, 0x73, 0x6b, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73,
 	0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52,
-	0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4a, 0x6f, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x62, 0x0a, 0x1a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x79, 0x4d, 0x69, 0x6e, 0x74,
-	0x41, 0x6e, 0x64, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x46, 0x54, 0x54, 0x61, 0x73, 0x6b,
-	0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d, 0x65,
-	0x72, 0x6b, 0x6c, 0x79, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x4e, 0x46, 0x54, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x1a, 0x6d, 0x65, 0x72, 0x6b,
-	0x6c, 0x79, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e,
-	0x46, 0x54, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x5f, 0x0a, 0x19, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x0a, 0x7a, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x41, 0x0a, 0x11, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x4a, 0x6f, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x11, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x4a, 0x6f, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x62,
+	0x0a, 0x1a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x79, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x46, 0x54, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x79,
+	0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x46, 0x54,
+	0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x1a, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x79, 0x4d, 0x69,
+	0x6e, 0x74, 0x41, 0x6e, 0x64, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x46, 0x54, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x5f, 0x0a, 0x19, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x72,
+	0x6b, 0x4e, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x19, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
 	0x53, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
-	0x61, 0x73, 0x6b, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61, 0x73, 0x6b,
-	0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x19, 0x64, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x31,
-	0x30, 0x6b, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x07, 0x73,
-	0x77, 0x61, 0x70, 0x31, 0x30, 0x6b, 0x12, 0x3d, 0x0a, 0x0f, 0x70, 0x61, 0x6e, 0x63, 0x61, 0x6b,
-	0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77,
-	0x61, 0x70, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x61, 0x6e, 0x63, 0x61, 0x6b, 0x65, 0x53, 0x77, 0x61,
-	0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x69, 0x74, 0x68, 0x53, 0x77, 0x61,
-	0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00,
-	0x52, 0x0c, 0x73, 0x69, 0x74, 0x68, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x37,
-	0x0a, 0x0c, 0x6a, 0x65, 0x64, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x21,
+	0x61, 0x73, 0x6b, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x31, 0x30, 0x6b, 0x18, 0x1e,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x65, 0x64, 0x69, 0x53,
-	0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x79, 0x53, 0x77, 0x61,
-	0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x31,
+	0x30, 0x6b, 0x12, 0x3d, 0x0a, 0x0f, 0x70, 0x61, 0x6e, 0x63, 0x61, 0x6b, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
 	0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00,
-	0x52, 0x0a, 0x6d, 0x79, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x23,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x74, 0x61,
-	0x72, 0x6b, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18,
-	0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x48, 0x00, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x3a, 0x27, 0x92, 0x41, 0x24, 0x0a, 0x22, 0xd2, 0x01, 0x06,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0xd2, 0x01, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70,
-	0x65, 0xd2, 0x01, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x06, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x62, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74,
-	0x61, 0x73, 0x6b, 0x73, 0x3a, 0x15, 0x92, 0x41, 0x12, 0x0a, 0x10, 0xd2, 0x01, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0xd2, 0x01, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x41, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77,
-	0x3a, 0x0c, 0x92, 0x41, 0x09, 0x0a, 0x07, 0xd2, 0x01, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x42,
-	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x04,
-	0x66, 0x6c, 0x6f, 0x77, 0x3a, 0x0c, 0x92, 0x41, 0x09, 0x0a, 0x07, 0xd2, 0x01, 0x04, 0x66, 0x6c,
-	0x6f, 0x77, 0x22, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c,
-	0x6f, 0x77, 0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x3a, 0x0c, 0x92, 0x41, 0x09, 0x0a, 0x07, 0xd2,
-	0x01, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c,
-	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
-	0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66,
-	0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a,
-	0x0d, 0x92, 0x41, 0x0a, 0x0a, 0x08, 0xd2, 0x01, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x2f,
-	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22,
-	0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xed, 0x03, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
-	0x6c, 0x6f, 0x77, 0x12, 0x17, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66,
-	0x6c, 0x6f, 0x77, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01,
-	0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c,
-	0x6f, 0x77, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x62, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x17, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6c,
-	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76,
-	0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x56, 0x0a,
-	0x07, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a,
-	0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f,
-	0x77, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x5a, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x6f,
-	0x77, 0x12, 0x15, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x6f,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x6c, 0x69, 0x73,
-	0x74, 0x12, 0x62, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x12,
-	0x17, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0f, 0x70, 0x61, 0x6e, 0x63, 0x61, 0x6b, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x69, 0x74, 0x68, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x69,
+	0x74, 0x68, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x37, 0x0a, 0x0c, 0x6a, 0x65,
+	0x64, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x6a, 0x65, 0x64, 0x69, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x79, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x79,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x53, 0x77, 0x61,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65,
+	0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x12,
+	0x73, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x38, 0x0a, 0x0e, 0x6c, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x54, 0x61, 0x73, 0x6b, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x4c, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x0e, 0x6c, 0x65,
+	0x6e, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3c, 0x0a, 0x10,
+	0x6c, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x61, 0x73, 0x6b,
+	0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x65,
+	0x6e, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x10, 0x6c, 0x65, 0x6e, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x35, 0x0a, 0x0c, 0x6d, 0x75,
+	0x74, 0x65, 0x69, 0x6f, 0x4c, 0x50, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c,
+	0x50, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x75, 0x74, 0x65, 0x69, 0x6f, 0x4c, 0x50, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x4c, 0x50, 0x54, 0x61,
+	0x73, 0x6b, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x50, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x46, 0x69, 0x4c, 0x50, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x39, 0x0a, 0x0e, 0x76, 0x65,
+	0x6c, 0x6f, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x50, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x29, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x4c, 0x50, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x6f, 0x72, 0x65, 0x4c,
+	0x50, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x0a, 0x0b, 0x69, 0x7a, 0x75, 0x6d, 0x69, 0x4c, 0x50,
+	0x54, 0x61, 0x73, 0x6b, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x50, 0x48, 0x00, 0x52, 0x0b, 0x69,
+	0x7a, 0x75, 0x6d, 0x69, 0x4c, 0x50, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x39, 0x0a, 0x0e, 0x6d, 0x61,
+	0x76, 0x65, 0x72, 0x69, 0x63, 0x6b, 0x4c, 0x50, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x4c, 0x50, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x76, 0x65, 0x72, 0x69, 0x63, 0x6b, 0x4c,
+	0x50, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x54, 0x0a, 0x19, 0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4e,
+	0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00,
+	0x52, 0x19, 0x7a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x40, 0x0a, 0x0f, 0x65,
+	0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x72,
+	0x61, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x40, 0x0a,
+	0x0f, 0x73, 0x74, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x4d, 0x0a, 0x13, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5a, 0x65, 0x72, 0x6f, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x35,
+	0x0a, 0x0b, 0x73, 0x74, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x30, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x67, 0x4c, 0x6f,
+	0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x67, 0x4c, 0x6f, 0x63,
+	0x6b, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x41, 0x0a, 0x11, 0x73, 0x74, 0x67, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x54,
+	0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x11, 0x73, 0x74, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x61, 0x73,
+	0x6b, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x15, 0x73, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x41, 0x0a,
+	0x10, 0x61, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x61, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x3b, 0x0a, 0x0d, 0x68, 0x6f, 0x70, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d,
+	0x68, 0x6f, 0x70, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x43, 0x0a,
+	0x11, 0x73, 0x79, 0x6e, 0x61, 0x70, 0x73, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x11, 0x73, 0x79, 0x6e, 0x61, 0x70, 0x73, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x58, 0x0a, 0x19, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x18,
+	0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48,
+	0x00, 0x52, 0x19, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x5a, 0x0a, 0x1a,
+	0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x1a, 0x6f, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x4d, 0x0a, 0x13, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x18,
+	0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x54, 0x61, 0x73, 0x6b,
+	0x48, 0x00, 0x52, 0x13, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x3a, 0x27, 0x92, 0x41, 0x24, 0x0a, 0x22, 0xd2, 0x01,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0xd2, 0x01, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0xd2, 0x01, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x06, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x9e, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x0c, 0x65, 0x78, 0x61, 0x63, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x88, 0x01, 0x01,
+	0x3a, 0x15, 0x92, 0x41, 0x12, 0x0a, 0x10, 0xd2, 0x01, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0xd2,
+	0x01, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x41, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x3a, 0x0c,
+	0x92, 0x41, 0x09, 0x0a, 0x07, 0xd2, 0x01, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x42, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x04, 0x66, 0x6c,
+	0x6f, 0x77, 0x3a, 0x0c, 0x92, 0x41, 0x09, 0x0a, 0x07, 0xd2, 0x01, 0x04, 0x66, 0x6c, 0x6f, 0x77,
+	0x22, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77,
+	0x52, 0x04, 0x66, 0x6c, 0x6f, 0x77, 0x3a, 0x0c, 0x92, 0x41, 0x09, 0x0a, 0x07, 0xd2, 0x01, 0x04,
+	0x66, 0x6c, 0x6f, 0x77, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x3a, 0x0d, 0x92,
+	0x41, 0x0a, 0x0a, 0x08, 0xd2, 0x01, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x2f, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x3a, 0x0a, 0x92, 0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xed, 0x03, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f,
+	0x77, 0x12, 0x17, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22,
+	0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77,
+	0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x62, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x17, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x47, 0x65,
+	0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f,
+	0x67, 0x65, 0x74, 0x12, 0x5a, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x12,
+	0x15, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x62, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x17, 0x2e,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1478,6 +1868,14 @@ var file_v1_flow_proto_goTypes = []interface{}{
 	(*MerklyMintAndBridgeNFTTask)(nil),           // 30: task.MerklyMintAndBridgeNFTTask
 	(*DeployStarkNetAccountTask)(nil),            // 31: task.DeployStarkNetAccountTask
 	(*LiquidityBridgeTask)(nil),                  // 32: task.LiquidityBridgeTask
+	(*LendTask)(nil),                             // 33: task.LendTask
+	(*DomainMintTask)(nil),                       // 34: task.DomainMintTask
+	(*LayerZeroBridgeTask)(nil),                  // 35: task.LayerZeroBridgeTask
+	(*StgLockTask)(nil),                          // 36: task.StgLockTask
+	(*StargateLiquidityTask)(nil),                // 37: task.StargateLiquidityTask
+	(*DefaultBridge)(nil),                        // 38: task.DefaultBridge
+	(*OfficialBridgeTask)(nil),                   // 39: task.OfficialBridgeTask
+	(*RevokeApprovalsTask)(nil),                  // 40: task.RevokeApprovalsTask
 }
 var file_v1_flow_proto_depIdxs = []int32{
 	2,  // 0: flow.GetFlowResponse.flow:type_name -> flow.Flow
@@ -1518,26 +1916,46 @@ var file_v1_flow_proto_depIdxs = []int32{
 	24, // 35: flow.Task.mySwapTask:type_name -> task.DefaultSwap
 	24, // 36: flow.Task.protosSwapTask:type_name -> task.DefaultSwap
 	32, // 37: flow.Task.starkNetBridgeTask:type_name -> task.LiquidityBridgeTask
-	4,  // 38: flow.CreateFlowRequest.tasks:type_name -> flow.Task
-	2,  // 39: flow.UpdateFlowRequest.flow:type_name -> flow.Flow
-	2,  // 40: flow.UpdateFlowResponse.flow:type_name -> flow.Flow
-	2,  // 41: flow.CreateFlowResponse.flow:type_name -> flow.Flow
-	2,  // 42: flow.ListFlowResponse.flows:type_name -> flow.Flow
-	6,  // 43: flow.FlowService.UpdateFlow:input_type -> flow.UpdateFlowRequest
-	5,  // 44: flow.FlowService.CreateFlow:input_type -> flow.CreateFlowRequest
-	0,  // 45: flow.FlowService.GetFlow:input_type -> flow.GetFlowRequest
-	9,  // 46: flow.FlowService.ListFlow:input_type -> flow.ListFlowRequest
-	11, // 47: flow.FlowService.DeleteFlow:input_type -> flow.DeleteFlowRequest
-	7,  // 48: flow.FlowService.UpdateFlow:output_type -> flow.UpdateFlowResponse
-	8,  // 49: flow.FlowService.CreateFlow:output_type -> flow.CreateFlowResponse
-	1,  // 50: flow.FlowService.GetFlow:output_type -> flow.GetFlowResponse
-	10, // 51: flow.FlowService.ListFlow:output_type -> flow.ListFlowResponse
-	12, // 52: flow.FlowService.DeleteFlow:output_type -> flow.DeleteFlowResponse
-	48, // [48:53] is the sub-list for method output_type
-	43, // [43:48] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	33, // 38: flow.Task.lendSupplyTask:type_name -> task.LendTask
+	33, // 39: flow.Task.lendWithdrawTask:type_name -> task.LendTask
+	29, // 40: flow.Task.muteioLPTask:type_name -> task.DefaultLP
+	29, // 41: flow.Task.spaceFiLPTask:type_name -> task.DefaultLP
+	29, // 42: flow.Task.velocoreLPTask:type_name -> task.DefaultLP
+	29, // 43: flow.Task.izumiLPTask:type_name -> task.DefaultLP
+	29, // 44: flow.Task.maverickLPTask:type_name -> task.DefaultLP
+	34, // 45: flow.Task.zkSyncNameServiceMintTask:type_name -> task.DomainMintTask
+	34, // 46: flow.Task.eraNameMintTask:type_name -> task.DomainMintTask
+	34, // 47: flow.Task.starkIdMintTask:type_name -> task.DomainMintTask
+	35, // 48: flow.Task.layerZeroBridgeTask:type_name -> task.LayerZeroBridgeTask
+	36, // 49: flow.Task.stgLockTask:type_name -> task.StgLockTask
+	36, // 50: flow.Task.stgExtendLockTask:type_name -> task.StgLockTask
+	37, // 51: flow.Task.stargateLiquidityTask:type_name -> task.StargateLiquidityTask
+	38, // 52: flow.Task.acrossBridgeTask:type_name -> task.DefaultBridge
+	38, // 53: flow.Task.hopBridgeTask:type_name -> task.DefaultBridge
+	38, // 54: flow.Task.synapseBridgeTask:type_name -> task.DefaultBridge
+	39, // 55: flow.Task.officialBridgeDepositTask:type_name -> task.OfficialBridgeTask
+	39, // 56: flow.Task.officialBridgeWithdrawTask:type_name -> task.OfficialBridgeTask
+	40, // 57: flow.Task.revokeApprovalsTask:type_name -> task.RevokeApprovalsTask
+	4,  // 58: flow.CreateFlowRequest.tasks:type_name -> flow.Task
+	2,  // 59: flow.UpdateFlowRequest.flow:type_name -> flow.Flow
+	2,  // 60: flow.UpdateFlowResponse.flow:type_name -> flow.Flow
+	2,  // 61: flow.CreateFlowResponse.flow:type_name -> flow.Flow
+	2,  // 62: flow.ListFlowResponse.flows:type_name -> flow.Flow
+	6,  // 63: flow.FlowService.UpdateFlow:input_type -> flow.UpdateFlowRequest
+	5,  // 64: flow.FlowService.CreateFlow:input_type -> flow.CreateFlowRequest
+	0,  // 65: flow.FlowService.GetFlow:input_type -> flow.GetFlowRequest
+	9,  // 66: flow.FlowService.ListFlow:input_type -> flow.ListFlowRequest
+	11, // 67: flow.FlowService.DeleteFlow:input_type -> flow.DeleteFlowRequest
+	7,  // 68: flow.FlowService.UpdateFlow:output_type -> flow.UpdateFlowResponse
+	8,  // 69: flow.FlowService.CreateFlow:output_type -> flow.CreateFlowResponse
+	1,  // 70: flow.FlowService.GetFlow:output_type -> flow.GetFlowResponse
+	10, // 71: flow.FlowService.ListFlow:output_type -> flow.ListFlowResponse
+	12, // 72: flow.FlowService.DeleteFlow:output_type -> flow.DeleteFlowResponse
+	68, // [68:73] is the sub-list for method output_type
+	63, // [63:68] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_v1_flow_proto_init() }
@@ -1740,7 +2158,28 @@ func file_v1_flow_proto_init() {
 		(*Task_MySwapTask)(nil),
 		(*Task_ProtosSwapTask)(nil),
 		(*Task_StarkNetBridgeTask)(nil),
-	}
+		(*Task_LendSupplyTask)(nil),
+		(*Task_LendWithdrawTask)(nil),
+		(*Task_MuteioLPTask)(nil),
+		(*Task_SpaceFiLPTask)(nil),
+		(*Task_VelocoreLPTask)(nil),
+		(*Task_IzumiLPTask)(nil),
+		(*Task_MaverickLPTask)(nil),
+		(*Task_ZkSyncNameServiceMintTask)(nil),
+		(*Task_EraNameMintTask)(nil),
+		(*Task_StarkIdMintTask)(nil),
+		(*Task_LayerZeroBridgeTask)(nil),
+		(*Task_StgLockTask)(nil),
+		(*Task_StgExtendLockTask)(nil),
+		(*Task_StargateLiquidityTask)(nil),
+		(*Task_AcrossBridgeTask)(nil),
+		(*Task_HopBridgeTask)(nil),
+		(*Task_SynapseBridgeTask)(nil),
+		(*Task_OfficialBridgeDepositTask)(nil),
+		(*Task_OfficialBridgeWithdrawTask)(nil),
+		(*Task_RevokeApprovalsTask)(nil),
+	}
+	file_v1_flow_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -873,6 +873,9 @@ type EstimationTx struct {
 	GasValuePercent string      `protobuf:"bytes,6,opt,name=gasValuePercent,proto3" json:"gasValuePercent,omitempty"`
 	Name            string      `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
 	Details         []*TxDetail `protobuf:"bytes,8,rep,name=details,proto3" json:"details,omitempty"`
+	// base_fee and priority_fee of the eip-1559 tx, gas_price is its fee cap
+	BaseFee     *AmUni `protobuf:"bytes,9,opt,name=base_fee,json=baseFee,proto3,oneof" json:"base_fee,omitempty"`
+	PriorityFee *AmUni `protobuf:"bytes,10,opt,name=priority_fee,json=priorityFee,proto3,oneof" json:"priority_fee,omitempty"`
 }
 
 func (x *EstimationTx) Reset() {
@@ -963,6 +966,20 @@ func (x *EstimationTx) GetDetails() []*TxDetail {
 	return nil
 }
 
+func (x *EstimationTx) GetBaseFee() *AmUni {
+	if x != nil {
+		return x.BaseFee
+	}
+	return nil
+}
+
+func (x *EstimationTx) GetPriorityFee() *AmUni {
+	if x != nil {
+		return x.PriorityFee
+	}
+	return nil
+}
+
 type CancelProcessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1319,6 +1336,107 @@ func (x *SkipProcessTaskRequest) GetProfileId() string {
 	return ""
 }
 
+type ReplaceTaskTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReplaceTaskTxResponse) Reset() {
+	*x = ReplaceTaskTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_process_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceTaskTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceTaskTxResponse) ProtoMessage() {}
+
+func (x *ReplaceTaskTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_process_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceTaskTxResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceTaskTxResponse) Descriptor() ([]byte, []int) {
+	return file_v1_process_proto_rawDescGZIP(), []int{20}
+}
+
+type ReplaceTaskTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId string            `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TxId   string            `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Type   TxReplacementType `protobuf:"varint,3,opt,name=type,proto3,enum=task.TxReplacementType" json:"type,omitempty"`
+}
+
+func (x *ReplaceTaskTxRequest) Reset() {
+	*x = ReplaceTaskTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_process_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceTaskTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceTaskTxRequest) ProtoMessage() {}
+
+func (x *ReplaceTaskTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_process_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceTaskTxRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceTaskTxRequest) Descriptor() ([]byte, []int) {
+	return file_v1_process_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReplaceTaskTxRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReplaceTaskTxRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ReplaceTaskTxRequest) GetType() TxReplacementType {
+	if x != nil {
+		return x.Type
+	}
+	return TxReplacementType_TxSpeedUp
+}
+
 type GetProcessTaskHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1330,7 +1448,7 @@ type GetProcessTaskHistoryRequest struct {
 func (x *GetProcessTaskHistoryRequest) Reset() {
 	*x = GetProcessTaskHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[20]
+		mi := &file_v1_process_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1343,7 +1461,7 @@ func (x *GetProcessTaskHistoryRequest) String() string {
 func (*GetProcessTaskHistoryRequest) ProtoMessage() {}
 
 func (x *GetProcessTaskHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[20]
+	mi := &file_v1_process_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1356,7 +1474,7 @@ func (x *GetProcessTaskHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProcessTaskHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetProcessTaskHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{20}
+	return file_v1_process_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetProcessTaskHistoryRequest) GetTaskId() string {
@@ -1377,7 +1495,7 @@ type GetProcessTaskHistoryResponse struct {
 func (x *GetProcessTaskHistoryResponse) Reset() {
 	*x = GetProcessTaskHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[21]
+		mi := &file_v1_process_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1390,7 +1508,7 @@ func (x *GetProcessTaskHistoryResponse) String() string {
 func (*GetProcessTaskHistoryResponse) ProtoMessage() {}
 
 func (x *GetProcessTaskHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[21]
+	mi := &file_v1_process_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,7 +1521,7 @@ func (x *GetProcessTaskHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProcessTaskHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetProcessTaskHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{21}
+	return file_v1_process_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetProcessTaskHistoryResponse) GetRecords() []*ProcessTaskHistoryRecord {
@@ -1424,7 +1542,7 @@ type StopProcessRequest struct {
 func (x *StopProcessRequest) Reset() {
 	*x = StopProcessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[22]
+		mi := &file_v1_process_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1437,7 +1555,7 @@ func (x *StopProcessRequest) String() string {
 func (*StopProcessRequest) ProtoMessage() {}
 
 func (x *StopProcessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[22]
+	mi := &file_v1_process_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,7 +1568,7 @@ func (x *StopProcessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopProcessRequest.ProtoReflect.Descriptor instead.
 func (*StopProcessRequest) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{22}
+	return file_v1_process_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *StopProcessRequest) GetProcessId() string {
@@ -1471,7 +1589,7 @@ type ResumeProcessRequest struct {
 func (x *ResumeProcessRequest) Reset() {
 	*x = ResumeProcessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[23]
+		mi := &file_v1_process_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1484,7 +1602,7 @@ func (x *ResumeProcessRequest) String() string {
 func (*ResumeProcessRequest) ProtoMessage() {}
 
 func (x *ResumeProcessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[23]
+	mi := &file_v1_process_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,7 +1615,7 @@ func (x *ResumeProcessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeProcessRequest.ProtoReflect.Descriptor instead.
 func (*ResumeProcessRequest) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{23}
+	return file_v1_process_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ResumeProcessRequest) GetProcessId() string {
@@ -1516,7 +1634,7 @@ type ResumeProcessResponse struct {
 func (x *ResumeProcessResponse) Reset() {
 	*x = ResumeProcessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[24]
+		mi := &file_v1_process_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1529,7 +1647,7 @@ func (x *ResumeProcessResponse) String() string {
 func (*ResumeProcessResponse) ProtoMessage() {}
 
 func (x *ResumeProcessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[24]
+	mi := &file_v1_process_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,7 +1660,7 @@ func (x *ResumeProcessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeProcessResponse.ProtoReflect.Descriptor instead.
 func (*ResumeProcessResponse) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{24}
+	return file_v1_process_proto_rawDescGZIP(), []int{26}
 }
 
 type StopProcessResponse struct {
@@ -1554,7 +1672,7 @@ type StopProcessResponse struct {
 func (x *StopProcessResponse) Reset() {
 	*x = StopProcessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[25]
+		mi := &file_v1_process_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1567,7 +1685,7 @@ func (x *StopProcessResponse) String() string {
 func (*StopProcessResponse) ProtoMessage() {}
 
 func (x *StopProcessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[25]
+	mi := &file_v1_process_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1580,7 +1698,7 @@ func (x *StopProcessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopProcessResponse.ProtoReflect.Descriptor instead.
 func (*StopProcessResponse) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{25}
+	return file_v1_process_proto_rawDescGZIP(), []int{27}
 }
 
 type GetProcessUpdatedAtRequest struct {
@@ -1594,7 +1712,7 @@ type GetProcessUpdatedAtRequest struct {
 func (x *GetProcessUpdatedAtRequest) Reset() {
 	*x = GetProcessUpdatedAtRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[26]
+		mi := &file_v1_process_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1607,7 +1725,7 @@ func (x *GetProcessUpdatedAtRequest) String() string {
 func (*GetProcessUpdatedAtRequest) ProtoMessage() {}
 
 func (x *GetProcessUpdatedAtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[26]
+	mi := &file_v1_process_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +1738,7 @@ func (x *GetProcessUpdatedAtRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProcessUpdatedAtRequest.ProtoReflect.Descriptor instead.
 func (*GetProcessUpdatedAtRequest) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{26}
+	return file_v1_process_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetProcessUpdatedAtRequest) GetProcessId() string {
@@ -1641,7 +1759,7 @@ type GetProcessUpdatedAtResponse struct {
 func (x *GetProcessUpdatedAtResponse) Reset() {
 	*x = GetProcessUpdatedAtResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[27]
+		mi := &file_v1_process_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1654,7 +1772,7 @@ func (x *GetProcessUpdatedAtResponse) String() string {
 func (*GetProcessUpdatedAtResponse) ProtoMessage() {}
 
 func (x *GetProcessUpdatedAtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[27]
+	mi := &file_v1_process_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1667,7 +1785,7 @@ func (x *GetProcessUpdatedAtResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProcessUpdatedAtResponse.ProtoReflect.Descriptor instead.
 func (*GetProcessUpdatedAtResponse) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{27}
+	return file_v1_process_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetProcessUpdatedAtResponse) GetUpdatedAt() *timestamppb.Timestamp {
@@ -1690,7 +1808,7 @@ type RetryProcessRequest struct {
 func (x *RetryProcessRequest) Reset() {
 	*x = RetryProcessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[28]
+		mi := &file_v1_process_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1703,7 +1821,7 @@ func (x *RetryProcessRequest) String() string {
 func (*RetryProcessRequest) ProtoMessage() {}
 
 func (x *RetryProcessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[28]
+	mi := &file_v1_process_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,7 +1834,7 @@ func (x *RetryProcessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryProcessRequest.ProtoReflect.Descriptor instead.
 func (*RetryProcessRequest) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{28}
+	return file_v1_process_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RetryProcessRequest) GetProcessId() string {
@@ -1749,7 +1867,7 @@ type RetryProcessResponse struct {
 func (x *RetryProcessResponse) Reset() {
 	*x = RetryProcessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[29]
+		mi := &file_v1_process_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1762,7 +1880,7 @@ func (x *RetryProcessResponse) String() string {
 func (*RetryProcessResponse) ProtoMessage() {}
 
 func (x *RetryProcessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[29]
+	mi := &file_v1_process_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1775,7 +1893,7 @@ func (x *RetryProcessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetryProcessResponse.ProtoReflect.Descriptor instead.
 func (*RetryProcessResponse) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{29}
+	return file_v1_process_proto_rawDescGZIP(), []int{31}
 }
 
 type ListProcessRequest struct {
@@ -1790,7 +1908,7 @@ type ListProcessRequest struct {
 func (x *ListProcessRequest) Reset() {
 	*x = ListProcessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[30]
+		mi := &file_v1_process_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1803,7 +1921,7 @@ func (x *ListProcessRequest) String() string {
 func (*ListProcessRequest) ProtoMessage() {}
 
 func (x *ListProcessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[30]
+	mi := &file_v1_process_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +1934,7 @@ func (x *ListProcessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProcessRequest.ProtoReflect.Descriptor instead.
 func (*ListProcessRequest) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{30}
+	return file_v1_process_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListProcessRequest) GetStatuses() []ProcessStatus {
@@ -1844,7 +1962,7 @@ type ListProcessResponse struct {
 func (x *ListProcessResponse) Reset() {
 	*x = ListProcessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[31]
+		mi := &file_v1_process_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1857,7 +1975,7 @@ func (x *ListProcessResponse) String() string {
 func (*ListProcessResponse) ProtoMessage() {}
 
 func (x *ListProcessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[31]
+	mi := &file_v1_process_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,7 +1988,7 @@ func (x *ListProcessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProcessResponse.ProtoReflect.Descriptor instead.
 func (*ListProcessResponse) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{31}
+	return file_v1_process_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListProcessResponse) GetProcesses() []*Process {
@@ -1891,7 +2009,7 @@ type GetProcessRequest struct {
 func (x *GetProcessRequest) Reset() {
 	*x = GetProcessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[32]
+		mi := &file_v1_process_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1904,7 +2022,7 @@ func (x *GetProcessRequest) String() string {
 func (*GetProcessRequest) ProtoMessage() {}
 
 func (x *GetProcessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[32]
+	mi := &file_v1_process_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,7 +2035,7 @@ func (x *GetProcessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProcessRequest.ProtoReflect.Descriptor instead.
 func (*GetProcessRequest) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{32}
+	return file_v1_process_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetProcessRequest) GetId() string {
@@ -1938,7 +2056,7 @@ type GetProcessResponse struct {
 func (x *GetProcessResponse) Reset() {
 	*x = GetProcessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[33]
+		mi := &file_v1_process_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1951,7 +2069,7 @@ func (x *GetProcessResponse) String() string {
 func (*GetProcessResponse) ProtoMessage() {}
 
 func (x *GetProcessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[33]
+	mi := &file_v1_process_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,7 +2082,7 @@ func (x *GetProcessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProcessResponse.ProtoReflect.Descriptor instead.
 func (*GetProcessResponse) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{33}
+	return file_v1_process_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetProcessResponse) GetProcess() *Process {
@@ -1986,7 +2104,7 @@ type CreateProcessRequest struct {
 func (x *CreateProcessRequest) Reset() {
 	*x = CreateProcessRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[34]
+		mi := &file_v1_process_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1999,7 +2117,7 @@ func (x *CreateProcessRequest) String() string {
 func (*CreateProcessRequest) ProtoMessage() {}
 
 func (x *CreateProcessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[34]
+	mi := &file_v1_process_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,7 +2130,7 @@ func (x *CreateProcessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProcessRequest.ProtoReflect.Descriptor instead.
 func (*CreateProcessRequest) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{34}
+	return file_v1_process_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateProcessRequest) GetFlowId() string {
@@ -2040,7 +2158,7 @@ type CreateProcessResponse struct {
 func (x *CreateProcessResponse) Reset() {
 	*x = CreateProcessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_process_proto_msgTypes[35]
+		mi := &file_v1_process_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2053,7 +2171,7 @@ func (x *CreateProcessResponse) String() string {
 func (*CreateProcessResponse) ProtoMessage() {}
 
 func (x *CreateProcessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_process_proto_msgTypes[35]
+	mi := &file_v1_process_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,7 +2184,7 @@ func (x *CreateProcessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProcessResponse.ProtoReflect.Descriptor instead.
 func (*CreateProcessResponse) Descriptor() ([]byte, []int) {
-	return file_v1_process_proto_rawDescGZIP(), []int{35}
+	return file_v1_process_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CreateProcessResponse) GetProcess() *Process {
@@ -2270,7 +2388,7 @@ var file_v1_process_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x54, 0x78, 0x52, 0x0b, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x97, 0x03, 0x0a, 0x0c,
+	0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9b, 0x04, 0x0a, 0x0c,
 	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x12, 0x27, 0x0a, 0x07,
 	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
 	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x55, 0x6e, 0x69, 0x52, 0x07, 0x62, 0x61,
@@ -2290,151 +2408,178 @@ var file_v1_process_proto_rawDesc = []byte{
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x3a, 0x58, 0x92, 0x41, 0x55,
-	0x0a, 0x53, 0xd2, 0x01, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0xd2, 0x01, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0xd2, 0x01, 0x03, 0x67, 0x61, 0x73, 0xd2, 0x01, 0x09, 0x67, 0x61, 0x73,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xd2, 0x01, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0xd2, 0x01, 0x0f, 0x67, 0x61, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x07, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x49, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x12, 0x92, 0x41,
-	0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
-	0x22, 0x4c, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x12, 0x92, 0x41, 0x0f, 0x0a,
-	0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x22, 0x4b,
-	0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x12, 0x92, 0x41, 0x0f, 0x0a, 0x0d, 0xd2, 0x01,
-	0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53,
+	0x69, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x55, 0x6e, 0x69, 0x48, 0x00, 0x52, 0x07,
+	0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x55, 0x6e, 0x69, 0x48,
+	0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x88, 0x01,
+	0x01, 0x3a, 0x58, 0x92, 0x41, 0x55, 0x0a, 0x53, 0xd2, 0x01, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0xd2, 0x01, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0xd2, 0x01, 0x03, 0x67, 0x61, 0x73,
+	0xd2, 0x01, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xd2, 0x01, 0x09, 0x67,
+	0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0xd2, 0x01, 0x0f, 0x67, 0x61, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0xd2, 0x01, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x49, 0x0a, 0x14, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x3a, 0x12, 0x92, 0x41, 0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x12,
+	0x92, 0x41, 0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x22, 0x4b, 0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x12, 0x92, 0x41, 0x0f,
+	0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x22,
+	0x1a, 0x0a, 0x18, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x19, 0x0a, 0x17, 0x53, 0x6b, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x53,
 	0x6b, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x53, 0x6b, 0x69, 0x70, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x3a, 0x29, 0x92, 0x41, 0x26, 0x0a, 0x24, 0xd2,
-	0x01, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x3a, 0x0f, 0x92, 0x41,
-	0x0c, 0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0x6d, 0x0a,
-	0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
-	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x3a, 0x0f, 0x92, 0x41, 0x0c,
-	0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x47, 0x0a, 0x12,
-	0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
-	0x64, 0x3a, 0x12, 0x92, 0x41, 0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x5f, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x12, 0x92, 0x41,
-	0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64,
-	0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x6f,
-	0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x4f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x3a, 0x29, 0x92, 0x41,
+	0x26, 0x0a, 0x24, 0xd2, 0x01, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x0a,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x91, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b,
+	0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x78, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x3a, 0x1e, 0x92, 0x41, 0x1b, 0x0a, 0x19, 0xd2, 0x01, 0x07, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x48, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x3a, 0x0f, 0x92,
+	0x41, 0x0c, 0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0x6d,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x3a, 0x0f, 0x92, 0x41,
+	0x0c, 0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x47, 0x0a,
+	0x12, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x3a, 0x12, 0x92, 0x41, 0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
 	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x12, 0x92,
 	0x41, 0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69,
-	0x64, 0x22, 0x6c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x12, 0x92, 0x41, 0x0f,
-	0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22,
-	0x97, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x3a, 0x29,
-	0x92, 0x41, 0x26, 0x0a, 0x24, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x69, 0x64, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0xd2,
-	0x01, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x74,
-	0x72, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x7a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x3a, 0x19, 0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01, 0x08, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x65, 0x73, 0xd2, 0x01, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x58, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x3a, 0x11, 0x92, 0x41, 0x0e, 0x0a, 0x0c, 0xd2, 0x01, 0x09, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92, 0x41,
-	0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x3a, 0x0f, 0x92, 0x41, 0x0c, 0x0a,
-	0x0a, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x3a, 0x1d, 0x92,
-	0x41, 0x1a, 0x0a, 0x18, 0xd2, 0x01, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0xd2, 0x01,
-	0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x54, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x3a, 0x0f, 0x92, 0x41, 0x0c, 0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x32, 0xfa, 0x0e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74,
+	0x6f, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x12,
+	0x92, 0x41, 0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x22, 0x6c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x12, 0x92, 0x41,
+	0x0f, 0x0a, 0x0d, 0xd2, 0x01, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x22, 0x97, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x3a,
+	0x29, 0x92, 0x41, 0x26, 0x0a, 0x24, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x69, 0x64, 0xd2, 0x01, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0xd2, 0x01, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x7a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x3a, 0x19, 0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0xd2, 0x01, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x58,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x3a, 0x11, 0x92, 0x41, 0x0e, 0x0a, 0x0c, 0xd2, 0x01, 0x09, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0a, 0x92,
+	0x41, 0x07, 0x0a, 0x05, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x3a, 0x0f, 0x92, 0x41, 0x0c,
+	0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x3a, 0x1d,
+	0x92, 0x41, 0x1a, 0x0a, 0x18, 0xd2, 0x01, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0xd2,
+	0x01, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x54, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x3a, 0x0f, 0x92, 0x41, 0x0c, 0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x32, 0xf9, 0x0f, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
 	0x63, 0x65, 0x73, 0x73, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x6f,
-	0x2d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x89, 0x01,
-	0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74,
-	0x72, 0x79, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a,
-	0x01, 0x2a, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x2d, 0x72, 0x65, 0x74, 0x72,
-	0x79, 0x2f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x7d, 0x0a, 0x0f, 0x53, 0x6b, 0x69,
-	0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1f, 0x2e, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
+	0x77, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x61, 0x75, 0x74,
+	0x6f, 0x2d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x2f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x89,
+	0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a,
+	0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x2d, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x2f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x7d, 0x0a, 0x0f, 0x53, 0x6b,
+	0x69, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1f, 0x2e,
 	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x74,
-	0x61, 0x73, 0x6b, 0x2f, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x6c, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70,
+	0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2f,
+	0x74, 0x61, 0x73, 0x6b, 0x2f, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x7d, 0x0a, 0x0d, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x77, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x78,
+	0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70,
 	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
 	0x73, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x53,
@@ -2544,7 +2689,7 @@ func file_v1_process_proto_rawDescGZIP() []byte {
 	return file_v1_process_proto_rawDescData
 }
 
-var file_v1_process_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_v1_process_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_v1_process_proto_goTypes = []interface{}{
 	(*Transaction)(nil),                   // 0: process.Transaction
 	(*Process)(nil),                       // 1: process.Process
@@ -2566,101 +2711,109 @@ var file_v1_process_proto_goTypes = []interface{}{
 	(*CancelProcessResponse)(nil),         // 17: process.CancelProcessResponse
 	(*SkipProcessTaskResponse)(nil),       // 18: process.SkipProcessTaskResponse
 	(*SkipProcessTaskRequest)(nil),        // 19: process.SkipProcessTaskRequest
-	(*GetProcessTaskHistoryRequest)(nil),  // 20: process.GetProcessTaskHistoryRequest
-	(*GetProcessTaskHistoryResponse)(nil), // 21: process.GetProcessTaskHistoryResponse
-	(*StopProcessRequest)(nil),            // 22: process.StopProcessRequest
-	(*ResumeProcessRequest)(nil),          // 23: process.ResumeProcessRequest
-	(*ResumeProcessResponse)(nil),         // 24: process.ResumeProcessResponse
-	(*StopProcessResponse)(nil),           // 25: process.StopProcessResponse
-	(*GetProcessUpdatedAtRequest)(nil),    // 26: process.GetProcessUpdatedAtRequest
-	(*GetProcessUpdatedAtResponse)(nil),   // 27: process.GetProcessUpdatedAtResponse
-	(*RetryProcessRequest)(nil),           // 28: process.RetryProcessRequest
-	(*RetryProcessResponse)(nil),          // 29: process.RetryProcessResponse
-	(*ListProcessRequest)(nil),            // 30: process.ListProcessRequest
-	(*ListProcessResponse)(nil),           // 31: process.ListProcessResponse
-	(*GetProcessRequest)(nil),             // 32: process.GetProcessRequest
-	(*GetProcessResponse)(nil),            // 33: process.GetProcessResponse
-	(*CreateProcessRequest)(nil),          // 34: process.CreateProcessRequest
-	(*CreateProcessResponse)(nil),         // 35: process.CreateProcessResponse
-	(*timestamppb.Timestamp)(nil),         // 36: google.protobuf.Timestamp
-	(Network)(0),                          // 37: shared.Network
-	(ProcessStatus)(0),                    // 38: shared.ProcessStatus
-	(*Flow)(nil),                          // 39: flow.Flow
-	(*Task)(nil),                          // 40: flow.Task
-	(*AmUni)(nil),                         // 41: shared.AmUni
-	(*TxDetail)(nil),                      // 42: task.TxDetail
+	(*ReplaceTaskTxResponse)(nil),         // 20: process.ReplaceTaskTxResponse
+	(*ReplaceTaskTxRequest)(nil),          // 21: process.ReplaceTaskTxRequest
+	(*GetProcessTaskHistoryRequest)(nil),  // 22: process.GetProcessTaskHistoryRequest
+	(*GetProcessTaskHistoryResponse)(nil), // 23: process.GetProcessTaskHistoryResponse
+	(*StopProcessRequest)(nil),            // 24: process.StopProcessRequest
+	(*ResumeProcessRequest)(nil),          // 25: process.ResumeProcessRequest
+	(*ResumeProcessResponse)(nil),         // 26: process.ResumeProcessResponse
+	(*StopProcessResponse)(nil),           // 27: process.StopProcessResponse
+	(*GetProcessUpdatedAtRequest)(nil),    // 28: process.GetProcessUpdatedAtRequest
+	(*GetProcessUpdatedAtResponse)(nil),   // 29: process.GetProcessUpdatedAtResponse
+	(*RetryProcessRequest)(nil),           // 30: process.RetryProcessRequest
+	(*RetryProcessResponse)(nil),          // 31: process.RetryProcessResponse
+	(*ListProcessRequest)(nil),            // 32: process.ListProcessRequest
+	(*ListProcessResponse)(nil),           // 33: process.ListProcessResponse
+	(*GetProcessRequest)(nil),             // 34: process.GetProcessRequest
+	(*GetProcessResponse)(nil),            // 35: process.GetProcessResponse
+	(*CreateProcessRequest)(nil),          // 36: process.CreateProcessRequest
+	(*CreateProcessResponse)(nil),         // 37: process.CreateProcessResponse
+	(*timestamppb.Timestamp)(nil),         // 38: google.protobuf.Timestamp
+	(Network)(0),                          // 39: shared.Network
+	(ProcessStatus)(0),                    // 40: shared.ProcessStatus
+	(*Flow)(nil),                          // 41: flow.Flow
+	(*Task)(nil),                          // 42: flow.Task
+	(*AmUni)(nil),                         // 43: shared.AmUni
+	(*TxDetail)(nil),                      // 44: task.TxDetail
+	(TxReplacementType)(0),                // 45: task.TxReplacementType
 }
 var file_v1_process_proto_depIdxs = []int32{
-	36, // 0: process.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	37, // 1: process.Transaction.network:type_name -> shared.Network
-	38, // 2: process.Process.status:type_name -> shared.ProcessStatus
+	38, // 0: process.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	39, // 1: process.Transaction.network:type_name -> shared.Network
+	40, // 2: process.Process.status:type_name -> shared.ProcessStatus
 	2,  // 3: process.Process.profiles:type_name -> process.ProcessProfile
-	36, // 4: process.Process.created_at:type_name -> google.protobuf.Timestamp
-	36, // 5: process.Process.updated_at:type_name -> google.protobuf.Timestamp
-	36, // 6: process.Process.finished_at:type_name -> google.protobuf.Timestamp
-	36, // 7: process.Process.started_at:type_name -> google.protobuf.Timestamp
-	36, // 8: process.Process.deleted_at:type_name -> google.protobuf.Timestamp
-	39, // 9: process.Process.flow:type_name -> flow.Flow
+	38, // 4: process.Process.created_at:type_name -> google.protobuf.Timestamp
+	38, // 5: process.Process.updated_at:type_name -> google.protobuf.Timestamp
+	38, // 6: process.Process.finished_at:type_name -> google.protobuf.Timestamp
+	38, // 7: process.Process.started_at:type_name -> google.protobuf.Timestamp
+	38, // 8: process.Process.deleted_at:type_name -> google.protobuf.Timestamp
+	41, // 9: process.Process.flow:type_name -> flow.Flow
 	3,  // 10: process.ProcessProfile.tasks:type_name -> process.ProcessTask
-	38, // 11: process.ProcessProfile.status:type_name -> shared.ProcessStatus
-	40, // 12: process.ProcessTask.task:type_name -> flow.Task
-	38, // 13: process.ProcessTask.status:type_name -> shared.ProcessStatus
-	36, // 14: process.ProcessTask.finished_at:type_name -> google.protobuf.Timestamp
-	36, // 15: process.ProcessTask.started_at:type_name -> google.protobuf.Timestamp
-	36, // 16: process.ProcessTaskHistoryRecord.started_at:type_name -> google.protobuf.Timestamp
-	36, // 17: process.ProcessTaskHistoryRecord.finished_at:type_name -> google.protobuf.Timestamp
-	38, // 18: process.ProcessTaskHistoryRecord.start_status:type_name -> shared.ProcessStatus
-	38, // 19: process.ProcessTaskHistoryRecord.finish_status:type_name -> shared.ProcessStatus
+	40, // 11: process.ProcessProfile.status:type_name -> shared.ProcessStatus
+	42, // 12: process.ProcessTask.task:type_name -> flow.Task
+	40, // 13: process.ProcessTask.status:type_name -> shared.ProcessStatus
+	38, // 14: process.ProcessTask.finished_at:type_name -> google.protobuf.Timestamp
+	38, // 15: process.ProcessTask.started_at:type_name -> google.protobuf.Timestamp
+	38, // 16: process.ProcessTaskHistoryRecord.started_at:type_name -> google.protobuf.Timestamp
+	38, // 17: process.ProcessTaskHistoryRecord.finished_at:type_name -> google.protobuf.Timestamp
+	40, // 18: process.ProcessTaskHistoryRecord.start_status:type_name -> shared.ProcessStatus
+	40, // 19: process.ProcessTaskHistoryRecord.finish_status:type_name -> shared.ProcessStatus
 	0,  // 20: process.GetProfileTransactionsRes.transactions:type_name -> process.Transaction
 	0,  // 21: process.GetTaskTransactionsRes.transactions:type_name -> process.Transaction
 	11, // 22: process.EstimateCostResponse.estimations:type_name -> process.EstimationTx
-	41, // 23: process.EstimationTx.balance:type_name -> shared.AmUni
-	41, // 24: process.EstimationTx.value:type_name -> shared.AmUni
-	41, // 25: process.EstimationTx.gas:type_name -> shared.AmUni
-	41, // 26: process.EstimationTx.gas_limit:type_name -> shared.AmUni
-	41, // 27: process.EstimationTx.gas_price:type_name -> shared.AmUni
-	42, // 28: process.EstimationTx.details:type_name -> task.TxDetail
-	4,  // 29: process.GetProcessTaskHistoryResponse.records:type_name -> process.ProcessTaskHistoryRecord
-	36, // 30: process.GetProcessUpdatedAtResponse.updated_at:type_name -> google.protobuf.Timestamp
-	38, // 31: process.ListProcessRequest.statuses:type_name -> shared.ProcessStatus
-	1,  // 32: process.ListProcessResponse.processes:type_name -> process.Process
-	1,  // 33: process.GetProcessResponse.process:type_name -> process.Process
-	1,  // 34: process.CreateProcessResponse.process:type_name -> process.Process
-	14, // 35: process.ProcessService.EnableAutoRetry:input_type -> process.EnableAutoRetryRequest
-	13, // 36: process.ProcessService.DisableAutoRetry:input_type -> process.DisableAutoRetryRequest
-	19, // 37: process.ProcessService.SkipProcessTask:input_type -> process.SkipProcessTaskRequest
-	22, // 38: process.ProcessService.StopProcess:input_type -> process.StopProcessRequest
-	23, // 39: process.ProcessService.ResumeProcess:input_type -> process.ResumeProcessRequest
-	12, // 40: process.ProcessService.CancelProcess:input_type -> process.CancelProcessRequest
-	34, // 41: process.ProcessService.CreateProcess:input_type -> process.CreateProcessRequest
-	20, // 42: process.ProcessService.GetProcessTaskHistory:input_type -> process.GetProcessTaskHistoryRequest
-	32, // 43: process.ProcessService.GetProcess:input_type -> process.GetProcessRequest
-	26, // 44: process.ProcessService.GetProcessUpdatedAt:input_type -> process.GetProcessUpdatedAtRequest
-	30, // 45: process.ProcessService.ListProcess:input_type -> process.ListProcessRequest
-	28, // 46: process.ProcessService.RetryProcess:input_type -> process.RetryProcessRequest
-	9,  // 47: process.ProcessService.EstimateCost:input_type -> process.EstimateCostRequest
-	7,  // 48: process.ProcessService.GetTaskTransactions:input_type -> process.GetTaskTransactionsReq
-	5,  // 49: process.ProcessService.GetProfileTransactions:input_type -> process.GetProfileTransactionsReq
-	16, // 50: process.ProcessService.EnableAutoRetry:output_type -> process.EnableAutoRetryResponse
-	15, // 51: process.ProcessService.DisableAutoRetry:output_type -> process.DisableAutoRetryResponse
-	18, // 52: process.ProcessService.SkipProcessTask:output_type -> process.SkipProcessTaskResponse
-	25, // 53: process.ProcessService.StopProcess:output_type -> process.StopProcessResponse
-	24, // 54: process.ProcessService.ResumeProcess:output_type -> process.ResumeProcessResponse
-	17, // 55: process.ProcessService.CancelProcess:output_type -> process.CancelProcessResponse
-	35, // 56: process.ProcessService.CreateProcess:output_type -> process.CreateProcessResponse
-	21, // 57: process.ProcessService.GetProcessTaskHistory:output_type -> process.GetProcessTaskHistoryResponse
-	33, // 58: process.ProcessService.GetProcess:output_type -> process.GetProcessResponse
-	27, // 59: process.ProcessService.GetProcessUpdatedAt:output_type -> process.GetProcessUpdatedAtResponse
-	31, // 60: process.ProcessService.ListProcess:output_type -> process.ListProcessResponse
-	29, // 61: process.ProcessService.RetryProcess:output_type -> process.RetryProcessResponse
-	10, // 62: process.ProcessService.EstimateCost:output_type -> process.EstimateCostResponse
-	8,  // 63: process.ProcessService.GetTaskTransactions:output_type -> process.GetTaskTransactionsRes
-	6,  // 64: process.ProcessService.GetProfileTransactions:output_type -> process.GetProfileTransactionsRes
-	50, // [50:65] is the sub-list for method output_type
-	35, // [35:50] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	43, // 23: process.EstimationTx.balance:type_name -> shared.AmUni
+	43, // 24: process.EstimationTx.value:type_name -> shared.AmUni
+	43, // 25: process.EstimationTx.gas:type_name -> shared.AmUni
+	43, // 26: process.EstimationTx.gas_limit:type_name -> shared.AmUni
+	43, // 27: process.EstimationTx.gas_price:type_name -> shared.AmUni
+	44, // 28: process.EstimationTx.details:type_name -> task.TxDetail
+	43, // 29: process.EstimationTx.base_fee:type_name -> shared.AmUni
+	43, // 30: process.EstimationTx.priority_fee:type_name -> shared.AmUni
+	45, // 31: process.ReplaceTaskTxRequest.type:type_name -> task.TxReplacementType
+	4,  // 32: process.GetProcessTaskHistoryResponse.records:type_name -> process.ProcessTaskHistoryRecord
+	38, // 33: process.GetProcessUpdatedAtResponse.updated_at:type_name -> google.protobuf.Timestamp
+	40, // 34: process.ListProcessRequest.statuses:type_name -> shared.ProcessStatus
+	1,  // 35: process.ListProcessResponse.processes:type_name -> process.Process
+	1,  // 36: process.GetProcessResponse.process:type_name -> process.Process
+	1,  // 37: process.CreateProcessResponse.process:type_name -> process.Process
+	14, // 38: process.ProcessService.EnableAutoRetry:input_type -> process.EnableAutoRetryRequest
+	13, // 39: process.ProcessService.DisableAutoRetry:input_type -> process.DisableAutoRetryRequest
+	19, // 40: process.ProcessService.SkipProcessTask:input_type -> process.SkipProcessTaskRequest
+	21, // 41: process.ProcessService.ReplaceTaskTx:input_type -> process.ReplaceTaskTxRequest
+	24, // 42: process.ProcessService.StopProcess:input_type -> process.StopProcessRequest
+	25, // 43: process.ProcessService.ResumeProcess:input_type -> process.ResumeProcessRequest
+	12, // 44: process.ProcessService.CancelProcess:input_type -> process.CancelProcessRequest
+	36, // 45: process.ProcessService.CreateProcess:input_type -> process.CreateProcessRequest
+	22, // 46: process.ProcessService.GetProcessTaskHistory:input_type -> process.GetProcessTaskHistoryRequest
+	34, // 47: process.ProcessService.GetProcess:input_type -> process.GetProcessRequest
+	28, // 48: process.ProcessService.GetProcessUpdatedAt:input_type -> process.GetProcessUpdatedAtRequest
+	32, // 49: process.ProcessService.ListProcess:input_type -> process.ListProcessRequest
+	30, // 50: process.ProcessService.RetryProcess:input_type -> process.RetryProcessRequest
+	9,  // 51: process.ProcessService.EstimateCost:input_type -> process.EstimateCostRequest
+	7,  // 52: process.ProcessService.GetTaskTransactions:input_type -> process.GetTaskTransactionsReq
+	5,  // 53: process.ProcessService.GetProfileTransactions:input_type -> process.GetProfileTransactionsReq
+	16, // 54: process.ProcessService.EnableAutoRetry:output_type -> process.EnableAutoRetryResponse
+	15, // 55: process.ProcessService.DisableAutoRetry:output_type -> process.DisableAutoRetryResponse
+	18, // 56: process.ProcessService.SkipProcessTask:output_type -> process.SkipProcessTaskResponse
+	20, // 57: process.ProcessService.ReplaceTaskTx:output_type -> process.ReplaceTaskTxResponse
+	27, // 58: process.ProcessService.StopProcess:output_type -> process.StopProcessResponse
+	26, // 59: process.ProcessService.ResumeProcess:output_type -> process.ResumeProcessResponse
+	17, // 60: process.ProcessService.CancelProcess:output_type -> process.CancelProcessResponse
+	37, // 61: process.ProcessService.CreateProcess:output_type -> process.CreateProcessResponse
+	23, // 62: process.ProcessService.GetProcessTaskHistory:output_type -> process.GetProcessTaskHistoryResponse
+	35, // 63: process.ProcessService.GetProcess:output_type -> process.GetProcessResponse
+	29, // 64: process.ProcessService.GetProcessUpdatedAt:output_type -> process.GetProcessUpdatedAtResponse
+	33, // 65: process.ProcessService.ListProcess:output_type -> process.ListProcessResponse
+	31, // 66: process.ProcessService.RetryProcess:output_type -> process.RetryProcessResponse
+	10, // 67: process.ProcessService.EstimateCost:output_type -> process.EstimateCostResponse
+	8,  // 68: process.ProcessService.GetTaskTransactions:output_type -> process.GetTaskTransactionsRes
+	6,  // 69: process.ProcessService.GetProfileTransactions:output_type -> process.GetProfileTransactionsRes
+	54, // [54:70] is the sub-list for method output_type
+	38, // [38:54] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_v1_process_proto_init() }
@@ -2914,7 +3067,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessTaskHistoryRequest); i {
+			switch v := v.(*ReplaceTaskTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2926,7 +3079,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessTaskHistoryResponse); i {
+			switch v := v.(*ReplaceTaskTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2938,7 +3091,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopProcessRequest); i {
+			switch v := v.(*GetProcessTaskHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2950,7 +3103,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResumeProcessRequest); i {
+			switch v := v.(*GetProcessTaskHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2962,7 +3115,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResumeProcessResponse); i {
+			switch v := v.(*StopProcessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2974,7 +3127,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopProcessResponse); i {
+			switch v := v.(*ResumeProcessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2986,7 +3139,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessUpdatedAtRequest); i {
+			switch v := v.(*ResumeProcessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2998,7 +3151,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessUpdatedAtResponse); i {
+			switch v := v.(*StopProcessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3010,7 +3163,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetryProcessRequest); i {
+			switch v := v.(*GetProcessUpdatedAtRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3022,7 +3175,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetryProcessResponse); i {
+			switch v := v.(*GetProcessUpdatedAtResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3034,7 +3187,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProcessRequest); i {
+			switch v := v.(*RetryProcessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3046,7 +3199,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProcessResponse); i {
+			switch v := v.(*RetryProcessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3058,7 +3211,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessRequest); i {
+			switch v := v.(*ListProcessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3070,7 +3223,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcessResponse); i {
+			switch v := v.(*ListProcessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3082,7 +3235,7 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProcessRequest); i {
+			switch v := v.(*GetProcessRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3094,6 +3247,30 @@ func file_v1_process_proto_init() {
 			}
 		}
 		file_v1_process_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProcessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_process_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProcessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_process_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateProcessResponse); i {
 			case 0:
 				return &v.state
@@ -3110,13 +3287,14 @@ func file_v1_process_proto_init() {
 	file_v1_process_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_v1_process_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_v1_process_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_v1_process_proto_msgTypes[11].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_process_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
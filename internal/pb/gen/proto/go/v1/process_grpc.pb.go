@@ -25,6 +25,7 @@ type ProcessServiceClient interface {
 	EnableAutoRetry(ctx context.Context, in *EnableAutoRetryRequest, opts ...grpc.CallOption) (*EnableAutoRetryResponse, error)
 	DisableAutoRetry(ctx context.Context, in *DisableAutoRetryRequest, opts ...grpc.CallOption) (*DisableAutoRetryResponse, error)
 	SkipProcessTask(ctx context.Context, in *SkipProcessTaskRequest, opts ...grpc.CallOption) (*SkipProcessTaskResponse, error)
+	ReplaceTaskTx(ctx context.Context, in *ReplaceTaskTxRequest, opts ...grpc.CallOption) (*ReplaceTaskTxResponse, error)
 	StopProcess(ctx context.Context, in *StopProcessRequest, opts ...grpc.CallOption) (*StopProcessResponse, error)
 	ResumeProcess(ctx context.Context, in *ResumeProcessRequest, opts ...grpc.CallOption) (*ResumeProcessResponse, error)
 	CancelProcess(ctx context.Context, in *CancelProcessRequest, opts ...grpc.CallOption) (*CancelProcessResponse, error)
@@ -74,6 +75,15 @@ func (c *processServiceClient) SkipProcessTask(ctx context.Context, in *SkipProc
 	return out, nil
 }
 
+func (c *processServiceClient) ReplaceTaskTx(ctx context.Context, in *ReplaceTaskTxRequest, opts ...grpc.CallOption) (*ReplaceTaskTxResponse, error) {
+	out := new(ReplaceTaskTxResponse)
+	err := c.cc.Invoke(ctx, "/process.ProcessService/ReplaceTaskTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *processServiceClient) StopProcess(ctx context.Context, in *StopProcessRequest, opts ...grpc.CallOption) (*StopProcessResponse, error) {
 	out := new(StopProcessResponse)
 	err := c.cc.Invoke(ctx, "/process.ProcessService/StopProcess", in, out, opts...)
@@ -189,6 +199,7 @@ type ProcessServiceServer interface {
 	EnableAutoRetry(context.Context, *EnableAutoRetryRequest) (*EnableAutoRetryResponse, error)
 	DisableAutoRetry(context.Context, *DisableAutoRetryRequest) (*DisableAutoRetryResponse, error)
 	SkipProcessTask(context.Context, *SkipProcessTaskRequest) (*SkipProcessTaskResponse, error)
+	ReplaceTaskTx(context.Context, *ReplaceTaskTxRequest) (*ReplaceTaskTxResponse, error)
 	StopProcess(context.Context, *StopProcessRequest) (*StopProcessResponse, error)
 	ResumeProcess(context.Context, *ResumeProcessRequest) (*ResumeProcessResponse, error)
 	CancelProcess(context.Context, *CancelProcessRequest) (*CancelProcessResponse, error)
@@ -217,6 +228,9 @@ func (UnimplementedProcessServiceServer) DisableAutoRetry(context.Context, *Disa
 func (UnimplementedProcessServiceServer) SkipProcessTask(context.Context, *SkipProcessTaskRequest) (*SkipProcessTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SkipProcessTask not implemented")
 }
+func (UnimplementedProcessServiceServer) ReplaceTaskTx(context.Context, *ReplaceTaskTxRequest) (*ReplaceTaskTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceTaskTx not implemented")
+}
 func (UnimplementedProcessServiceServer) StopProcess(context.Context, *StopProcessRequest) (*StopProcessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopProcess not implemented")
 }
@@ -320,6 +334,24 @@ func _ProcessService_SkipProcessTask_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProcessService_ReplaceTaskTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceTaskTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessServiceServer).ReplaceTaskTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/process.ProcessService/ReplaceTaskTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessServiceServer).ReplaceTaskTx(ctx, req.(*ReplaceTaskTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProcessService_StopProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopProcessRequest)
 	if err := dec(in); err != nil {
@@ -555,6 +587,10 @@ var ProcessService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SkipProcessTask",
 			Handler:    _ProcessService_SkipProcessTask_Handler,
 		},
+		{
+			MethodName: "ReplaceTaskTx",
+			Handler:    _ProcessService_ReplaceTaskTx_Handler,
+		},
 		{
 			MethodName: "StopProcess",
 			Handler:    _ProcessService_StopProcess_Handler,
@@ -25,6 +25,7 @@ type ProfileServiceClient interface {
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
 	ValidateLabel(ctx context.Context, in *ValidateLabelRequest, opts ...grpc.CallOption) (*ValidateLabelResponse, error)
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	GetApprovals(ctx context.Context, in *GetApprovalsRequest, opts ...grpc.CallOption) (*GetApprovalsResponse, error)
 	SearchProfile(ctx context.Context, in *SearchProfileRequest, opts ...grpc.CallOption) (*SearchProfileResponse, error)
 	SearchProfilesNotConnectedToOkexDeposit(ctx context.Context, in *SearchProfilesNotConnectedToOkexDepositRequest, opts ...grpc.CallOption) (*SearchProfilesNotConnectedToOkexDepositResponse, error)
 	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
@@ -71,6 +72,15 @@ func (c *profileServiceClient) GetBalance(ctx context.Context, in *GetBalanceReq
 	return out, nil
 }
 
+func (c *profileServiceClient) GetApprovals(ctx context.Context, in *GetApprovalsRequest, opts ...grpc.CallOption) (*GetApprovalsResponse, error) {
+	out := new(GetApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/profile.ProfileService/GetApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileServiceClient) SearchProfile(ctx context.Context, in *SearchProfileRequest, opts ...grpc.CallOption) (*SearchProfileResponse, error) {
 	out := new(SearchProfileResponse)
 	err := c.cc.Invoke(ctx, "/profile.ProfileService/SearchProfile", in, out, opts...)
@@ -159,6 +169,7 @@ type ProfileServiceServer interface {
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
 	ValidateLabel(context.Context, *ValidateLabelRequest) (*ValidateLabelResponse, error)
 	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	GetApprovals(context.Context, *GetApprovalsRequest) (*GetApprovalsResponse, error)
 	SearchProfile(context.Context, *SearchProfileRequest) (*SearchProfileResponse, error)
 	SearchProfilesNotConnectedToOkexDeposit(context.Context, *SearchProfilesNotConnectedToOkexDepositRequest) (*SearchProfilesNotConnectedToOkexDepositResponse, error)
 	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
@@ -184,6 +195,9 @@ func (UnimplementedProfileServiceServer) ValidateLabel(context.Context, *Validat
 func (UnimplementedProfileServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
 }
+func (UnimplementedProfileServiceServer) GetApprovals(context.Context, *GetApprovalsRequest) (*GetApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovals not implemented")
+}
 func (UnimplementedProfileServiceServer) SearchProfile(context.Context, *SearchProfileRequest) (*SearchProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchProfile not implemented")
 }
@@ -278,6 +292,24 @@ func _ProfileService_GetBalance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_GetApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.ProfileService/GetApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetApprovals(ctx, req.(*GetApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfileService_SearchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchProfileRequest)
 	if err := dec(in); err != nil {
@@ -459,6 +491,10 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBalance",
 			Handler:    _ProfileService_GetBalance_Handler,
 		},
+		{
+			MethodName: "GetApprovals",
+			Handler:    _ProfileService_GetApprovals_Handler,
+		},
 		{
 			MethodName: "SearchProfile",
 			Handler:    _ProfileService_SearchProfile_Handler,
@@ -25,6 +25,7 @@ type SettingsServiceClient interface {
 	ResetSettings(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
 	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error)
 	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*UpdateSettingsResponse, error)
+	GetRpcStats(ctx context.Context, in *GetRpcStatsRequest, opts ...grpc.CallOption) (*GetRpcStatsResponse, error)
 }
 
 type settingsServiceClient struct {
@@ -62,6 +63,15 @@ func (c *settingsServiceClient) UpdateSettings(ctx context.Context, in *UpdateSe
 	return out, nil
 }
 
+func (c *settingsServiceClient) GetRpcStats(ctx context.Context, in *GetRpcStatsRequest, opts ...grpc.CallOption) (*GetRpcStatsResponse, error) {
+	out := new(GetRpcStatsResponse)
+	err := c.cc.Invoke(ctx, "/settings.SettingsService/GetRpcStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SettingsServiceServer is the server API for SettingsService service.
 // All implementations must embed UnimplementedSettingsServiceServer
 // for forward compatibility
@@ -69,6 +79,7 @@ type SettingsServiceServer interface {
 	ResetSettings(context.Context, *ResetRequest) (*ResetResponse, error)
 	GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error)
 	UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error)
+	GetRpcStats(context.Context, *GetRpcStatsRequest) (*GetRpcStatsResponse, error)
 	mustEmbedUnimplementedSettingsServiceServer()
 }
 
@@ -85,6 +96,9 @@ func (UnimplementedSettingsServiceServer) GetSettings(context.Context, *GetSetti
 func (UnimplementedSettingsServiceServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
 }
+func (UnimplementedSettingsServiceServer) GetRpcStats(context.Context, *GetRpcStatsRequest) (*GetRpcStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRpcStats not implemented")
+}
 func (UnimplementedSettingsServiceServer) mustEmbedUnimplementedSettingsServiceServer() {}
 
 // UnsafeSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +166,24 @@ func _SettingsService_UpdateSettings_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingsService_GetRpcStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRpcStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetRpcStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/settings.SettingsService/GetRpcStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetRpcStats(ctx, req.(*GetRpcStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SettingsService_ServiceDesc is the grpc.ServiceDesc for SettingsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +203,10 @@ var SettingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSettings",
 			Handler:    _SettingsService_UpdateSettings_Handler,
 		},
+		{
+			MethodName: "GetRpcStats",
+			Handler:    _SettingsService_GetRpcStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/settings.proto",
@@ -37,6 +37,9 @@ const (
 	Network_ZKSYNCERATESTNET Network = 8
 	Network_ZKSYNCLITE       Network = 9
 	Network_StarkNet         Network = 10
+	Network_Base             Network = 11
+	Network_Linea            Network = 12
+	Network_Metis            Network = 13
 )
 
 // Enum value maps for Network.
@@ -53,6 +56,9 @@ var (
 		8:  "ZKSYNCERATESTNET",
 		9:  "ZKSYNCLITE",
 		10: "StarkNet",
+		11: "Base",
+		12: "Linea",
+		13: "Metis",
 	}
 	Network_value = map[string]int32{
 		"ARBITRUM":         0,
@@ -66,6 +72,9 @@ var (
 		"ZKSYNCERATESTNET": 8,
 		"ZKSYNCLITE":       9,
 		"StarkNet":         10,
+		"Base":             11,
+		"Linea":            12,
+		"Metis":            13,
 	}
 )
 
@@ -115,6 +124,13 @@ const (
 	Token_SPACE Token = 13
 	Token_VC    Token = 14
 	Token_IZI   Token = 15
+	Token_DAI   Token = 16
+	Token_FRAX  Token = 17
+	Token_sUSD  Token = 18
+	Token_MAI   Token = 19
+	Token_METIS Token = 20
+	Token_BUSD  Token = 21
+	Token_USDD  Token = 22
 )
 
 // Enum value maps for Token.
@@ -136,6 +152,13 @@ var (
 		13: "SPACE",
 		14: "VC",
 		15: "IZI",
+		16: "DAI",
+		17: "FRAX",
+		18: "sUSD",
+		19: "MAI",
+		20: "METIS",
+		21: "BUSD",
+		22: "USDD",
 	}
 	Token_value = map[string]int32{
 		"USDT":  0,
@@ -154,6 +177,13 @@ var (
 		"SPACE": 13,
 		"VC":    14,
 		"IZI":   15,
+		"DAI":   16,
+		"FRAX":  17,
+		"sUSD":  18,
+		"MAI":   19,
+		"METIS": 20,
+		"BUSD":  21,
+		"USDD":  22,
 	}
 )
 
@@ -593,7 +623,7 @@ var file_v1_shared_proto_rawDesc = []byte{
 	0x6b, 0x69, 0x6e, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05,
 	0x5f, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
 	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x64, 0x2a, 0xb2, 0x01, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x74, 0x65, 0x64, 0x2a, 0xd2, 0x01, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
 	0x0c, 0x0a, 0x08, 0x41, 0x52, 0x42, 0x49, 0x54, 0x52, 0x55, 0x4d, 0x10, 0x00, 0x12, 0x0c, 0x0a,
 	0x08, 0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x53, 0x4d, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42,
 	0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x42, 0x4e, 0x42, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
@@ -604,7 +634,9 @@ var file_v1_shared_proto_rawDesc = []byte{
 	0x52, 0x41, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x5a, 0x4b, 0x53, 0x59, 0x4e, 0x43, 0x45, 0x52,
 	0x41, 0x54, 0x45, 0x53, 0x54, 0x4e, 0x45, 0x54, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x5a, 0x4b,
 	0x53, 0x59, 0x4e, 0x43, 0x4c, 0x49, 0x54, 0x45, 0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x74,
-	0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x10, 0x0a, 0x2a, 0xa2, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
+	0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x10, 0x0a, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x61, 0x73, 0x65,
+	0x10, 0x0b, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x10, 0x0c, 0x12, 0x09, 0x0a,
+	0x05, 0x4d, 0x65, 0x74, 0x69, 0x73, 0x10, 0x0d, 0x2a, 0xe7, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
 	0x65, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x44, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
 	0x45, 0x54, 0x48, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x44, 0x43, 0x10, 0x02, 0x12,
 	0x07, 0x0a, 0x03, 0x53, 0x54, 0x47, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4e, 0x42, 0x10,
@@ -614,24 +646,28 @@ var file_v1_shared_proto_rawDesc = []byte{
 	0x55, 0x53, 0x44, 0x10, 0x09, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x53, 0x44, 0x10, 0x0a, 0x12, 0x08,
 	0x0a, 0x04, 0x4d, 0x55, 0x54, 0x45, 0x10, 0x0b, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41, 0x56, 0x10,
 	0x0c, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x0d, 0x12, 0x06, 0x0a, 0x02,
-	0x56, 0x43, 0x10, 0x0e, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x5a, 0x49, 0x10, 0x0f, 0x2a, 0x75, 0x0a,
-	0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f,
-	0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10, 0x00, 0x12,
-	0x11, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x6f, 0x6e,
-	0x65, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x6f,
-	0x70, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x74,
-	0x72, 0x79, 0x10, 0x05, 0x2a, 0x4b, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x10, 0x00, 0x12, 0x0f,
-	0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6b, 0x65, 0x78, 0x10, 0x01, 0x12,
-	0x10, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x10,
-	0x02, 0x2a, 0x2d, 0x0a, 0x0a, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x07, 0x0a, 0x03, 0x41, 0x6c, 0x6c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x02,
-	0x42, 0x09, 0x5a, 0x07, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x56, 0x43, 0x10, 0x0e, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x5a, 0x49, 0x10, 0x0f, 0x12, 0x07, 0x0a,
+	0x03, 0x44, 0x41, 0x49, 0x10, 0x10, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x52, 0x41, 0x58, 0x10, 0x11,
+	0x12, 0x08, 0x0a, 0x04, 0x73, 0x55, 0x53, 0x44, 0x10, 0x12, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x41,
+	0x49, 0x10, 0x13, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x54, 0x49, 0x53, 0x10, 0x14, 0x12, 0x08,
+	0x0a, 0x04, 0x42, 0x55, 0x53, 0x44, 0x10, 0x15, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x44, 0x44,
+	0x10, 0x16, 0x2a, 0x75, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x74, 0x72, 0x79, 0x10, 0x05, 0x2a, 0x4b, 0x0a, 0x12, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x64, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6b,
+	0x65, 0x78, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x10, 0x02, 0x2a, 0x2d, 0x0a, 0x0a, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x6c, 0x6c, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x10, 0x02, 0x42, 0x09, 0x5a, 0x07, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
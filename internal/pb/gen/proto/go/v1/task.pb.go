@@ -59,6 +59,26 @@ const (
 	TaskType_MySwap                           TaskType = 30
 	TaskType_ProtossSwap                      TaskType = 31
 	TaskType_StarkNetBridge                   TaskType = 32
+	TaskType_LendSupply                       TaskType = 33
+	TaskType_LendWithdraw                     TaskType = 34
+	TaskType_MuteioLP                         TaskType = 35
+	TaskType_SpaceFiLP                        TaskType = 36
+	TaskType_VelocoreLP                       TaskType = 37
+	TaskType_IzumiLP                          TaskType = 38
+	TaskType_MaverickLP                       TaskType = 39
+	TaskType_ZkSyncNameServiceMint            TaskType = 40
+	TaskType_EraNameMint                      TaskType = 41
+	TaskType_StarkIdMint                      TaskType = 42
+	TaskType_LayerZeroBridge                  TaskType = 43
+	TaskType_StgLock                          TaskType = 44
+	TaskType_StgExtendLock                    TaskType = 45
+	TaskType_StargateLiquidity                TaskType = 46
+	TaskType_AcrossBridge                     TaskType = 47
+	TaskType_HopBridge                        TaskType = 48
+	TaskType_SynapseBridge                    TaskType = 49
+	TaskType_OfficialBridgeDeposit            TaskType = 50
+	TaskType_OfficialBridgeWithdraw           TaskType = 51
+	TaskType_RevokeApprovals                  TaskType = 52
 )
 
 // Enum value maps for TaskType.
@@ -97,6 +117,26 @@ var (
 		30: "MySwap",
 		31: "ProtossSwap",
 		32: "StarkNetBridge",
+		33: "LendSupply",
+		34: "LendWithdraw",
+		35: "MuteioLP",
+		36: "SpaceFiLP",
+		37: "VelocoreLP",
+		38: "IzumiLP",
+		39: "MaverickLP",
+		40: "ZkSyncNameServiceMint",
+		41: "EraNameMint",
+		42: "StarkIdMint",
+		43: "LayerZeroBridge",
+		44: "StgLock",
+		45: "StgExtendLock",
+		46: "StargateLiquidity",
+		47: "AcrossBridge",
+		48: "HopBridge",
+		49: "SynapseBridge",
+		50: "OfficialBridgeDeposit",
+		51: "OfficialBridgeWithdraw",
+		52: "RevokeApprovals",
 	}
 	TaskType_value = map[string]int32{
 		"StargateBridge":                   0,
@@ -132,6 +172,26 @@ var (
 		"MySwap":                           30,
 		"ProtossSwap":                      31,
 		"StarkNetBridge":                   32,
+		"LendSupply":                       33,
+		"LendWithdraw":                     34,
+		"MuteioLP":                         35,
+		"SpaceFiLP":                        36,
+		"VelocoreLP":                       37,
+		"IzumiLP":                          38,
+		"MaverickLP":                       39,
+		"ZkSyncNameServiceMint":            40,
+		"EraNameMint":                      41,
+		"StarkIdMint":                      42,
+		"LayerZeroBridge":                  43,
+		"StgLock":                          44,
+		"StgExtendLock":                    45,
+		"StargateLiquidity":                46,
+		"AcrossBridge":                     47,
+		"HopBridge":                        48,
+		"SynapseBridge":                    49,
+		"OfficialBridgeDeposit":            50,
+		"OfficialBridgeWithdraw":           51,
+		"RevokeApprovals":                  52,
 	}
 )
 
@@ -162,6 +222,258 @@ func (TaskType) EnumDescriptor() ([]byte, []int) {
 	return file_v1_task_proto_rawDescGZIP(), []int{0}
 }
 
+type LendProtocol int32
+
+const (
+	LendProtocol_Aave          LendProtocol = 0
+	LendProtocol_EraLend       LendProtocol = 1
+	LendProtocol_ReactorFusion LendProtocol = 2
+)
+
+// Enum value maps for LendProtocol.
+var (
+	LendProtocol_name = map[int32]string{
+		0: "Aave",
+		1: "EraLend",
+		2: "ReactorFusion",
+	}
+	LendProtocol_value = map[string]int32{
+		"Aave":          0,
+		"EraLend":       1,
+		"ReactorFusion": 2,
+	}
+)
+
+func (x LendProtocol) Enum() *LendProtocol {
+	p := new(LendProtocol)
+	*p = x
+	return p
+}
+
+func (x LendProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LendProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (LendProtocol) Type() protoreflect.EnumType {
+	return &file_v1_task_proto_enumTypes[1]
+}
+
+func (x LendProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LendProtocol.Descriptor instead.
+func (LendProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
+type StargateLiquidityAction int32
+
+const (
+	StargateLiquidityAction_AddLiquidity  StargateLiquidityAction = 0
+	StargateLiquidityAction_StakeLP       StargateLiquidityAction = 1
+	StargateLiquidityAction_ClaimStg      StargateLiquidityAction = 2
+	StargateLiquidityAction_UnstakeLP     StargateLiquidityAction = 3
+	StargateLiquidityAction_InstantRedeem StargateLiquidityAction = 4
+	StargateLiquidityAction_DelayedRedeem StargateLiquidityAction = 5
+)
+
+// Enum value maps for StargateLiquidityAction.
+var (
+	StargateLiquidityAction_name = map[int32]string{
+		0: "AddLiquidity",
+		1: "StakeLP",
+		2: "ClaimStg",
+		3: "UnstakeLP",
+		4: "InstantRedeem",
+		5: "DelayedRedeem",
+	}
+	StargateLiquidityAction_value = map[string]int32{
+		"AddLiquidity":  0,
+		"StakeLP":       1,
+		"ClaimStg":      2,
+		"UnstakeLP":     3,
+		"InstantRedeem": 4,
+		"DelayedRedeem": 5,
+	}
+)
+
+func (x StargateLiquidityAction) Enum() *StargateLiquidityAction {
+	p := new(StargateLiquidityAction)
+	*p = x
+	return p
+}
+
+func (x StargateLiquidityAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StargateLiquidityAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_task_proto_enumTypes[2].Descriptor()
+}
+
+func (StargateLiquidityAction) Type() protoreflect.EnumType {
+	return &file_v1_task_proto_enumTypes[2]
+}
+
+func (x StargateLiquidityAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StargateLiquidityAction.Descriptor instead.
+func (StargateLiquidityAction) EnumDescriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+// steps of the official bridge withdrawal, only op stack withdrawals are proven
+type OfficialWithdrawalStep int32
+
+const (
+	OfficialWithdrawalStep_WithdrawalInitiated OfficialWithdrawalStep = 0
+	OfficialWithdrawalStep_WithdrawalProven    OfficialWithdrawalStep = 1
+	OfficialWithdrawalStep_WithdrawalFinalized OfficialWithdrawalStep = 2
+)
+
+// Enum value maps for OfficialWithdrawalStep.
+var (
+	OfficialWithdrawalStep_name = map[int32]string{
+		0: "WithdrawalInitiated",
+		1: "WithdrawalProven",
+		2: "WithdrawalFinalized",
+	}
+	OfficialWithdrawalStep_value = map[string]int32{
+		"WithdrawalInitiated": 0,
+		"WithdrawalProven":    1,
+		"WithdrawalFinalized": 2,
+	}
+)
+
+func (x OfficialWithdrawalStep) Enum() *OfficialWithdrawalStep {
+	p := new(OfficialWithdrawalStep)
+	*p = x
+	return p
+}
+
+func (x OfficialWithdrawalStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OfficialWithdrawalStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (OfficialWithdrawalStep) Type() protoreflect.EnumType {
+	return &file_v1_task_proto_enumTypes[3]
+}
+
+func (x OfficialWithdrawalStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OfficialWithdrawalStep.Descriptor instead.
+func (OfficialWithdrawalStep) EnumDescriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+type LayerZeroContract int32
+
+const (
+	LayerZeroContract_ONFT  LayerZeroContract = 0
+	LayerZeroContract_OFT   LayerZeroContract = 1
+	LayerZeroContract_OFTV2 LayerZeroContract = 2
+)
+
+// Enum value maps for LayerZeroContract.
+var (
+	LayerZeroContract_name = map[int32]string{
+		0: "ONFT",
+		1: "OFT",
+		2: "OFTV2",
+	}
+	LayerZeroContract_value = map[string]int32{
+		"ONFT":  0,
+		"OFT":   1,
+		"OFTV2": 2,
+	}
+)
+
+func (x LayerZeroContract) Enum() *LayerZeroContract {
+	p := new(LayerZeroContract)
+	*p = x
+	return p
+}
+
+func (x LayerZeroContract) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LayerZeroContract) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_task_proto_enumTypes[4].Descriptor()
+}
+
+func (LayerZeroContract) Type() protoreflect.EnumType {
+	return &file_v1_task_proto_enumTypes[4]
+}
+
+func (x LayerZeroContract) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LayerZeroContract.Descriptor instead.
+func (LayerZeroContract) EnumDescriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+type TxReplacementType int32
+
+const (
+	TxReplacementType_TxSpeedUp TxReplacementType = 0
+	TxReplacementType_TxCancel  TxReplacementType = 1
+)
+
+// Enum value maps for TxReplacementType.
+var (
+	TxReplacementType_name = map[int32]string{
+		0: "TxSpeedUp",
+		1: "TxCancel",
+	}
+	TxReplacementType_value = map[string]int32{
+		"TxSpeedUp": 0,
+		"TxCancel":  1,
+	}
+)
+
+func (x TxReplacementType) Enum() *TxReplacementType {
+	p := new(TxReplacementType)
+	*p = x
+	return p
+}
+
+func (x TxReplacementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxReplacementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_task_proto_enumTypes[5].Descriptor()
+}
+
+func (TxReplacementType) Type() protoreflect.EnumType {
+	return &file_v1_task_proto_enumTypes[5]
+}
+
+func (x TxReplacementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxReplacementType.Descriptor instead.
+func (TxReplacementType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
 type TxDetail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -304,21 +616,21 @@ func (x *LiquidityBridgeTask) GetApproveTx() *TaskTx {
 	return nil
 }
 
-type DefaultSwap struct {
+type LendTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount    *Amount `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Network   Network `protobuf:"varint,2,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
-	FromToken Token   `protobuf:"varint,3,opt,name=from_token,json=fromToken,proto3,enum=shared.Token" json:"from_token,omitempty"`
-	ToToken   Token   `protobuf:"varint,4,opt,name=to_token,json=toToken,proto3,enum=shared.Token" json:"to_token,omitempty"`
-	Tx        *TaskTx `protobuf:"bytes,5,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
-	ApproveTx *TaskTx `protobuf:"bytes,6,opt,name=approveTx,proto3,oneof" json:"approveTx,omitempty"`
+	Amount    *Amount      `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Network   Network      `protobuf:"varint,2,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
+	Token     Token        `protobuf:"varint,3,opt,name=token,proto3,enum=shared.Token" json:"token,omitempty"`
+	Protocol  LendProtocol `protobuf:"varint,4,opt,name=protocol,proto3,enum=task.LendProtocol" json:"protocol,omitempty"`
+	Tx        *TaskTx      `protobuf:"bytes,5,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	ApproveTx *TaskTx      `protobuf:"bytes,6,opt,name=approveTx,proto3,oneof" json:"approveTx,omitempty"`
 }
 
-func (x *DefaultSwap) Reset() {
-	*x = DefaultSwap{}
+func (x *LendTask) Reset() {
+	*x = LendTask{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_task_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -326,13 +638,13 @@ func (x *DefaultSwap) Reset() {
 	}
 }
 
-func (x *DefaultSwap) String() string {
+func (x *LendTask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultSwap) ProtoMessage() {}
+func (*LendTask) ProtoMessage() {}
 
-func (x *DefaultSwap) ProtoReflect() protoreflect.Message {
+func (x *LendTask) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_task_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -344,49 +656,804 @@ func (x *DefaultSwap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultSwap.ProtoReflect.Descriptor instead.
-func (*DefaultSwap) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *DefaultSwap) GetAmount() *Amount {
-	if x != nil {
-		return x.Amount
-	}
-	return nil
+// Deprecated: Use LendTask.ProtoReflect.Descriptor instead.
+func (*LendTask) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LendTask) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LendTask) GetNetwork() Network {
+	if x != nil {
+		return x.Network
+	}
+	return Network_ARBITRUM
+}
+
+func (x *LendTask) GetToken() Token {
+	if x != nil {
+		return x.Token
+	}
+	return Token_USDT
+}
+
+func (x *LendTask) GetProtocol() LendProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return LendProtocol_Aave
+}
+
+func (x *LendTask) GetTx() *TaskTx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *LendTask) GetApproveTx() *TaskTx {
+	if x != nil {
+		return x.ApproveTx
+	}
+	return nil
+}
+
+type DomainMintTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network Network `protobuf:"varint,1,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
+	// generated name, kept between retries
+	Domain *string `protobuf:"bytes,2,opt,name=domain,proto3,oneof" json:"domain,omitempty"`
+	Tx     *TaskTx `protobuf:"bytes,3,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+}
+
+func (x *DomainMintTask) Reset() {
+	*x = DomainMintTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainMintTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainMintTask) ProtoMessage() {}
+
+func (x *DomainMintTask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainMintTask.ProtoReflect.Descriptor instead.
+func (*DomainMintTask) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DomainMintTask) GetNetwork() Network {
+	if x != nil {
+		return x.Network
+	}
+	return Network_ARBITRUM
+}
+
+func (x *DomainMintTask) GetDomain() string {
+	if x != nil && x.Domain != nil {
+		return *x.Domain
+	}
+	return ""
+}
+
+func (x *DomainMintTask) GetTx() *TaskTx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type LayerZeroBridgeTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network  Network           `protobuf:"varint,1,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
+	Contract string            `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Kind     LayerZeroContract `protobuf:"varint,3,opt,name=kind,proto3,enum=task.LayerZeroContract" json:"kind,omitempty"`
+	// layerzero chain id of the destination, e.g. 110 for Arbitrum
+	DstChainId uint32 `protobuf:"varint,4,opt,name=dstChainId,proto3" json:"dstChainId,omitempty"`
+	// oft amount
+	Amount *Amount `protobuf:"bytes,5,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	// onft to send, the last owned one when empty
+	TokenId         *string `protobuf:"bytes,6,opt,name=tokenId,proto3,oneof" json:"tokenId,omitempty"`
+	LayerZeroStatus *string `protobuf:"bytes,7,opt,name=layer_zero_status,json=layerZeroStatus,proto3,oneof" json:"layer_zero_status,omitempty"`
+	LzscanUrl       *string `protobuf:"bytes,8,opt,name=lzscan_url,json=lzscanUrl,proto3,oneof" json:"lzscan_url,omitempty"`
+	Tx              *TaskTx `protobuf:"bytes,9,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	ApproveTx       *TaskTx `protobuf:"bytes,10,opt,name=approveTx,proto3,oneof" json:"approveTx,omitempty"`
+	// the task waits for layerzero delivery unless it is set to false
+	WaitDelivery *bool   `protobuf:"varint,11,opt,name=wait_delivery,json=waitDelivery,proto3,oneof" json:"wait_delivery,omitempty"`
+	DstTxId      *string `protobuf:"bytes,12,opt,name=dst_tx_id,json=dstTxId,proto3,oneof" json:"dst_tx_id,omitempty"`
+}
+
+func (x *LayerZeroBridgeTask) Reset() {
+	*x = LayerZeroBridgeTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayerZeroBridgeTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayerZeroBridgeTask) ProtoMessage() {}
+
+func (x *LayerZeroBridgeTask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayerZeroBridgeTask.ProtoReflect.Descriptor instead.
+func (*LayerZeroBridgeTask) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LayerZeroBridgeTask) GetNetwork() Network {
+	if x != nil {
+		return x.Network
+	}
+	return Network_ARBITRUM
+}
+
+func (x *LayerZeroBridgeTask) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *LayerZeroBridgeTask) GetKind() LayerZeroContract {
+	if x != nil {
+		return x.Kind
+	}
+	return LayerZeroContract_ONFT
+}
+
+func (x *LayerZeroBridgeTask) GetDstChainId() uint32 {
+	if x != nil {
+		return x.DstChainId
+	}
+	return 0
+}
+
+func (x *LayerZeroBridgeTask) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LayerZeroBridgeTask) GetTokenId() string {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return ""
+}
+
+func (x *LayerZeroBridgeTask) GetLayerZeroStatus() string {
+	if x != nil && x.LayerZeroStatus != nil {
+		return *x.LayerZeroStatus
+	}
+	return ""
+}
+
+func (x *LayerZeroBridgeTask) GetLzscanUrl() string {
+	if x != nil && x.LzscanUrl != nil {
+		return *x.LzscanUrl
+	}
+	return ""
+}
+
+func (x *LayerZeroBridgeTask) GetTx() *TaskTx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *LayerZeroBridgeTask) GetApproveTx() *TaskTx {
+	if x != nil {
+		return x.ApproveTx
+	}
+	return nil
+}
+
+func (x *LayerZeroBridgeTask) GetWaitDelivery() bool {
+	if x != nil && x.WaitDelivery != nil {
+		return *x.WaitDelivery
+	}
+	return false
+}
+
+func (x *LayerZeroBridgeTask) GetDstTxId() string {
+	if x != nil && x.DstTxId != nil {
+		return *x.DstTxId
+	}
+	return ""
+}
+
+type StgLockTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network Network `protobuf:"varint,1,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
+	// stg to lock, not used to extend the lock
+	Amount *Amount `protobuf:"bytes,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	// lock duration from now, max lock time of veSTG when empty
+	LockDays  *int64  `protobuf:"varint,3,opt,name=lock_days,json=lockDays,proto3,oneof" json:"lock_days,omitempty"`
+	Tx        *TaskTx `protobuf:"bytes,4,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	ApproveTx *TaskTx `protobuf:"bytes,5,opt,name=approveTx,proto3,oneof" json:"approveTx,omitempty"`
+}
+
+func (x *StgLockTask) Reset() {
+	*x = StgLockTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StgLockTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StgLockTask) ProtoMessage() {}
+
+func (x *StgLockTask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StgLockTask.ProtoReflect.Descriptor instead.
+func (*StgLockTask) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StgLockTask) GetNetwork() Network {
+	if x != nil {
+		return x.Network
+	}
+	return Network_ARBITRUM
+}
+
+func (x *StgLockTask) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *StgLockTask) GetLockDays() int64 {
+	if x != nil && x.LockDays != nil {
+		return *x.LockDays
+	}
+	return 0
+}
+
+func (x *StgLockTask) GetTx() *TaskTx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *StgLockTask) GetApproveTx() *TaskTx {
+	if x != nil {
+		return x.ApproveTx
+	}
+	return nil
+}
+
+type StargateLiquidityTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network Network                 `protobuf:"varint,1,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
+	Token   Token                   `protobuf:"varint,2,opt,name=token,proto3,enum=shared.Token" json:"token,omitempty"`
+	Action  StargateLiquidityAction `protobuf:"varint,3,opt,name=action,proto3,enum=task.StargateLiquidityAction" json:"action,omitempty"`
+	// token to add, lp to stake, unstake or redeem
+	Amount *Amount `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	// chain of the pool the delayed redeem is checked against
+	ToNetwork *Network `protobuf:"varint,5,opt,name=to_network,json=toNetwork,proto3,enum=shared.Network,oneof" json:"to_network,omitempty"`
+	Tx        *TaskTx  `protobuf:"bytes,6,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	ApproveTx *TaskTx  `protobuf:"bytes,7,opt,name=approveTx,proto3,oneof" json:"approveTx,omitempty"`
+}
+
+func (x *StargateLiquidityTask) Reset() {
+	*x = StargateLiquidityTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StargateLiquidityTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StargateLiquidityTask) ProtoMessage() {}
+
+func (x *StargateLiquidityTask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StargateLiquidityTask.ProtoReflect.Descriptor instead.
+func (*StargateLiquidityTask) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StargateLiquidityTask) GetNetwork() Network {
+	if x != nil {
+		return x.Network
+	}
+	return Network_ARBITRUM
+}
+
+func (x *StargateLiquidityTask) GetToken() Token {
+	if x != nil {
+		return x.Token
+	}
+	return Token_USDT
+}
+
+func (x *StargateLiquidityTask) GetAction() StargateLiquidityAction {
+	if x != nil {
+		return x.Action
+	}
+	return StargateLiquidityAction_AddLiquidity
+}
+
+func (x *StargateLiquidityTask) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *StargateLiquidityTask) GetToNetwork() Network {
+	if x != nil && x.ToNetwork != nil {
+		return *x.ToNetwork
+	}
+	return Network_ARBITRUM
+}
+
+func (x *StargateLiquidityTask) GetTx() *TaskTx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *StargateLiquidityTask) GetApproveTx() *TaskTx {
+	if x != nil {
+		return x.ApproveTx
+	}
+	return nil
+}
+
+type DefaultSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount    *Amount `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Network   Network `protobuf:"varint,2,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
+	FromToken Token   `protobuf:"varint,3,opt,name=from_token,json=fromToken,proto3,enum=shared.Token" json:"from_token,omitempty"`
+	ToToken   Token   `protobuf:"varint,4,opt,name=to_token,json=toToken,proto3,enum=shared.Token" json:"to_token,omitempty"`
+	Tx        *TaskTx `protobuf:"bytes,5,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	ApproveTx *TaskTx `protobuf:"bytes,6,opt,name=approveTx,proto3,oneof" json:"approveTx,omitempty"`
+}
+
+func (x *DefaultSwap) Reset() {
+	*x = DefaultSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefaultSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultSwap) ProtoMessage() {}
+
+func (x *DefaultSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultSwap.ProtoReflect.Descriptor instead.
+func (*DefaultSwap) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DefaultSwap) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *DefaultSwap) GetNetwork() Network {
+	if x != nil {
+		return x.Network
+	}
+	return Network_ARBITRUM
+}
+
+func (x *DefaultSwap) GetFromToken() Token {
+	if x != nil {
+		return x.FromToken
+	}
+	return Token_USDT
+}
+
+func (x *DefaultSwap) GetToToken() Token {
+	if x != nil {
+		return x.ToToken
+	}
+	return Token_USDT
+}
+
+func (x *DefaultSwap) GetTx() *TaskTx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *DefaultSwap) GetApproveTx() *TaskTx {
+	if x != nil {
+		return x.ApproveTx
+	}
+	return nil
+}
+
+type DefaultBridge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount      *Amount `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	FromNetwork Network `protobuf:"varint,2,opt,name=from_network,json=fromNetwork,proto3,enum=shared.Network" json:"from_network,omitempty"`
+	ToNetwork   Network `protobuf:"varint,3,opt,name=to_network,json=toNetwork,proto3,enum=shared.Network" json:"to_network,omitempty"`
+	Token       Token   `protobuf:"varint,4,opt,name=token,proto3,enum=shared.Token" json:"token,omitempty"`
+	Tx          *TaskTx `protobuf:"bytes,5,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	ApproveTx   *TaskTx `protobuf:"bytes,6,opt,name=approveTx,proto3,oneof" json:"approveTx,omitempty"`
+}
+
+func (x *DefaultBridge) Reset() {
+	*x = DefaultBridge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefaultBridge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultBridge) ProtoMessage() {}
+
+func (x *DefaultBridge) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultBridge.ProtoReflect.Descriptor instead.
+func (*DefaultBridge) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DefaultBridge) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *DefaultBridge) GetFromNetwork() Network {
+	if x != nil {
+		return x.FromNetwork
+	}
+	return Network_ARBITRUM
+}
+
+func (x *DefaultBridge) GetToNetwork() Network {
+	if x != nil {
+		return x.ToNetwork
+	}
+	return Network_ARBITRUM
+}
+
+func (x *DefaultBridge) GetToken() Token {
+	if x != nil {
+		return x.Token
+	}
+	return Token_USDT
+}
+
+func (x *DefaultBridge) GetTx() *TaskTx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *DefaultBridge) GetApproveTx() *TaskTx {
+	if x != nil {
+		return x.ApproveTx
+	}
+	return nil
+}
+
+// eth bridged by the canonical bridge of the rollup, network is the l2 for deposits and withdrawals both
+type OfficialBridgeTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network Network `protobuf:"varint,1,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
+	Amount  *Amount `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// deposit on ethereum or withdrawal initiation on l2
+	Tx         *TaskTx `protobuf:"bytes,3,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	ProveTx    *TaskTx `protobuf:"bytes,4,opt,name=proveTx,proto3,oneof" json:"proveTx,omitempty"`
+	FinalizeTx *TaskTx `protobuf:"bytes,5,opt,name=finalizeTx,proto3,oneof" json:"finalizeTx,omitempty"`
+	// json of the l2 withdrawal message the prove and finalize transactions are built from
+	Withdrawal *string                 `protobuf:"bytes,6,opt,name=withdrawal,proto3,oneof" json:"withdrawal,omitempty"`
+	Step       *OfficialWithdrawalStep `protobuf:"varint,7,opt,name=step,proto3,enum=task.OfficialWithdrawalStep,oneof" json:"step,omitempty"`
+	// withdrawal is not checked before
+	NextCheck *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_check,json=nextCheck,proto3,oneof" json:"next_check,omitempty"`
+	// reason the withdrawal is waiting for
+	WaitingFor *string `protobuf:"bytes,9,opt,name=waiting_for,json=waitingFor,proto3,oneof" json:"waiting_for,omitempty"`
+}
+
+func (x *OfficialBridgeTask) Reset() {
+	*x = OfficialBridgeTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfficialBridgeTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfficialBridgeTask) ProtoMessage() {}
+
+func (x *OfficialBridgeTask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfficialBridgeTask.ProtoReflect.Descriptor instead.
+func (*OfficialBridgeTask) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OfficialBridgeTask) GetNetwork() Network {
+	if x != nil {
+		return x.Network
+	}
+	return Network_ARBITRUM
+}
+
+func (x *OfficialBridgeTask) GetAmount() *Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *OfficialBridgeTask) GetTx() *TaskTx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *OfficialBridgeTask) GetProveTx() *TaskTx {
+	if x != nil {
+		return x.ProveTx
+	}
+	return nil
+}
+
+func (x *OfficialBridgeTask) GetFinalizeTx() *TaskTx {
+	if x != nil {
+		return x.FinalizeTx
+	}
+	return nil
+}
+
+func (x *OfficialBridgeTask) GetWithdrawal() string {
+	if x != nil && x.Withdrawal != nil {
+		return *x.Withdrawal
+	}
+	return ""
+}
+
+func (x *OfficialBridgeTask) GetStep() OfficialWithdrawalStep {
+	if x != nil && x.Step != nil {
+		return *x.Step
+	}
+	return OfficialWithdrawalStep_WithdrawalInitiated
+}
+
+func (x *OfficialBridgeTask) GetNextCheck() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextCheck
+	}
+	return nil
+}
+
+func (x *OfficialBridgeTask) GetWaitingFor() string {
+	if x != nil && x.WaitingFor != nil {
+		return *x.WaitingFor
+	}
+	return ""
+}
+
+// erc20 approvals of the profile are set to zero one tx after another until none of them is left
+type RevokeApprovalsTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network Network `protobuf:"varint,1,opt,name=network,proto3,enum=shared.Network" json:"network,omitempty"`
+	// tokens the approvals of are revoked, all the tokens of the network if empty
+	Tokens []Token `protobuf:"varint,2,rep,packed,name=tokens,proto3,enum=shared.Token" json:"tokens,omitempty"`
+	// spender addresses the approvals to are revoked, all the known spenders of the network if empty
+	Spenders []string `protobuf:"bytes,3,rep,name=spenders,proto3" json:"spenders,omitempty"`
+	// limited approvals are left
+	UnlimitedOnly *bool `protobuf:"varint,4,opt,name=unlimited_only,json=unlimitedOnly,proto3,oneof" json:"unlimited_only,omitempty"`
+	// revoke txs in the order they are sent
+	Txs []*TaskTx `protobuf:"bytes,5,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *RevokeApprovalsTask) Reset() {
+	*x = RevokeApprovalsTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeApprovalsTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApprovalsTask) ProtoMessage() {}
+
+func (x *RevokeApprovalsTask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApprovalsTask.ProtoReflect.Descriptor instead.
+func (*RevokeApprovalsTask) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DefaultSwap) GetNetwork() Network {
+func (x *RevokeApprovalsTask) GetNetwork() Network {
 	if x != nil {
 		return x.Network
 	}
 	return Network_ARBITRUM
 }
 
-func (x *DefaultSwap) GetFromToken() Token {
+func (x *RevokeApprovalsTask) GetTokens() []Token {
 	if x != nil {
-		return x.FromToken
+		return x.Tokens
 	}
-	return Token_USDT
+	return nil
 }
 
-func (x *DefaultSwap) GetToToken() Token {
+func (x *RevokeApprovalsTask) GetSpenders() []string {
 	if x != nil {
-		return x.ToToken
+		return x.Spenders
 	}
-	return Token_USDT
+	return nil
 }
 
-func (x *DefaultSwap) GetTx() *TaskTx {
-	if x != nil {
-		return x.Tx
+func (x *RevokeApprovalsTask) GetUnlimitedOnly() bool {
+	if x != nil && x.UnlimitedOnly != nil {
+		return *x.UnlimitedOnly
 	}
-	return nil
+	return false
 }
 
-func (x *DefaultSwap) GetApproveTx() *TaskTx {
+func (x *RevokeApprovalsTask) GetTxs() []*TaskTx {
 	if x != nil {
-		return x.ApproveTx
+		return x.Txs
 	}
 	return nil
 }
@@ -409,12 +1476,17 @@ type TaskTx struct {
 	Details      []*TxDetail `protobuf:"bytes,11,rep,name=details,proto3" json:"details,omitempty"`
 	Ts           int64       `protobuf:"varint,12,opt,name=ts,proto3" json:"ts,omitempty"`
 	CompleteTs   int64       `protobuf:"varint,13,opt,name=complete_ts,json=completeTs,proto3" json:"complete_ts,omitempty"`
+	// txs replaced by tx_id with the same nonce, the oldest first
+	Replacements []*TxReplacement `protobuf:"bytes,14,rep,name=replacements,proto3" json:"replacements,omitempty"`
+	// sender and nonce of the tx once the node returned it, they tell the replaced tx from the dropped one
+	Sender *string `protobuf:"bytes,15,opt,name=sender,proto3,oneof" json:"sender,omitempty"`
+	Nonce  *uint64 `protobuf:"varint,16,opt,name=nonce,proto3,oneof" json:"nonce,omitempty"`
 }
 
 func (x *TaskTx) Reset() {
 	*x = TaskTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[3]
+		mi := &file_v1_task_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -427,7 +1499,7 @@ func (x *TaskTx) String() string {
 func (*TaskTx) ProtoMessage() {}
 
 func (x *TaskTx) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[3]
+	mi := &file_v1_task_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +1512,7 @@ func (x *TaskTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskTx.ProtoReflect.Descriptor instead.
 func (*TaskTx) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{3}
+	return file_v1_task_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TaskTx) GetTxCompleted() bool {
@@ -534,6 +1606,99 @@ func (x *TaskTx) GetCompleteTs() int64 {
 	return 0
 }
 
+func (x *TaskTx) GetReplacements() []*TxReplacement {
+	if x != nil {
+		return x.Replacements
+	}
+	return nil
+}
+
+func (x *TaskTx) GetSender() string {
+	if x != nil && x.Sender != nil {
+		return *x.Sender
+	}
+	return ""
+}
+
+func (x *TaskTx) GetNonce() uint64 {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return 0
+}
+
+type TxReplacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx_id of the replaced tx
+	TxId string            `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Url  *string           `protobuf:"bytes,2,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Type TxReplacementType `protobuf:"varint,3,opt,name=type,proto3,enum=task.TxReplacementType" json:"type,omitempty"`
+	Ts   int64             `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+}
+
+func (x *TxReplacement) Reset() {
+	*x = TxReplacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxReplacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxReplacement) ProtoMessage() {}
+
+func (x *TxReplacement) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxReplacement.ProtoReflect.Descriptor instead.
+func (*TxReplacement) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TxReplacement) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TxReplacement) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *TxReplacement) GetType() TxReplacementType {
+	if x != nil {
+		return x.Type
+	}
+	return TxReplacementType_TxSpeedUp
+}
+
+func (x *TxReplacement) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
 type MerklyMintAndBridgeNFTTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -545,12 +1710,17 @@ type MerklyMintAndBridgeNFTTask struct {
 	BridgeTx    *TaskTx `protobuf:"bytes,4,opt,name=bridge_tx,json=bridgeTx,proto3,oneof" json:"bridge_tx,omitempty"`
 	NftId       *string `protobuf:"bytes,5,opt,name=nft_id,json=nftId,proto3,oneof" json:"nft_id,omitempty"`
 	Fee         *string `protobuf:"bytes,6,opt,name=fee,proto3,oneof" json:"fee,omitempty"` //deprecated
+	// the task waits for layerzero delivery unless it is set to false
+	WaitDelivery    *bool   `protobuf:"varint,7,opt,name=wait_delivery,json=waitDelivery,proto3,oneof" json:"wait_delivery,omitempty"`
+	LayerZeroStatus *string `protobuf:"bytes,8,opt,name=layer_zero_status,json=layerZeroStatus,proto3,oneof" json:"layer_zero_status,omitempty"`
+	LzscanUrl       *string `protobuf:"bytes,9,opt,name=lzscan_url,json=lzscanUrl,proto3,oneof" json:"lzscan_url,omitempty"`
+	DstTxId         *string `protobuf:"bytes,10,opt,name=dst_tx_id,json=dstTxId,proto3,oneof" json:"dst_tx_id,omitempty"`
 }
 
 func (x *MerklyMintAndBridgeNFTTask) Reset() {
 	*x = MerklyMintAndBridgeNFTTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[4]
+		mi := &file_v1_task_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -563,7 +1733,7 @@ func (x *MerklyMintAndBridgeNFTTask) String() string {
 func (*MerklyMintAndBridgeNFTTask) ProtoMessage() {}
 
 func (x *MerklyMintAndBridgeNFTTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[4]
+	mi := &file_v1_task_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,7 +1746,7 @@ func (x *MerklyMintAndBridgeNFTTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MerklyMintAndBridgeNFTTask.ProtoReflect.Descriptor instead.
 func (*MerklyMintAndBridgeNFTTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{4}
+	return file_v1_task_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MerklyMintAndBridgeNFTTask) GetFromNetwork() Network {
@@ -621,6 +1791,34 @@ func (x *MerklyMintAndBridgeNFTTask) GetFee() string {
 	return ""
 }
 
+func (x *MerklyMintAndBridgeNFTTask) GetWaitDelivery() bool {
+	if x != nil && x.WaitDelivery != nil {
+		return *x.WaitDelivery
+	}
+	return false
+}
+
+func (x *MerklyMintAndBridgeNFTTask) GetLayerZeroStatus() string {
+	if x != nil && x.LayerZeroStatus != nil {
+		return *x.LayerZeroStatus
+	}
+	return ""
+}
+
+func (x *MerklyMintAndBridgeNFTTask) GetLzscanUrl() string {
+	if x != nil && x.LzscanUrl != nil {
+		return *x.LzscanUrl
+	}
+	return ""
+}
+
+func (x *MerklyMintAndBridgeNFTTask) GetDstTxId() string {
+	if x != nil && x.DstTxId != nil {
+		return *x.DstTxId
+	}
+	return ""
+}
+
 type DeployStarkNetAccountTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -633,7 +1831,7 @@ type DeployStarkNetAccountTask struct {
 func (x *DeployStarkNetAccountTask) Reset() {
 	*x = DeployStarkNetAccountTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[5]
+		mi := &file_v1_task_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -646,7 +1844,7 @@ func (x *DeployStarkNetAccountTask) String() string {
 func (*DeployStarkNetAccountTask) ProtoMessage() {}
 
 func (x *DeployStarkNetAccountTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[5]
+	mi := &file_v1_task_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,7 +1857,7 @@ func (x *DeployStarkNetAccountTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeployStarkNetAccountTask.ProtoReflect.Descriptor instead.
 func (*DeployStarkNetAccountTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{5}
+	return file_v1_task_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeployStarkNetAccountTask) GetNetwork() Network {
@@ -687,12 +1885,15 @@ type DefaultLP struct {
 	B       Token   `protobuf:"varint,4,opt,name=b,proto3,enum=shared.Token" json:"b,omitempty"`
 	Tx      *TaskTx `protobuf:"bytes,5,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
 	Add     bool    `protobuf:"varint,6,opt,name=add,proto3" json:"add,omitempty"`
+	Stable  bool    `protobuf:"varint,7,opt,name=stable,proto3" json:"stable,omitempty"`
+	// add: position before the tx, remove: withdrawn position
+	Position *LPPosition `protobuf:"bytes,8,opt,name=position,proto3,oneof" json:"position,omitempty"`
 }
 
 func (x *DefaultLP) Reset() {
 	*x = DefaultLP{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[6]
+		mi := &file_v1_task_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +1906,7 @@ func (x *DefaultLP) String() string {
 func (*DefaultLP) ProtoMessage() {}
 
 func (x *DefaultLP) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[6]
+	mi := &file_v1_task_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1919,7 @@ func (x *DefaultLP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultLP.ProtoReflect.Descriptor instead.
 func (*DefaultLP) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{6}
+	return file_v1_task_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DefaultLP) GetAmount() *Amount {
@@ -763,6 +1964,91 @@ func (x *DefaultLP) GetAdd() bool {
 	return false
 }
 
+func (x *DefaultLP) GetStable() bool {
+	if x != nil {
+		return x.Stable
+	}
+	return false
+}
+
+func (x *DefaultLP) GetPosition() *LPPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type LPPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool       string  `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Liquidity  string  `protobuf:"bytes,2,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	PositionId *string `protobuf:"bytes,3,opt,name=positionId,proto3,oneof" json:"positionId,omitempty"`
+	BinId      *string `protobuf:"bytes,4,opt,name=binId,proto3,oneof" json:"binId,omitempty"`
+}
+
+func (x *LPPosition) Reset() {
+	*x = LPPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_task_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LPPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPPosition) ProtoMessage() {}
+
+func (x *LPPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_task_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPPosition.ProtoReflect.Descriptor instead.
+func (*LPPosition) Descriptor() ([]byte, []int) {
+	return file_v1_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LPPosition) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *LPPosition) GetLiquidity() string {
+	if x != nil {
+		return x.Liquidity
+	}
+	return ""
+}
+
+func (x *LPPosition) GetPositionId() string {
+	if x != nil && x.PositionId != nil {
+		return *x.PositionId
+	}
+	return ""
+}
+
+func (x *LPPosition) GetBinId() string {
+	if x != nil && x.BinId != nil {
+		return *x.BinId
+	}
+	return ""
+}
+
 type WETHTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -777,7 +2063,7 @@ type WETHTask struct {
 func (x *WETHTask) Reset() {
 	*x = WETHTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[7]
+		mi := &file_v1_task_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -790,7 +2076,7 @@ func (x *WETHTask) String() string {
 func (*WETHTask) ProtoMessage() {}
 
 func (x *WETHTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[7]
+	mi := &file_v1_task_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +2089,7 @@ func (x *WETHTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WETHTask.ProtoReflect.Descriptor instead.
 func (*WETHTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{7}
+	return file_v1_task_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WETHTask) GetAmount() *Amount {
@@ -850,12 +2136,17 @@ type OrbiterBridgeTask struct {
 	OrbiterSenderAddr   *string `protobuf:"bytes,9,opt,name=orbiter_sender_addr,json=orbiterSenderAddr,proto3,oneof" json:"orbiter_sender_addr,omitempty"`
 	SwapCompleted       *bool   `protobuf:"varint,10,opt,name=swap_completed,json=swapCompleted,proto3,oneof" json:"swap_completed,omitempty"` //deprecated
 	Tx                  *TaskTx `protobuf:"bytes,11,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	// amount the maker is expected to send, wei of to_token
+	ReceivedAmount *string `protobuf:"bytes,12,opt,name=received_amount,json=receivedAmount,proto3,oneof" json:"received_amount,omitempty"`
+	// to_token balance on to_network before the transfer, arrival is checked against it
+	DstBalanceBefore *string `protobuf:"bytes,13,opt,name=dst_balance_before,json=dstBalanceBefore,proto3,oneof" json:"dst_balance_before,omitempty"`
+	Received         *bool   `protobuf:"varint,14,opt,name=received,proto3,oneof" json:"received,omitempty"`
 }
 
 func (x *OrbiterBridgeTask) Reset() {
 	*x = OrbiterBridgeTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[8]
+		mi := &file_v1_task_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -868,7 +2159,7 @@ func (x *OrbiterBridgeTask) String() string {
 func (*OrbiterBridgeTask) ProtoMessage() {}
 
 func (x *OrbiterBridgeTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[8]
+	mi := &file_v1_task_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +2172,7 @@ func (x *OrbiterBridgeTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrbiterBridgeTask.ProtoReflect.Descriptor instead.
 func (*OrbiterBridgeTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{8}
+	return file_v1_task_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *OrbiterBridgeTask) GetAmount() *Amount {
@@ -961,6 +2252,27 @@ func (x *OrbiterBridgeTask) GetTx() *TaskTx {
 	return nil
 }
 
+func (x *OrbiterBridgeTask) GetReceivedAmount() string {
+	if x != nil && x.ReceivedAmount != nil {
+		return *x.ReceivedAmount
+	}
+	return ""
+}
+
+func (x *OrbiterBridgeTask) GetDstBalanceBefore() string {
+	if x != nil && x.DstBalanceBefore != nil {
+		return *x.DstBalanceBefore
+	}
+	return ""
+}
+
+func (x *OrbiterBridgeTask) GetReceived() bool {
+	if x != nil && x.Received != nil {
+		return *x.Received
+	}
+	return false
+}
+
 type ZkSyncOfficialBridgeFromEthereumTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -975,7 +2287,7 @@ type ZkSyncOfficialBridgeFromEthereumTask struct {
 func (x *ZkSyncOfficialBridgeFromEthereumTask) Reset() {
 	*x = ZkSyncOfficialBridgeFromEthereumTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[9]
+		mi := &file_v1_task_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -988,7 +2300,7 @@ func (x *ZkSyncOfficialBridgeFromEthereumTask) String() string {
 func (*ZkSyncOfficialBridgeFromEthereumTask) ProtoMessage() {}
 
 func (x *ZkSyncOfficialBridgeFromEthereumTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[9]
+	mi := &file_v1_task_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,7 +2313,7 @@ func (x *ZkSyncOfficialBridgeFromEthereumTask) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ZkSyncOfficialBridgeFromEthereumTask.ProtoReflect.Descriptor instead.
 func (*ZkSyncOfficialBridgeFromEthereumTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{9}
+	return file_v1_task_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ZkSyncOfficialBridgeFromEthereumTask) GetAmount() *Amount {
@@ -1043,12 +2355,19 @@ type ZkSyncOfficialBridgeToEthereumTask struct {
 	TxCompleted *bool   `protobuf:"varint,4,opt,name=tx_completed,json=txCompleted,proto3,oneof" json:"tx_completed,omitempty"` //deprecated
 	TxId        *string `protobuf:"bytes,5,opt,name=txId,proto3,oneof" json:"txId,omitempty"`                                   //deprecated
 	Tx          *TaskTx `protobuf:"bytes,6,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	// withdrawn eth is claimed on ethereum once the batch of the withdrawal is executed
+	Finalize   *bool   `protobuf:"varint,7,opt,name=finalize,proto3,oneof" json:"finalize,omitempty"`
+	FinalizeTx *TaskTx `protobuf:"bytes,8,opt,name=finalizeTx,proto3,oneof" json:"finalizeTx,omitempty"`
+	// withdrawal is not checked before
+	NextCheck *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_check,json=nextCheck,proto3,oneof" json:"next_check,omitempty"`
+	// reason the withdrawal is waiting for
+	WaitingFor *string `protobuf:"bytes,10,opt,name=waiting_for,json=waitingFor,proto3,oneof" json:"waiting_for,omitempty"`
 }
 
 func (x *ZkSyncOfficialBridgeToEthereumTask) Reset() {
 	*x = ZkSyncOfficialBridgeToEthereumTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[10]
+		mi := &file_v1_task_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1061,7 +2380,7 @@ func (x *ZkSyncOfficialBridgeToEthereumTask) String() string {
 func (*ZkSyncOfficialBridgeToEthereumTask) ProtoMessage() {}
 
 func (x *ZkSyncOfficialBridgeToEthereumTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[10]
+	mi := &file_v1_task_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,7 +2393,7 @@ func (x *ZkSyncOfficialBridgeToEthereumTask) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ZkSyncOfficialBridgeToEthereumTask.ProtoReflect.Descriptor instead.
 func (*ZkSyncOfficialBridgeToEthereumTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{10}
+	return file_v1_task_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ZkSyncOfficialBridgeToEthereumTask) GetAmount() *Amount {
@@ -1119,6 +2438,34 @@ func (x *ZkSyncOfficialBridgeToEthereumTask) GetTx() *TaskTx {
 	return nil
 }
 
+func (x *ZkSyncOfficialBridgeToEthereumTask) GetFinalize() bool {
+	if x != nil && x.Finalize != nil {
+		return *x.Finalize
+	}
+	return false
+}
+
+func (x *ZkSyncOfficialBridgeToEthereumTask) GetFinalizeTx() *TaskTx {
+	if x != nil {
+		return x.FinalizeTx
+	}
+	return nil
+}
+
+func (x *ZkSyncOfficialBridgeToEthereumTask) GetNextCheck() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextCheck
+	}
+	return nil
+}
+
+func (x *ZkSyncOfficialBridgeToEthereumTask) GetWaitingFor() string {
+	if x != nil && x.WaitingFor != nil {
+		return *x.WaitingFor
+	}
+	return ""
+}
+
 type Swap1InchTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1145,7 +2492,7 @@ type Swap1InchTask struct {
 func (x *Swap1InchTask) Reset() {
 	*x = Swap1InchTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[11]
+		mi := &file_v1_task_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1158,7 +2505,7 @@ func (x *Swap1InchTask) String() string {
 func (*Swap1InchTask) ProtoMessage() {}
 
 func (x *Swap1InchTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[11]
+	mi := &file_v1_task_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,7 +2518,7 @@ func (x *Swap1InchTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Swap1InchTask.ProtoReflect.Descriptor instead.
 func (*Swap1InchTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{11}
+	return file_v1_task_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Swap1InchTask) GetNetwork() Network {
@@ -1306,7 +2653,7 @@ type SnapshotVoteTask struct {
 func (x *SnapshotVoteTask) Reset() {
 	*x = SnapshotVoteTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[12]
+		mi := &file_v1_task_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1319,7 +2666,7 @@ func (x *SnapshotVoteTask) String() string {
 func (*SnapshotVoteTask) ProtoMessage() {}
 
 func (x *SnapshotVoteTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[12]
+	mi := &file_v1_task_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,7 +2679,7 @@ func (x *SnapshotVoteTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotVoteTask.ProtoReflect.Descriptor instead.
 func (*SnapshotVoteTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{12}
+	return file_v1_task_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SnapshotVoteTask) GetSpace() string {
@@ -1366,7 +2713,7 @@ type SnapshotVoteProposal struct {
 func (x *SnapshotVoteProposal) Reset() {
 	*x = SnapshotVoteProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[13]
+		mi := &file_v1_task_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1379,7 +2726,7 @@ func (x *SnapshotVoteProposal) String() string {
 func (*SnapshotVoteProposal) ProtoMessage() {}
 
 func (x *SnapshotVoteProposal) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[13]
+	mi := &file_v1_task_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +2739,7 @@ func (x *SnapshotVoteProposal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotVoteProposal.ProtoReflect.Descriptor instead.
 func (*SnapshotVoteProposal) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{13}
+	return file_v1_task_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SnapshotVoteProposal) GetStatus() ProcessStatus {
@@ -1454,12 +2801,17 @@ type TestNetBridgeSwapTask struct {
 	MaxAmount string  `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
 	Amount    *string `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
 	Tx        *TaskTx `protobuf:"bytes,5,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	// the task waits for layerzero delivery unless it is set to false
+	WaitDelivery    *bool   `protobuf:"varint,6,opt,name=wait_delivery,json=waitDelivery,proto3,oneof" json:"wait_delivery,omitempty"`
+	LayerZeroStatus *string `protobuf:"bytes,7,opt,name=layer_zero_status,json=layerZeroStatus,proto3,oneof" json:"layer_zero_status,omitempty"`
+	LzscanUrl       *string `protobuf:"bytes,8,opt,name=lzscan_url,json=lzscanUrl,proto3,oneof" json:"lzscan_url,omitempty"`
+	DstTxId         *string `protobuf:"bytes,9,opt,name=dst_tx_id,json=dstTxId,proto3,oneof" json:"dst_tx_id,omitempty"`
 }
 
 func (x *TestNetBridgeSwapTask) Reset() {
 	*x = TestNetBridgeSwapTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[14]
+		mi := &file_v1_task_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1472,7 +2824,7 @@ func (x *TestNetBridgeSwapTask) String() string {
 func (*TestNetBridgeSwapTask) ProtoMessage() {}
 
 func (x *TestNetBridgeSwapTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[14]
+	mi := &file_v1_task_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1485,7 +2837,7 @@ func (x *TestNetBridgeSwapTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestNetBridgeSwapTask.ProtoReflect.Descriptor instead.
 func (*TestNetBridgeSwapTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{14}
+	return file_v1_task_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TestNetBridgeSwapTask) GetNetwork() Network {
@@ -1523,6 +2875,34 @@ func (x *TestNetBridgeSwapTask) GetTx() *TaskTx {
 	return nil
 }
 
+func (x *TestNetBridgeSwapTask) GetWaitDelivery() bool {
+	if x != nil && x.WaitDelivery != nil {
+		return *x.WaitDelivery
+	}
+	return false
+}
+
+func (x *TestNetBridgeSwapTask) GetLayerZeroStatus() string {
+	if x != nil && x.LayerZeroStatus != nil {
+		return *x.LayerZeroStatus
+	}
+	return ""
+}
+
+func (x *TestNetBridgeSwapTask) GetLzscanUrl() string {
+	if x != nil && x.LzscanUrl != nil {
+		return *x.LzscanUrl
+	}
+	return ""
+}
+
+func (x *TestNetBridgeSwapTask) GetDstTxId() string {
+	if x != nil && x.DstTxId != nil {
+		return *x.DstTxId
+	}
+	return ""
+}
+
 type OkexDepositTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1543,7 +2923,7 @@ type OkexDepositTask struct {
 func (x *OkexDepositTask) Reset() {
 	*x = OkexDepositTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[15]
+		mi := &file_v1_task_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1556,7 +2936,7 @@ func (x *OkexDepositTask) String() string {
 func (*OkexDepositTask) ProtoMessage() {}
 
 func (x *OkexDepositTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[15]
+	mi := &file_v1_task_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1569,7 +2949,7 @@ func (x *OkexDepositTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OkexDepositTask.ProtoReflect.Descriptor instead.
 func (*OkexDepositTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{15}
+	return file_v1_task_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *OkexDepositTask) GetNetwork() Network {
@@ -1663,7 +3043,7 @@ type WithdrawExchangeTask struct {
 func (x *WithdrawExchangeTask) Reset() {
 	*x = WithdrawExchangeTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[16]
+		mi := &file_v1_task_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1676,7 +3056,7 @@ func (x *WithdrawExchangeTask) String() string {
 func (*WithdrawExchangeTask) ProtoMessage() {}
 
 func (x *WithdrawExchangeTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[16]
+	mi := &file_v1_task_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,7 +3069,7 @@ func (x *WithdrawExchangeTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawExchangeTask.ProtoReflect.Descriptor instead.
 func (*WithdrawExchangeTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{16}
+	return file_v1_task_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *WithdrawExchangeTask) GetWithdrawerId() string {
@@ -1785,12 +3165,15 @@ type StargateBridgeTask struct {
 	TxCompleted     *bool   `protobuf:"varint,9,opt,name=tx_completed,json=txCompleted,proto3,oneof" json:"tx_completed,omitempty"` //deprecated
 	Amount          *Amount `protobuf:"bytes,10,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
 	Tx              *TaskTx `protobuf:"bytes,11,opt,name=tx,proto3,oneof" json:"tx,omitempty"`
+	// the task waits for layerzero delivery unless it is set to false
+	WaitDelivery *bool   `protobuf:"varint,12,opt,name=wait_delivery,json=waitDelivery,proto3,oneof" json:"wait_delivery,omitempty"`
+	DstTxId      *string `protobuf:"bytes,13,opt,name=dst_tx_id,json=dstTxId,proto3,oneof" json:"dst_tx_id,omitempty"`
 }
 
 func (x *StargateBridgeTask) Reset() {
 	*x = StargateBridgeTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[17]
+		mi := &file_v1_task_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1803,7 +3186,7 @@ func (x *StargateBridgeTask) String() string {
 func (*StargateBridgeTask) ProtoMessage() {}
 
 func (x *StargateBridgeTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[17]
+	mi := &file_v1_task_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +3199,7 @@ func (x *StargateBridgeTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StargateBridgeTask.ProtoReflect.Descriptor instead.
 func (*StargateBridgeTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{17}
+	return file_v1_task_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *StargateBridgeTask) GetFromNetwork() Network {
@@ -1896,6 +3279,20 @@ func (x *StargateBridgeTask) GetTx() *TaskTx {
 	return nil
 }
 
+func (x *StargateBridgeTask) GetWaitDelivery() bool {
+	if x != nil && x.WaitDelivery != nil {
+		return *x.WaitDelivery
+	}
+	return false
+}
+
+func (x *StargateBridgeTask) GetDstTxId() string {
+	if x != nil && x.DstTxId != nil {
+		return *x.DstTxId
+	}
+	return ""
+}
+
 // deprecated
 type MockTask struct {
 	state         protoimpl.MessageState
@@ -1906,7 +3303,7 @@ type MockTask struct {
 func (x *MockTask) Reset() {
 	*x = MockTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[18]
+		mi := &file_v1_task_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1919,7 +3316,7 @@ func (x *MockTask) String() string {
 func (*MockTask) ProtoMessage() {}
 
 func (x *MockTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[18]
+	mi := &file_v1_task_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1932,7 +3329,7 @@ func (x *MockTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MockTask.ProtoReflect.Descriptor instead.
 func (*MockTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{18}
+	return file_v1_task_proto_rawDescGZIP(), []int{28}
 }
 
 type DelayTask struct {
@@ -1951,7 +3348,7 @@ type DelayTask struct {
 func (x *DelayTask) Reset() {
 	*x = DelayTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[19]
+		mi := &file_v1_task_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1964,7 +3361,7 @@ func (x *DelayTask) String() string {
 func (*DelayTask) ProtoMessage() {}
 
 func (x *DelayTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[19]
+	mi := &file_v1_task_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1977,7 +3374,7 @@ func (x *DelayTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelayTask.ProtoReflect.Descriptor instead.
 func (*DelayTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{19}
+	return file_v1_task_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DelayTask) GetDuration() int64 {
@@ -2039,7 +3436,7 @@ type OkexBinanaceTask struct {
 func (x *OkexBinanaceTask) Reset() {
 	*x = OkexBinanaceTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_task_proto_msgTypes[20]
+		mi := &file_v1_task_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2052,7 +3449,7 @@ func (x *OkexBinanaceTask) String() string {
 func (*OkexBinanaceTask) ProtoMessage() {}
 
 func (x *OkexBinanaceTask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_task_proto_msgTypes[20]
+	mi := &file_v1_task_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,7 +3462,7 @@ func (x *OkexBinanaceTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OkexBinanaceTask.ProtoReflect.Descriptor instead.
 func (*OkexBinanaceTask) Descriptor() ([]byte, []int) {
-	return file_v1_task_proto_rawDescGZIP(), []int{20}
+	return file_v1_task_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *OkexBinanaceTask) GetOkexWithdrawerId() string {
@@ -2150,483 +3547,815 @@ var file_v1_task_proto_rawDesc = []byte{
 	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x0a,
 	0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x05, 0x74, 0x6f, 0x6b,
 	0x65, 0x6e, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x22, 0xd3, 0x02, 0x0a, 0x0b, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2c, 0x0a, 0x0a, 0x66, 0x72,
-	0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x66,
-	0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x02,
-	0x74, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x54, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x01, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x54, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x30, 0x92, 0x41, 0x2d, 0x0a, 0x2b, 0xd2, 0x01, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0xd2, 0x01, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x08,
-	0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x22, 0xef, 0x04,
-	0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x74, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74,
-	0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x48, 0x01, 0x52, 0x07, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x37, 0x0a, 0x0d, 0x67, 0x61, 0x73, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2e, 0x41, 0x6d, 0x55, 0x6e, 0x69, 0x48, 0x03, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x45, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0a, 0x67, 0x61,
-	0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x55, 0x6e, 0x69, 0x48, 0x04, 0x52,
-	0x09, 0x67, 0x61, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
-	0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x55, 0x6e, 0x69, 0x48,
-	0x05, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23,
-	0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x02, 0x48, 0x06, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x78, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a,
-	0x02, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x73, 0x3a, 0x34,
-	0x92, 0x41, 0x31, 0x0a, 0x2f, 0xd2, 0x01, 0x0c, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0xd2, 0x01, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x0b, 0x72,
-	0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x07, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22,
-	0xf5, 0x02, 0x0a, 0x1a, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x79, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e,
-	0x64, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x46, 0x54, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x32,
-	0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x09, 0x74, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54,
-	0x78, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x54, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2e,
-	0x0a, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48,
-	0x01, 0x52, 0x08, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1a,
-	0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
-	0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x66, 0x65,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x88, 0x01,
-	0x01, 0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0xd2, 0x01, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x0a, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x78, 0xd2, 0x01,
-	0x09, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x78, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
-	0x69, 0x6e, 0x74, 0x5f, 0x74, 0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x5f, 0x74, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x42,
-	0x06, 0x0a, 0x04, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x53, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x22, 0xc8, 0x02, 0x0a, 0x08, 0x4c, 0x65, 0x6e, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78,
+	0x48, 0x01, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x88, 0x01, 0x01,
+	0x3a, 0x2b, 0x92, 0x41, 0x28, 0x0a, 0x26, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0xd2, 0x01, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x74, 0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x54, 0x78, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x69, 0x6e,
 	0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
 	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x12, 0x1b, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x02, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x01, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01,
+	0x3a, 0x0f, 0x92, 0x41, 0x0c, 0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x74, 0x78, 0x22, 0x8a, 0x05, 0x0a, 0x13, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x5a, 0x65, 0x72,
+	0x6f, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x5a, 0x65, 0x72,
+	0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5a,
+	0x65, 0x72, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x09, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x04, 0x52, 0x02, 0x74, 0x78,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x54, 0x78, 0x48, 0x05, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54,
+	0x78, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x0c, 0x77,
+	0x61, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x09, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x07, 0x52, 0x07, 0x64, 0x73, 0x74, 0x54, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x3a,
+	0x2e, 0x92, 0x41, 0x2b, 0x0a, 0x29, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0xd2, 0x01, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0xd2, 0x01, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0xd2, 0x01, 0x0a, 0x64, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x05, 0x0a, 0x03, 0x5f,
+	0x74, 0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x22, 0x9a, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x67, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2b, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x6b, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x54, 0x78, 0x48, 0x02, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x03,
+	0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x0f,
+	0x92, 0x41, 0x0c, 0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x22, 0xa5, 0x03,
+	0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x74,
+	0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x02, 0x52, 0x02, 0x74, 0x78,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x54, 0x78, 0x48, 0x03, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54,
+	0x78, 0x88, 0x01, 0x01, 0x3a, 0x20, 0x92, 0x41, 0x1d, 0x0a, 0x1b, 0xd2, 0x01, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x54, 0x78, 0x22, 0xd3, 0x02, 0x0a, 0x0b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
 	0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x02, 0x74, 0x78,
-	0x88, 0x01, 0x01, 0x3a, 0x14, 0x92, 0x41, 0x11, 0x0a, 0x0f, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x02, 0x74, 0x78, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78,
-	0x22, 0xfc, 0x01, 0x0a, 0x09, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x50, 0x12, 0x26,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x1b, 0x0a, 0x01, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x01, 0x61, 0x12, 0x1b,
-	0x0a, 0x01, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x01, 0x62, 0x12, 0x21, 0x0a, 0x02, 0x74,
-	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x64, 0x64,
-	0x3a, 0x26, 0x92, 0x41, 0x23, 0x0a, 0x21, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x01, 0x61, 0xd2, 0x01,
-	0x01, 0x62, 0xd2, 0x01, 0x03, 0x61, 0x64, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22,
-	0xbc, 0x01, 0x0a, 0x08, 0x57, 0x45, 0x54, 0x48, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x26, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x54, 0x78, 0x48, 0x01, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54,
+	0x78, 0x88, 0x01, 0x01, 0x3a, 0x30, 0x92, 0x41, 0x2d, 0x0a, 0x2b, 0xd2, 0x01, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x08, 0x74, 0x6f,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x22, 0xdd, 0x02, 0x0a, 0x0d,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x26, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x74, 0x6f, 0x5f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x09,
+	0x74, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21,
+	0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x54, 0x78, 0x48, 0x01, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x88,
+	0x01, 0x01, 0x3a, 0x32, 0x92, 0x41, 0x2f, 0x0a, 0x2d, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0xd2, 0x01, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0xd2, 0x01, 0x0a, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x22, 0x9f, 0x04, 0x0a, 0x12,
+	0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48,
+	0x00, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x54, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x54, 0x78, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x54, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x02, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x54, 0x78, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x48, 0x04, 0x52, 0x04, 0x73, 0x74, 0x65,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0a, 0x77, 0x61, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x3a, 0x18, 0x92, 0x41, 0x15, 0x0a,
+	0x13, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x54, 0x78, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x22, 0xf3, 0x01,
+	0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x25, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x75, 0x6e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x75,
+	0x6e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x3a,
+	0x0f, 0x92, 0x41, 0x0c, 0x0a, 0x0a, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x75, 0x6e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x22, 0xf5, 0x05, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2e,
+	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x48, 0x01, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x67, 0x61, 0x73, 0x5f, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x55, 0x6e, 0x69, 0x48, 0x03, 0x52,
+	0x0c, 0x67, 0x61, 0x73, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x31, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d,
+	0x55, 0x6e, 0x69, 0x48, 0x04, 0x52, 0x09, 0x67, 0x61, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
+	0x41, 0x6d, 0x55, 0x6e, 0x69, 0x48, 0x05, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x48, 0x06, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x48, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x3a, 0x34, 0x92, 0x41, 0x31, 0x0a, 0x2f, 0xd2, 0x01, 0x0c, 0x74,
+	0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0xd2, 0x01, 0x05, 0x74, 0x78,
+	0x5f, 0x69, 0x64, 0xd2, 0x01, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0xd2, 0x01, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x75, 0x72, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x67, 0x61, 0x73,
+	0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67,
+	0x61, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x0d,
+	0x54, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54,
+	0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x74, 0x73, 0x3a, 0x19, 0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01, 0x05,
+	0x74, 0x78, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x04, 0x74, 0x79, 0x70, 0x65, 0xd2, 0x01, 0x02, 0x74,
+	0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xda, 0x04, 0x0a, 0x1a, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x79, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x4e, 0x46, 0x54, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x32, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2e, 0x0a, 0x0a,
+	0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x52, 0x09, 0x74, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2a, 0x0a, 0x07,
+	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x06, 0x6d,
+	0x69, 0x6e, 0x74, 0x54, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x09, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x01, 0x52, 0x08, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x54, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x77,
+	0x61, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x04, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x7a,
+	0x65, 0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x05, 0x52, 0x0f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x09, 0x6c, 0x7a,
+	0x73, 0x63, 0x61, 0x6e, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x09, 0x64, 0x73,
+	0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52,
+	0x07, 0x64, 0x73, 0x74, 0x54, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x37, 0x92, 0x41, 0x34,
+	0x0a, 0x32, 0xd2, 0x01, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0xd2, 0x01, 0x0a, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01,
+	0x07, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x78, 0xd2, 0x01, 0x09, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x5f, 0x74, 0x78, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x78,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x74, 0x78, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x66, 0x65,
+	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x7a, 0x65,
+	0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x7a,
+	0x73, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x73, 0x74,
+	0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x53, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e,
 	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
-	0x12, 0x0a, 0x04, 0x77, 0x72, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77,
-	0x72, 0x61, 0x70, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52,
-	0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x1f, 0x92, 0x41, 0x1c, 0x0a, 0x1a, 0xd2, 0x01, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0xd2, 0x01, 0x04, 0x77, 0x72, 0x61, 0x70, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22, 0xa1,
-	0x05, 0x0a, 0x11, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x88,
+	0x01, 0x01, 0x3a, 0x14, 0x92, 0x41, 0x11, 0x0a, 0x0f, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x02, 0x74, 0x78, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22,
+	0xd4, 0x02, 0x0a, 0x09, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x50, 0x12, 0x26, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x1b, 0x0a, 0x01, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x01, 0x61, 0x12, 0x1b, 0x0a,
+	0x01, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x01, 0x62, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x64, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x4c, 0x50, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x3a, 0x26, 0x92, 0x41, 0x23, 0x0a,
+	0x21, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x01, 0x61, 0xd2, 0x01, 0x01, 0x62, 0xd2, 0x01, 0x03, 0x61,
+	0x64, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x0a, 0x4c, 0x50, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x62, 0x69, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x62,
+	0x69, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x18, 0x92, 0x41, 0x15, 0x0a, 0x13, 0xd2, 0x01,
+	0x04, 0x70, 0x6f, 0x6f, 0x6c, 0xd2, 0x01, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x62, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x08, 0x57,
+	0x45, 0x54, 0x48, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x72,
+	0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x72, 0x61, 0x70, 0x12, 0x21,
+	0x0a, 0x02, 0x74, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01,
+	0x01, 0x3a, 0x1f, 0x92, 0x41, 0x1c, 0x0a, 0x1a, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x04, 0x77, 0x72,
+	0x61, 0x70, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22, 0xdb, 0x06, 0x0a, 0x11, 0x4f, 0x72,
+	0x62, 0x69, 0x74, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x0b,
+	0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2e, 0x0a, 0x0a, 0x74,
+	0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x09, 0x74, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2c, 0x0a, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x6f, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x74, 0x6f, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x78, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x05, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x13, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x13, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x11, 0x6f,
+	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0d, 0x73,
+	0x77, 0x61, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x05, 0x52, 0x02, 0x74, 0x78, 0x88,
+	0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0e, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x31, 0x0a, 0x12, 0x64, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x10,
+	0x64, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x88, 0x01, 0x01, 0x3a, 0x42, 0x92, 0x41, 0x3f, 0x0a, 0x3d, 0xd2, 0x01, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x0a, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0xd2, 0x01, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x08,
+	0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x78, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x78,
+	0x5f, 0x69, 0x64, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x16, 0x0a,
+	0x14, 0x5f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x64, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0xe3, 0x01, 0x0a, 0x24, 0x5a, 0x6b, 0x53, 0x79,
+	0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x0b, 0x74, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x54, 0x78, 0x48, 0x02, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x0e, 0x92, 0x41,
+	0x0b, 0x0a, 0x09, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22, 0xba, 0x04,
+	0x0a, 0x22, 0x5a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
 	0x54, 0x61, 0x73, 0x6b, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0c,
-	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x2e, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x09, 0x74, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x2c, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28,
-	0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
 	0x52, 0x0b, 0x74, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x18, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x01, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x6f, 0x72,
-	0x62, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x13, 0x6f, 0x72, 0x62,
-	0x69, 0x74, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x03, 0x52, 0x11, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x77, 0x61, 0x70,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x04, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x05,
-	0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x42, 0x92, 0x41, 0x3f, 0x0a, 0x3d, 0xd2, 0x01,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x0a, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0xd2, 0x01, 0x08, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6f, 0x72, 0x62, 0x69, 0x74,
-	0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x77, 0x61,
-	0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x5f,
-	0x74, 0x78, 0x22, 0xe3, 0x01, 0x0a, 0x24, 0x5a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66,
-	0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x45,
-	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x26, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x78, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74,
-	0x78, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x78, 0x49,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x02,
-	0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x0e, 0x92, 0x41, 0x0b, 0x0a, 0x09, 0xd2, 0x01,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x78, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49,
-	0x64, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22, 0xc5, 0x02, 0x0a, 0x22, 0x5a, 0x6b, 0x53,
-	0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x26, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4b,
-	0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x78, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74,
-	0x78, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x78, 0x49,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x02,
-	0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x26, 0x92, 0x41, 0x23, 0x0a, 0x21, 0xd2, 0x01,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0xd2, 0x01, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78,
-	0x22, 0xa7, 0x05, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x31, 0x69, 0x6e, 0x63, 0x68, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a,
+	0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x54, 0x78, 0x48, 0x02, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03,
+	0x52, 0x08, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
+	0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48,
+	0x04, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x78, 0x88, 0x01, 0x01,
+	0x12, 0x3e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x05, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x46, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x3a, 0x26, 0x92, 0x41, 0x23, 0x0a, 0x21, 0xd2, 0x01, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0xd2, 0x01, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x54, 0x78, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x22, 0xa7, 0x05, 0x0a, 0x0d, 0x53,
+	0x77, 0x61, 0x70, 0x31, 0x69, 0x6e, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x07, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0b, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x02, 0x52, 0x0b, 0x74, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0d, 0x73, 0x77, 0x61,
+	0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x7e, 0x92,
+	0x41, 0x7b, 0x0a, 0x79, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01,
 	0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a,
-	0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a,
-	0x0d, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x1b, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x12, 0x23,
-	0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x74, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x5f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x03, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x88, 0x01, 0x01, 0x3a, 0x7e, 0x92, 0x41, 0x7b, 0x0a, 0x79, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0xd2, 0x01, 0x0d, 0x74, 0x6f, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x0d, 0x74, 0x6f, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x0d, 0x74, 0x6f, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x77, 0x61, 0x70,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xdd, 0x01, 0x0a, 0x10, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x2e,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x57, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x73, 0x6b,
-	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x3a, 0x18, 0x92, 0x41, 0x15, 0x0a, 0x13, 0xd2, 0x01, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0xd2, 0x01, 0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x15, 0x54, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29,
-	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
-	0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61,
-	0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x01,
-	0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x29, 0x92, 0x41, 0x26, 0x0a, 0x24, 0xd2, 0x01,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x74, 0x78, 0x22, 0x91, 0x04, 0x0a, 0x0f, 0x4f, 0x6b, 0x65, 0x78, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0xd2, 0x01, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0xd2, 0x01, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0xd2, 0x01, 0x0d, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x0d, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x0d, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49, 0x64,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x22, 0xdd, 0x01, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x40, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x1a, 0x57, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x18, 0x92, 0x41, 0x15, 0x0a,
+	0x13, 0xd2, 0x01, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0xd2, 0x01, 0x05, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2d, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe2,
+	0x03, 0x0a, 0x15, 0x54, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72,
 	0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x12, 0x27, 0x0a, 0x0d, 0x6f, 0x6b, 0x65, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6b,
-	0x65, 0x78, 0x41, 0x63, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
-	0x6f, 0x6b, 0x65, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x01, 0x52, 0x08, 0x6f, 0x6b, 0x65, 0x78, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x03, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x74,
-	0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x04, 0x52, 0x0a, 0x74, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x2f, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0f,
-	0x73, 0x75, 0x62, 0x4d, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x88,
-	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x48, 0x06, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x07, 0x52, 0x02, 0x74, 0x78, 0x88,
-	0x01, 0x01, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
-	0x6f, 0x6b, 0x65, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x6f, 0x6b, 0x65, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49, 0x64,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22, 0xd4, 0x04, 0x0a, 0x14, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x02, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
-	0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x04, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x5f,
-	0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x05, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x88,
-	0x01, 0x01, 0x3a, 0x55, 0x92, 0x41, 0x52, 0x0a, 0x50, 0xd2, 0x01, 0x0d, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e,
-	0xd2, 0x01, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0xd2, 0x01, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74,
-	0x78, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x65,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x11, 0x0a, 0x0f,
-	0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22,
-	0xe2, 0x04, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x31, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x0b, 0x66, 0x72,
-	0x6f, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2d, 0x0a, 0x09, 0x74, 0x6f, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x09, 0x74,
-	0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15,
-	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x66,
-	0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f,
-	0x0a, 0x11, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0f, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
-	0x22, 0x0a, 0x0a, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x55, 0x72, 0x6c,
-	0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0b, 0x74, 0x78, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x05, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x54, 0x78, 0x48, 0x06, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x3a, 0x3e, 0x92, 0x41, 0x3b,
-	0x0a, 0x39, 0xd2, 0x01, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0xd2, 0x01, 0x09, 0x74, 0x6f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x09, 0x66,
-	0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f,
-	0x66, 0x65, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42, 0x14, 0x0a, 0x12,
-	0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x72,
-	0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x74, 0x78, 0x22, 0x0a, 0x0a, 0x08, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b,
-	0x22, 0xcb, 0x02, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x77, 0x61,
-	0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74,
-	0x46, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x22,
-	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x88,
-	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e,
-	0x64, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x03, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x3a, 0x19, 0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01, 0x08, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xd2, 0x01, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xed,
-	0x02, 0x0a, 0x10, 0x4f, 0x6b, 0x65, 0x78, 0x42, 0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x6b, 0x65, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f,
-	0x6b, 0x65, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x6f, 0x6b, 0x65, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6f, 0x6b, 0x65, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a,
-	0x0b, 0x6f, 0x6b, 0x65, 0x78, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6b, 0x65, 0x78, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
-	0x30, 0x0a, 0x13, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x69,
-	0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x78, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x78, 0x49, 0x64,
-	0x88, 0x01, 0x01, 0x3a, 0x5f, 0x92, 0x41, 0x5c, 0x0a, 0x5a, 0xd2, 0x01, 0x10, 0x6f, 0x6b, 0x65,
-	0x78, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x14,
-	0x62, 0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x0b, 0x6f, 0x6b, 0x65, 0x78, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0xd2, 0x01, 0x09, 0x6f, 0x6b, 0x65, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01,
-	0x13, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x78, 0x49, 0x64, 0x2a, 0xe0,
-	0x04, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x53,
-	0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x4d, 0x6f, 0x63, 0x6b, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x6b,
-	0x65, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x54,
-	0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f,
-	0x74, 0x65, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x6b, 0x65, 0x78, 0x42, 0x69, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x31, 0x69, 0x6e,
-	0x63, 0x68, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70,
-	0x10, 0x09, 0x12, 0x22, 0x0a, 0x1e, 0x5a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69,
-	0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65,
-	0x72, 0x65, 0x75, 0x6d, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65,
-	0x72, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20, 0x5a, 0x6b, 0x53,
-	0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x10, 0x0c, 0x12,
-	0x08, 0x0a, 0x04, 0x57, 0x45, 0x54, 0x48, 0x10, 0x0d, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x75, 0x74,
-	0x65, 0x69, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x79, 0x6e,
-	0x63, 0x53, 0x77, 0x61, 0x70, 0x4c, 0x50, 0x10, 0x0f, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x61, 0x76,
-	0x65, 0x72, 0x69, 0x63, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x10, 0x10, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x46, 0x49, 0x53, 0x77, 0x61, 0x70, 0x10, 0x11, 0x12, 0x10, 0x0a, 0x0c,
-	0x56, 0x65, 0x6c, 0x6f, 0x63, 0x6f, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x10, 0x12, 0x12, 0x0d,
-	0x0a, 0x09, 0x49, 0x7a, 0x75, 0x6d, 0x69, 0x53, 0x77, 0x61, 0x70, 0x10, 0x13, 0x12, 0x0e, 0x0a,
-	0x0a, 0x56, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x10, 0x14, 0x12, 0x11, 0x0a,
-	0x0d, 0x45, 0x7a, 0x6b, 0x61, 0x6c, 0x69, 0x62, 0x75, 0x72, 0x53, 0x77, 0x61, 0x70, 0x10, 0x15,
-	0x12, 0x0a, 0x0a, 0x06, 0x5a, 0x6b, 0x53, 0x77, 0x61, 0x70, 0x10, 0x16, 0x12, 0x11, 0x0a, 0x0d,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x4a, 0x6f, 0x65, 0x53, 0x77, 0x61, 0x70, 0x10, 0x17, 0x12,
-	0x1a, 0x0a, 0x16, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x79, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e, 0x64,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x46, 0x54, 0x10, 0x18, 0x12, 0x19, 0x0a, 0x15, 0x44,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x10, 0x19, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x77, 0x61, 0x70, 0x31, 0x30,
-	0x6b, 0x10, 0x1a, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x61, 0x6e, 0x63, 0x61, 0x6b, 0x65, 0x53, 0x77,
-	0x61, 0x70, 0x10, 0x1b, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x69, 0x74, 0x68, 0x53, 0x77, 0x61, 0x70,
-	0x10, 0x1c, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x65, 0x64, 0x69, 0x53, 0x77, 0x61, 0x70, 0x10, 0x1d,
-	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x79, 0x53, 0x77, 0x61, 0x70, 0x10, 0x1e, 0x12, 0x0f, 0x0a, 0x0b,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x73, 0x53, 0x77, 0x61, 0x70, 0x10, 0x1f, 0x12, 0x12, 0x0a,
-	0x0e, 0x53, 0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x10,
-	0x20, 0x42, 0x09, 0x5a, 0x07, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x02, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x01, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5a,
+	0x65, 0x72, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x09, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x09, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x07, 0x64, 0x73, 0x74, 0x54, 0x78, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x3a, 0x29, 0x92, 0x41, 0x26, 0x0a, 0x24, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0xd2, 0x01, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2,
+	0x01, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x7a, 0x73, 0x63, 0x61,
+	0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x78,
+	0x5f, 0x69, 0x64, 0x22, 0x91, 0x04, 0x0a, 0x0f, 0x4f, 0x6b, 0x65, 0x78, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x27, 0x0a, 0x0d, 0x6f, 0x6b, 0x65, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6b, 0x65,
+	0x78, 0x41, 0x63, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6f,
+	0x6b, 0x65, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x08, 0x6f, 0x6b, 0x65, 0x78, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x78,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x04, 0x52, 0x0a, 0x74, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2f, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0f, 0x73,
+	0x75, 0x62, 0x4d, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x48, 0x06, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x02, 0x74, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x78, 0x48, 0x07, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01,
+	0x01, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0xd2, 0x01, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f,
+	0x6b, 0x65, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6f, 0x6b, 0x65, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x22, 0xd4, 0x04, 0x0a, 0x14, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64,
+	0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x04, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61,
+	0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05,
+	0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x88, 0x01,
+	0x01, 0x3a, 0x55, 0x92, 0x41, 0x52, 0x0a, 0x50, 0xd2, 0x01, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0xd2, 0x01, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0xd2,
+	0x01, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0xd2, 0x01, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x78,
+	0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xcd,
+	0x05, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x31, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x0b, 0x66, 0x72, 0x6f,
+	0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2d, 0x0a, 0x09, 0x74, 0x6f, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x09, 0x74, 0x6f,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x78, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x11, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0f, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5a, 0x65, 0x72, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x55, 0x72, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0b, 0x74, 0x78, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x05, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54,
+	0x78, 0x48, 0x06, 0x52, 0x02, 0x74, 0x78, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x07, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x09, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x07, 0x64, 0x73, 0x74, 0x54, 0x78,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x3e, 0x92, 0x41, 0x3b, 0x0a, 0x39, 0xd2, 0x01, 0x0b, 0x66,
+	0x72, 0x6f, 0x6d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x09, 0x74, 0x6f, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0xd2, 0x01, 0x07, 0x74, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6c, 0x7a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x78, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x22, 0x0a,
+	0x0a, 0x08, 0x4d, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x22, 0xcb, 0x02, 0x0a, 0x09, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09,
+	0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x3a, 0x19,
+	0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0xd2, 0x01, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xed, 0x02, 0x0a, 0x10, 0x4f, 0x6b, 0x65,
+	0x78, 0x42, 0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2a, 0x0a,
+	0x10, 0x6f, 0x6b, 0x65, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x6b, 0x65, 0x78, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x6b, 0x65,
+	0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x6b,
+	0x65, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6b, 0x65, 0x78, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6b,
+	0x65, 0x78, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x30, 0x0a, 0x13, 0x62, 0x69, 0x6e,
+	0x61, 0x6e, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x61, 0x63, 0x65,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x04, 0x74,
+	0x78, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x54, 0x78, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x78, 0x49, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x5f, 0x92,
+	0x41, 0x5c, 0x0a, 0x5a, 0xd2, 0x01, 0x10, 0x6f, 0x6b, 0x65, 0x78, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01, 0x14, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x61,
+	0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x49, 0x64, 0xd2, 0x01,
+	0x0b, 0x6f, 0x6b, 0x65, 0x78, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0xd2, 0x01, 0x09, 0x6f,
+	0x6b, 0x65, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0xd2, 0x01, 0x13, 0x62, 0x69, 0x6e, 0x61, 0x6e,
+	0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x74, 0x78, 0x49, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x54, 0x78, 0x49, 0x64, 0x2a, 0xd5, 0x07, 0x0a, 0x08, 0x54, 0x61, 0x73,
+	0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x6f, 0x63,
+	0x6b, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x6b, 0x65, 0x78, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x77, 0x61, 0x70, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x10, 0x06, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4f, 0x6b, 0x65, 0x78, 0x42, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x10, 0x07, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x31, 0x69, 0x6e, 0x63, 0x68, 0x10, 0x08, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x10, 0x09, 0x12, 0x22, 0x0a, 0x1e,
+	0x5a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x54, 0x6f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x10, 0x0a,
+	0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20, 0x5a, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x45,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x10, 0x0c, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x54,
+	0x48, 0x10, 0x0d, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x75, 0x74, 0x65, 0x69, 0x6f, 0x53, 0x77, 0x61,
+	0x70, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x77, 0x61, 0x70, 0x4c,
+	0x50, 0x10, 0x0f, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x61, 0x76, 0x65, 0x72, 0x69, 0x63, 0x6b, 0x53,
+	0x77, 0x61, 0x70, 0x10, 0x10, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x46, 0x49,
+	0x53, 0x77, 0x61, 0x70, 0x10, 0x11, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x6f,
+	0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x10, 0x12, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x7a, 0x75, 0x6d,
+	0x69, 0x53, 0x77, 0x61, 0x70, 0x10, 0x13, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x65, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x77, 0x61, 0x70, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x7a, 0x6b, 0x61, 0x6c,
+	0x69, 0x62, 0x75, 0x72, 0x53, 0x77, 0x61, 0x70, 0x10, 0x15, 0x12, 0x0a, 0x0a, 0x06, 0x5a, 0x6b,
+	0x53, 0x77, 0x61, 0x70, 0x10, 0x16, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x4a, 0x6f, 0x65, 0x53, 0x77, 0x61, 0x70, 0x10, 0x17, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x79, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x4e, 0x46, 0x54, 0x10, 0x18, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53,
+	0x74, 0x61, 0x72, 0x6b, 0x4e, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x19,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x77, 0x61, 0x70, 0x31, 0x30, 0x6b, 0x10, 0x1a, 0x12, 0x0f, 0x0a,
+	0x0b, 0x50, 0x61, 0x6e, 0x63, 0x61, 0x6b, 0x65, 0x53, 0x77, 0x61, 0x70, 0x10, 0x1b, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x69, 0x74, 0x68, 0x53, 0x77, 0x61, 0x70, 0x10, 0x1c, 0x12, 0x0c, 0x0a, 0x08,
+	0x4a, 0x65, 0x64, 0x69, 0x53, 0x77, 0x61, 0x70, 0x10, 0x1d, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x79,
+	0x53, 0x77, 0x61, 0x70, 0x10, 0x1e, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x73, 0x53, 0x77, 0x61, 0x70, 0x10, 0x1f, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x6b,
+	0x4e, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x10, 0x20, 0x12, 0x0e, 0x0a, 0x0a, 0x4c,
+	0x65, 0x6e, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x10, 0x21, 0x12, 0x10, 0x0a, 0x0c, 0x4c,
+	0x65, 0x6e, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x10, 0x22, 0x12, 0x0c, 0x0a,
+	0x08, 0x4d, 0x75, 0x74, 0x65, 0x69, 0x6f, 0x4c, 0x50, 0x10, 0x23, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x4c, 0x50, 0x10, 0x24, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x65,
+	0x6c, 0x6f, 0x63, 0x6f, 0x72, 0x65, 0x4c, 0x50, 0x10, 0x25, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x7a,
+	0x75, 0x6d, 0x69, 0x4c, 0x50, 0x10, 0x26, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x61, 0x76, 0x65, 0x72,
+	0x69, 0x63, 0x6b, 0x4c, 0x50, 0x10, 0x27, 0x12, 0x19, 0x0a, 0x15, 0x5a, 0x6b, 0x53, 0x79, 0x6e,
+	0x63, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6e, 0x74,
+	0x10, 0x28, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x69, 0x6e,
+	0x74, 0x10, 0x29, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x4d, 0x69,
+	0x6e, 0x74, 0x10, 0x2a, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x5a, 0x65, 0x72,
+	0x6f, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x10, 0x2b, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x74, 0x67,
+	0x4c, 0x6f, 0x63, 0x6b, 0x10, 0x2c, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x74, 0x67, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x10, 0x2d, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x74, 0x61,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x10, 0x2e,
+	0x12, 0x10, 0x0a, 0x0c, 0x41, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x10, 0x2f, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x6f, 0x70, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x10,
+	0x30, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x79, 0x6e, 0x61, 0x70, 0x73, 0x65, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x10, 0x31, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x10, 0x32, 0x12,
+	0x1a, 0x0a, 0x16, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x10, 0x33, 0x12, 0x13, 0x0a, 0x0f, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x10, 0x34,
+	0x2a, 0x38, 0x0a, 0x0c, 0x4c, 0x65, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x08, 0x0a, 0x04, 0x41, 0x61, 0x76, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x72,
+	0x61, 0x4c, 0x65, 0x6e, 0x64, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x46, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x2a, 0x7b, 0x0a, 0x17, 0x53, 0x74,
+	0x61, 0x72, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x4c, 0x50, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x74, 0x67,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x50, 0x10,
+	0x03, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x52,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x10, 0x05, 0x2a, 0x60, 0x0a, 0x16, 0x4f, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x53, 0x74, 0x65,
+	0x70, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x10, 0x02, 0x2a, 0x31, 0x0a, 0x11, 0x4c, 0x61, 0x79,
+	0x65, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x08,
+	0x0a, 0x04, 0x4f, 0x4e, 0x46, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x46, 0x54, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x46, 0x54, 0x56, 0x32, 0x10, 0x02, 0x2a, 0x30, 0x0a, 0x11,
+	0x54, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x55, 0x70, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x54, 0x78, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x01, 0x42, 0x09,
+	0x5a, 0x07, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2641,104 +4370,164 @@ func file_v1_task_proto_rawDescGZIP() []byte {
 	return file_v1_task_proto_rawDescData
 }
 
-var file_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_v1_task_proto_goTypes = []interface{}{
 	(TaskType)(0),                                // 0: task.TaskType
-	(*TxDetail)(nil),                             // 1: task.TxDetail
-	(*LiquidityBridgeTask)(nil),                  // 2: task.LiquidityBridgeTask
-	(*DefaultSwap)(nil),                          // 3: task.DefaultSwap
-	(*TaskTx)(nil),                               // 4: task.TaskTx
-	(*MerklyMintAndBridgeNFTTask)(nil),           // 5: task.MerklyMintAndBridgeNFTTask
-	(*DeployStarkNetAccountTask)(nil),            // 6: task.DeployStarkNetAccountTask
-	(*DefaultLP)(nil),                            // 7: task.DefaultLP
-	(*WETHTask)(nil),                             // 8: task.WETHTask
-	(*OrbiterBridgeTask)(nil),                    // 9: task.OrbiterBridgeTask
-	(*ZkSyncOfficialBridgeFromEthereumTask)(nil), // 10: task.ZkSyncOfficialBridgeFromEthereumTask
-	(*ZkSyncOfficialBridgeToEthereumTask)(nil),   // 11: task.ZkSyncOfficialBridgeToEthereumTask
-	(*Swap1InchTask)(nil),                        // 12: task.Swap1inchTask
-	(*SnapshotVoteTask)(nil),                     // 13: task.SnapshotVoteTask
-	(*SnapshotVoteProposal)(nil),                 // 14: task.SnapshotVoteProposal
-	(*TestNetBridgeSwapTask)(nil),                // 15: task.TestNetBridgeSwapTask
-	(*OkexDepositTask)(nil),                      // 16: task.OkexDepositTask
-	(*WithdrawExchangeTask)(nil),                 // 17: task.WithdrawExchangeTask
-	(*StargateBridgeTask)(nil),                   // 18: task.StargateBridgeTask
-	(*MockTask)(nil),                             // 19: task.MockTask
-	(*DelayTask)(nil),                            // 20: task.DelayTask
-	(*OkexBinanaceTask)(nil),                     // 21: task.OkexBinanaceTask
-	nil,                                          // 22: task.SnapshotVoteTask.ProposalEntry
-	(*Amount)(nil),                               // 23: shared.Amount
-	(Network)(0),                                 // 24: shared.Network
-	(Token)(0),                                   // 25: shared.Token
-	(*AmUni)(nil),                                // 26: shared.AmUni
-	(ProcessStatus)(0),                           // 27: shared.ProcessStatus
-	(*timestamppb.Timestamp)(nil),                // 28: google.protobuf.Timestamp
+	(LendProtocol)(0),                            // 1: task.LendProtocol
+	(StargateLiquidityAction)(0),                 // 2: task.StargateLiquidityAction
+	(OfficialWithdrawalStep)(0),                  // 3: task.OfficialWithdrawalStep
+	(LayerZeroContract)(0),                       // 4: task.LayerZeroContract
+	(TxReplacementType)(0),                       // 5: task.TxReplacementType
+	(*TxDetail)(nil),                             // 6: task.TxDetail
+	(*LiquidityBridgeTask)(nil),                  // 7: task.LiquidityBridgeTask
+	(*LendTask)(nil),                             // 8: task.LendTask
+	(*DomainMintTask)(nil),                       // 9: task.DomainMintTask
+	(*LayerZeroBridgeTask)(nil),                  // 10: task.LayerZeroBridgeTask
+	(*StgLockTask)(nil),                          // 11: task.StgLockTask
+	(*StargateLiquidityTask)(nil),                // 12: task.StargateLiquidityTask
+	(*DefaultSwap)(nil),                          // 13: task.DefaultSwap
+	(*DefaultBridge)(nil),                        // 14: task.DefaultBridge
+	(*OfficialBridgeTask)(nil),                   // 15: task.OfficialBridgeTask
+	(*RevokeApprovalsTask)(nil),                  // 16: task.RevokeApprovalsTask
+	(*TaskTx)(nil),                               // 17: task.TaskTx
+	(*TxReplacement)(nil),                        // 18: task.TxReplacement
+	(*MerklyMintAndBridgeNFTTask)(nil),           // 19: task.MerklyMintAndBridgeNFTTask
+	(*DeployStarkNetAccountTask)(nil),            // 20: task.DeployStarkNetAccountTask
+	(*DefaultLP)(nil),                            // 21: task.DefaultLP
+	(*LPPosition)(nil),                           // 22: task.LPPosition
+	(*WETHTask)(nil),                             // 23: task.WETHTask
+	(*OrbiterBridgeTask)(nil),                    // 24: task.OrbiterBridgeTask
+	(*ZkSyncOfficialBridgeFromEthereumTask)(nil), // 25: task.ZkSyncOfficialBridgeFromEthereumTask
+	(*ZkSyncOfficialBridgeToEthereumTask)(nil),   // 26: task.ZkSyncOfficialBridgeToEthereumTask
+	(*Swap1InchTask)(nil),                        // 27: task.Swap1inchTask
+	(*SnapshotVoteTask)(nil),                     // 28: task.SnapshotVoteTask
+	(*SnapshotVoteProposal)(nil),                 // 29: task.SnapshotVoteProposal
+	(*TestNetBridgeSwapTask)(nil),                // 30: task.TestNetBridgeSwapTask
+	(*OkexDepositTask)(nil),                      // 31: task.OkexDepositTask
+	(*WithdrawExchangeTask)(nil),                 // 32: task.WithdrawExchangeTask
+	(*StargateBridgeTask)(nil),                   // 33: task.StargateBridgeTask
+	(*MockTask)(nil),                             // 34: task.MockTask
+	(*DelayTask)(nil),                            // 35: task.DelayTask
+	(*OkexBinanaceTask)(nil),                     // 36: task.OkexBinanaceTask
+	nil,                                          // 37: task.SnapshotVoteTask.ProposalEntry
+	(*Amount)(nil),                               // 38: shared.Amount
+	(Network)(0),                                 // 39: shared.Network
+	(Token)(0),                                   // 40: shared.Token
+	(*timestamppb.Timestamp)(nil),                // 41: google.protobuf.Timestamp
+	(*AmUni)(nil),                                // 42: shared.AmUni
+	(ProcessStatus)(0),                           // 43: shared.ProcessStatus
 }
 var file_v1_task_proto_depIdxs = []int32{
-	23, // 0: task.LiquidityBridgeTask.amount:type_name -> shared.Amount
-	24, // 1: task.LiquidityBridgeTask.from_network:type_name -> shared.Network
-	24, // 2: task.LiquidityBridgeTask.to_network:type_name -> shared.Network
-	25, // 3: task.LiquidityBridgeTask.token:type_name -> shared.Token
-	4,  // 4: task.LiquidityBridgeTask.tx:type_name -> task.TaskTx
-	4,  // 5: task.LiquidityBridgeTask.approveTx:type_name -> task.TaskTx
-	23, // 6: task.DefaultSwap.amount:type_name -> shared.Amount
-	24, // 7: task.DefaultSwap.network:type_name -> shared.Network
-	25, // 8: task.DefaultSwap.from_token:type_name -> shared.Token
-	25, // 9: task.DefaultSwap.to_token:type_name -> shared.Token
-	4,  // 10: task.DefaultSwap.tx:type_name -> task.TaskTx
-	4,  // 11: task.DefaultSwap.approveTx:type_name -> task.TaskTx
-	24, // 12: task.TaskTx.network:type_name -> shared.Network
-	26, // 13: task.TaskTx.gas_estimated:type_name -> shared.AmUni
-	26, // 14: task.TaskTx.gas_result:type_name -> shared.AmUni
-	26, // 15: task.TaskTx.gas_limit:type_name -> shared.AmUni
-	1,  // 16: task.TaskTx.details:type_name -> task.TxDetail
-	24, // 17: task.MerklyMintAndBridgeNFTTask.from_network:type_name -> shared.Network
-	24, // 18: task.MerklyMintAndBridgeNFTTask.to_network:type_name -> shared.Network
-	4,  // 19: task.MerklyMintAndBridgeNFTTask.mint_tx:type_name -> task.TaskTx
-	4,  // 20: task.MerklyMintAndBridgeNFTTask.bridge_tx:type_name -> task.TaskTx
-	24, // 21: task.DeployStarkNetAccountTask.network:type_name -> shared.Network
-	4,  // 22: task.DeployStarkNetAccountTask.tx:type_name -> task.TaskTx
-	23, // 23: task.DefaultLP.amount:type_name -> shared.Amount
-	24, // 24: task.DefaultLP.network:type_name -> shared.Network
-	25, // 25: task.DefaultLP.a:type_name -> shared.Token
-	25, // 26: task.DefaultLP.b:type_name -> shared.Token
-	4,  // 27: task.DefaultLP.tx:type_name -> task.TaskTx
-	23, // 28: task.WETHTask.amount:type_name -> shared.Amount
-	24, // 29: task.WETHTask.network:type_name -> shared.Network
-	4,  // 30: task.WETHTask.tx:type_name -> task.TaskTx
-	23, // 31: task.OrbiterBridgeTask.amount:type_name -> shared.Amount
-	24, // 32: task.OrbiterBridgeTask.from_network:type_name -> shared.Network
-	24, // 33: task.OrbiterBridgeTask.to_network:type_name -> shared.Network
-	25, // 34: task.OrbiterBridgeTask.from_token:type_name -> shared.Token
-	25, // 35: task.OrbiterBridgeTask.to_token:type_name -> shared.Token
-	4,  // 36: task.OrbiterBridgeTask.tx:type_name -> task.TaskTx
-	23, // 37: task.ZkSyncOfficialBridgeFromEthereumTask.amount:type_name -> shared.Amount
-	4,  // 38: task.ZkSyncOfficialBridgeFromEthereumTask.tx:type_name -> task.TaskTx
-	23, // 39: task.ZkSyncOfficialBridgeToEthereumTask.amount:type_name -> shared.Amount
-	24, // 40: task.ZkSyncOfficialBridgeToEthereumTask.network:type_name -> shared.Network
-	4,  // 41: task.ZkSyncOfficialBridgeToEthereumTask.tx:type_name -> task.TaskTx
-	24, // 42: task.Swap1inchTask.network:type_name -> shared.Network
-	22, // 43: task.SnapshotVoteTask.proposal:type_name -> task.SnapshotVoteTask.ProposalEntry
-	27, // 44: task.SnapshotVoteProposal.status:type_name -> shared.ProcessStatus
-	24, // 45: task.TestNetBridgeSwapTask.network:type_name -> shared.Network
-	4,  // 46: task.TestNetBridgeSwapTask.tx:type_name -> task.TaskTx
-	24, // 47: task.OkexDepositTask.network:type_name -> shared.Network
-	25, // 48: task.OkexDepositTask.token:type_name -> shared.Token
-	23, // 49: task.OkexDepositTask.amount:type_name -> shared.Amount
-	4,  // 50: task.OkexDepositTask.tx:type_name -> task.TaskTx
-	24, // 51: task.StargateBridgeTask.fromNetwork:type_name -> shared.Network
-	24, // 52: task.StargateBridgeTask.toNetwork:type_name -> shared.Network
-	25, // 53: task.StargateBridgeTask.fromToken:type_name -> shared.Token
-	25, // 54: task.StargateBridgeTask.toToken:type_name -> shared.Token
-	23, // 55: task.StargateBridgeTask.amount:type_name -> shared.Amount
-	4,  // 56: task.StargateBridgeTask.tx:type_name -> task.TaskTx
-	28, // 57: task.DelayTask.wait_for:type_name -> google.protobuf.Timestamp
-	14, // 58: task.SnapshotVoteTask.ProposalEntry.value:type_name -> task.SnapshotVoteProposal
-	59, // [59:59] is the sub-list for method output_type
-	59, // [59:59] is the sub-list for method input_type
-	59, // [59:59] is the sub-list for extension type_name
-	59, // [59:59] is the sub-list for extension extendee
-	0,  // [0:59] is the sub-list for field type_name
+	38,  // 0: task.LiquidityBridgeTask.amount:type_name -> shared.Amount
+	39,  // 1: task.LiquidityBridgeTask.from_network:type_name -> shared.Network
+	39,  // 2: task.LiquidityBridgeTask.to_network:type_name -> shared.Network
+	40,  // 3: task.LiquidityBridgeTask.token:type_name -> shared.Token
+	17,  // 4: task.LiquidityBridgeTask.tx:type_name -> task.TaskTx
+	17,  // 5: task.LiquidityBridgeTask.approveTx:type_name -> task.TaskTx
+	38,  // 6: task.LendTask.amount:type_name -> shared.Amount
+	39,  // 7: task.LendTask.network:type_name -> shared.Network
+	40,  // 8: task.LendTask.token:type_name -> shared.Token
+	1,   // 9: task.LendTask.protocol:type_name -> task.LendProtocol
+	17,  // 10: task.LendTask.tx:type_name -> task.TaskTx
+	17,  // 11: task.LendTask.approveTx:type_name -> task.TaskTx
+	39,  // 12: task.DomainMintTask.network:type_name -> shared.Network
+	17,  // 13: task.DomainMintTask.tx:type_name -> task.TaskTx
+	39,  // 14: task.LayerZeroBridgeTask.network:type_name -> shared.Network
+	4,   // 15: task.LayerZeroBridgeTask.kind:type_name -> task.LayerZeroContract
+	38,  // 16: task.LayerZeroBridgeTask.amount:type_name -> shared.Amount
+	17,  // 17: task.LayerZeroBridgeTask.tx:type_name -> task.TaskTx
+	17,  // 18: task.LayerZeroBridgeTask.approveTx:type_name -> task.TaskTx
+	39,  // 19: task.StgLockTask.network:type_name -> shared.Network
+	38,  // 20: task.StgLockTask.amount:type_name -> shared.Amount
+	17,  // 21: task.StgLockTask.tx:type_name -> task.TaskTx
+	17,  // 22: task.StgLockTask.approveTx:type_name -> task.TaskTx
+	39,  // 23: task.StargateLiquidityTask.network:type_name -> shared.Network
+	40,  // 24: task.StargateLiquidityTask.token:type_name -> shared.Token
+	2,   // 25: task.StargateLiquidityTask.action:type_name -> task.StargateLiquidityAction
+	38,  // 26: task.StargateLiquidityTask.amount:type_name -> shared.Amount
+	39,  // 27: task.StargateLiquidityTask.to_network:type_name -> shared.Network
+	17,  // 28: task.StargateLiquidityTask.tx:type_name -> task.TaskTx
+	17,  // 29: task.StargateLiquidityTask.approveTx:type_name -> task.TaskTx
+	38,  // 30: task.DefaultSwap.amount:type_name -> shared.Amount
+	39,  // 31: task.DefaultSwap.network:type_name -> shared.Network
+	40,  // 32: task.DefaultSwap.from_token:type_name -> shared.Token
+	40,  // 33: task.DefaultSwap.to_token:type_name -> shared.Token
+	17,  // 34: task.DefaultSwap.tx:type_name -> task.TaskTx
+	17,  // 35: task.DefaultSwap.approveTx:type_name -> task.TaskTx
+	38,  // 36: task.DefaultBridge.amount:type_name -> shared.Amount
+	39,  // 37: task.DefaultBridge.from_network:type_name -> shared.Network
+	39,  // 38: task.DefaultBridge.to_network:type_name -> shared.Network
+	40,  // 39: task.DefaultBridge.token:type_name -> shared.Token
+	17,  // 40: task.DefaultBridge.tx:type_name -> task.TaskTx
+	17,  // 41: task.DefaultBridge.approveTx:type_name -> task.TaskTx
+	39,  // 42: task.OfficialBridgeTask.network:type_name -> shared.Network
+	38,  // 43: task.OfficialBridgeTask.amount:type_name -> shared.Amount
+	17,  // 44: task.OfficialBridgeTask.tx:type_name -> task.TaskTx
+	17,  // 45: task.OfficialBridgeTask.proveTx:type_name -> task.TaskTx
+	17,  // 46: task.OfficialBridgeTask.finalizeTx:type_name -> task.TaskTx
+	3,   // 47: task.OfficialBridgeTask.step:type_name -> task.OfficialWithdrawalStep
+	41,  // 48: task.OfficialBridgeTask.next_check:type_name -> google.protobuf.Timestamp
+	39,  // 49: task.RevokeApprovalsTask.network:type_name -> shared.Network
+	40,  // 50: task.RevokeApprovalsTask.tokens:type_name -> shared.Token
+	17,  // 51: task.RevokeApprovalsTask.txs:type_name -> task.TaskTx
+	39,  // 52: task.TaskTx.network:type_name -> shared.Network
+	42,  // 53: task.TaskTx.gas_estimated:type_name -> shared.AmUni
+	42,  // 54: task.TaskTx.gas_result:type_name -> shared.AmUni
+	42,  // 55: task.TaskTx.gas_limit:type_name -> shared.AmUni
+	6,   // 56: task.TaskTx.details:type_name -> task.TxDetail
+	18,  // 57: task.TaskTx.replacements:type_name -> task.TxReplacement
+	5,   // 58: task.TxReplacement.type:type_name -> task.TxReplacementType
+	39,  // 59: task.MerklyMintAndBridgeNFTTask.from_network:type_name -> shared.Network
+	39,  // 60: task.MerklyMintAndBridgeNFTTask.to_network:type_name -> shared.Network
+	17,  // 61: task.MerklyMintAndBridgeNFTTask.mint_tx:type_name -> task.TaskTx
+	17,  // 62: task.MerklyMintAndBridgeNFTTask.bridge_tx:type_name -> task.TaskTx
+	39,  // 63: task.DeployStarkNetAccountTask.network:type_name -> shared.Network
+	17,  // 64: task.DeployStarkNetAccountTask.tx:type_name -> task.TaskTx
+	38,  // 65: task.DefaultLP.amount:type_name -> shared.Amount
+	39,  // 66: task.DefaultLP.network:type_name -> shared.Network
+	40,  // 67: task.DefaultLP.a:type_name -> shared.Token
+	40,  // 68: task.DefaultLP.b:type_name -> shared.Token
+	17,  // 69: task.DefaultLP.tx:type_name -> task.TaskTx
+	22,  // 70: task.DefaultLP.position:type_name -> task.LPPosition
+	38,  // 71: task.WETHTask.amount:type_name -> shared.Amount
+	39,  // 72: task.WETHTask.network:type_name -> shared.Network
+	17,  // 73: task.WETHTask.tx:type_name -> task.TaskTx
+	38,  // 74: task.OrbiterBridgeTask.amount:type_name -> shared.Amount
+	39,  // 75: task.OrbiterBridgeTask.from_network:type_name -> shared.Network
+	39,  // 76: task.OrbiterBridgeTask.to_network:type_name -> shared.Network
+	40,  // 77: task.OrbiterBridgeTask.from_token:type_name -> shared.Token
+	40,  // 78: task.OrbiterBridgeTask.to_token:type_name -> shared.Token
+	17,  // 79: task.OrbiterBridgeTask.tx:type_name -> task.TaskTx
+	38,  // 80: task.ZkSyncOfficialBridgeFromEthereumTask.amount:type_name -> shared.Amount
+	17,  // 81: task.ZkSyncOfficialBridgeFromEthereumTask.tx:type_name -> task.TaskTx
+	38,  // 82: task.ZkSyncOfficialBridgeToEthereumTask.amount:type_name -> shared.Amount
+	39,  // 83: task.ZkSyncOfficialBridgeToEthereumTask.network:type_name -> shared.Network
+	17,  // 84: task.ZkSyncOfficialBridgeToEthereumTask.tx:type_name -> task.TaskTx
+	17,  // 85: task.ZkSyncOfficialBridgeToEthereumTask.finalizeTx:type_name -> task.TaskTx
+	41,  // 86: task.ZkSyncOfficialBridgeToEthereumTask.next_check:type_name -> google.protobuf.Timestamp
+	39,  // 87: task.Swap1inchTask.network:type_name -> shared.Network
+	37,  // 88: task.SnapshotVoteTask.proposal:type_name -> task.SnapshotVoteTask.ProposalEntry
+	43,  // 89: task.SnapshotVoteProposal.status:type_name -> shared.ProcessStatus
+	39,  // 90: task.TestNetBridgeSwapTask.network:type_name -> shared.Network
+	17,  // 91: task.TestNetBridgeSwapTask.tx:type_name -> task.TaskTx
+	39,  // 92: task.OkexDepositTask.network:type_name -> shared.Network
+	40,  // 93: task.OkexDepositTask.token:type_name -> shared.Token
+	38,  // 94: task.OkexDepositTask.amount:type_name -> shared.Amount
+	17,  // 95: task.OkexDepositTask.tx:type_name -> task.TaskTx
+	39,  // 96: task.StargateBridgeTask.fromNetwork:type_name -> shared.Network
+	39,  // 97: task.StargateBridgeTask.toNetwork:type_name -> shared.Network
+	40,  // 98: task.StargateBridgeTask.fromToken:type_name -> shared.Token
+	40,  // 99: task.StargateBridgeTask.toToken:type_name -> shared.Token
+	38,  // 100: task.StargateBridgeTask.amount:type_name -> shared.Amount
+	17,  // 101: task.StargateBridgeTask.tx:type_name -> task.TaskTx
+	41,  // 102: task.DelayTask.wait_for:type_name -> google.protobuf.Timestamp
+	29,  // 103: task.SnapshotVoteTask.ProposalEntry.value:type_name -> task.SnapshotVoteProposal
+	104, // [104:104] is the sub-list for method output_type
+	104, // [104:104] is the sub-list for method input_type
+	104, // [104:104] is the sub-list for extension type_name
+	104, // [104:104] is the sub-list for extension extendee
+	0,   // [0:104] is the sub-list for field type_name
 }
 
 func init() { file_v1_task_proto_init() }
@@ -2774,7 +4563,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultSwap); i {
+			switch v := v.(*LendTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2786,7 +4575,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskTx); i {
+			switch v := v.(*DomainMintTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2798,7 +4587,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerklyMintAndBridgeNFTTask); i {
+			switch v := v.(*LayerZeroBridgeTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2810,7 +4599,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployStarkNetAccountTask); i {
+			switch v := v.(*StgLockTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2822,7 +4611,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultLP); i {
+			switch v := v.(*StargateLiquidityTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2834,7 +4623,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WETHTask); i {
+			switch v := v.(*DefaultSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2846,7 +4635,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrbiterBridgeTask); i {
+			switch v := v.(*DefaultBridge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2858,7 +4647,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkSyncOfficialBridgeFromEthereumTask); i {
+			switch v := v.(*OfficialBridgeTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2870,7 +4659,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZkSyncOfficialBridgeToEthereumTask); i {
+			switch v := v.(*RevokeApprovalsTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2882,7 +4671,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Swap1InchTask); i {
+			switch v := v.(*TaskTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2894,7 +4683,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotVoteTask); i {
+			switch v := v.(*TxReplacement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2906,7 +4695,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotVoteProposal); i {
+			switch v := v.(*MerklyMintAndBridgeNFTTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2918,7 +4707,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestNetBridgeSwapTask); i {
+			switch v := v.(*DeployStarkNetAccountTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2930,7 +4719,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OkexDepositTask); i {
+			switch v := v.(*DefaultLP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2942,7 +4731,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawExchangeTask); i {
+			switch v := v.(*LPPosition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2954,7 +4743,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StargateBridgeTask); i {
+			switch v := v.(*WETHTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2966,7 +4755,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MockTask); i {
+			switch v := v.(*OrbiterBridgeTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2978,7 +4767,7 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelayTask); i {
+			switch v := v.(*ZkSyncOfficialBridgeFromEthereumTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2990,6 +4779,126 @@ func file_v1_task_proto_init() {
 			}
 		}
 		file_v1_task_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ZkSyncOfficialBridgeToEthereumTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Swap1InchTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotVoteTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotVoteProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestNetBridgeSwapTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OkexDepositTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawExchangeTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StargateBridgeTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelayTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_task_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OkexBinanaceTask); i {
 			case 0:
 				return &v.state
@@ -3012,24 +4921,34 @@ func file_v1_task_proto_init() {
 	file_v1_task_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	file_v1_task_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	file_v1_task_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_v1_task_proto_msgTypes[11].OneofWrappers = []interface{}{
-		(*Swap1InchTask_SendAll)(nil),
-		(*Swap1InchTask_SendPercent)(nil),
-		(*Swap1InchTask_SendAmount)(nil),
-	}
+	file_v1_task_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[13].OneofWrappers = []interface{}{}
 	file_v1_task_proto_msgTypes[14].OneofWrappers = []interface{}{}
 	file_v1_task_proto_msgTypes[15].OneofWrappers = []interface{}{}
 	file_v1_task_proto_msgTypes[16].OneofWrappers = []interface{}{}
 	file_v1_task_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[18].OneofWrappers = []interface{}{}
 	file_v1_task_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	file_v1_task_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*Swap1InchTask_SendAll)(nil),
+		(*Swap1InchTask_SendPercent)(nil),
+		(*Swap1InchTask_SendAmount)(nil),
+	}
+	file_v1_task_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_v1_task_proto_msgTypes[30].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_task_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      6,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -195,6 +195,66 @@ func (d *Dispatcher) EstimateTaskCost(ctx context.Context, profileId, taskId str
 			return nil, errr
 		}
 		e, err = (&task.DefaultLiquidityBridgeTaskHalper{v1.TaskType_StarkNetBridge}).EstimateCost(ctx, from, to, p, nil, nil)
+	case v1.TaskType_LendSupply:
+		p := t.Task.Task.(*v1.Task_LendSupplyTask).LendSupplyTask
+		e, err = (&task.LendTaskHalper{TaskType: v1.TaskType_LendSupply}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_LendWithdraw:
+		p := t.Task.Task.(*v1.Task_LendWithdrawTask).LendWithdrawTask
+		e, err = (&task.LendTaskHalper{TaskType: v1.TaskType_LendWithdraw}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_MuteioLP:
+		p := t.Task.Task.(*v1.Task_MuteioLPTask).MuteioLPTask
+		e, err = (&task.LPTaskHalper{TaskType: v1.TaskType_MuteioLP}).EstimateCost(ctx, profile, p, nil, d.runner.profileRepository)
+	case v1.TaskType_SpaceFiLP:
+		p := t.Task.Task.(*v1.Task_SpaceFiLPTask).SpaceFiLPTask
+		e, err = (&task.LPTaskHalper{TaskType: v1.TaskType_SpaceFiLP}).EstimateCost(ctx, profile, p, nil, d.runner.profileRepository)
+	case v1.TaskType_VelocoreLP:
+		p := t.Task.Task.(*v1.Task_VelocoreLPTask).VelocoreLPTask
+		e, err = (&task.LPTaskHalper{TaskType: v1.TaskType_VelocoreLP}).EstimateCost(ctx, profile, p, nil, d.runner.profileRepository)
+	case v1.TaskType_IzumiLP:
+		p := t.Task.Task.(*v1.Task_IzumiLPTask).IzumiLPTask
+		e, err = (&task.LPTaskHalper{TaskType: v1.TaskType_IzumiLP}).EstimateCost(ctx, profile, p, nil, d.runner.profileRepository)
+	case v1.TaskType_MaverickLP:
+		p := t.Task.Task.(*v1.Task_MaverickLPTask).MaverickLPTask
+		e, err = (&task.LPTaskHalper{TaskType: v1.TaskType_MaverickLP}).EstimateCost(ctx, profile, p, nil, d.runner.profileRepository)
+	case v1.TaskType_ZkSyncNameServiceMint:
+		p := t.Task.Task.(*v1.Task_ZkSyncNameServiceMintTask).ZkSyncNameServiceMintTask
+		e, err = (&task.DomainMintTaskHalper{TaskType: v1.TaskType_ZkSyncNameServiceMint}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_EraNameMint:
+		p := t.Task.Task.(*v1.Task_EraNameMintTask).EraNameMintTask
+		e, err = (&task.DomainMintTaskHalper{TaskType: v1.TaskType_EraNameMint}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_StarkIdMint:
+		p := t.Task.Task.(*v1.Task_StarkIdMintTask).StarkIdMintTask
+		e, err = (&task.DomainMintTaskHalper{TaskType: v1.TaskType_StarkIdMint}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_LayerZeroBridge:
+		p := t.Task.Task.(*v1.Task_LayerZeroBridgeTask).LayerZeroBridgeTask
+		e, err = (&task.LayerZeroBridgeTaskHalper{}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_StgLock:
+		p := t.Task.Task.(*v1.Task_StgLockTask).StgLockTask
+		e, err = (&task.StgLockTaskHalper{TaskType: v1.TaskType_StgLock}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_StgExtendLock:
+		p := t.Task.Task.(*v1.Task_StgExtendLockTask).StgExtendLockTask
+		e, err = (&task.StgLockTaskHalper{TaskType: v1.TaskType_StgExtendLock}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_StargateLiquidity:
+		p := t.Task.Task.(*v1.Task_StargateLiquidityTask).StargateLiquidityTask
+		e, err = (&task.StargateLiquidityTaskHalper{}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_AcrossBridge:
+		p := t.Task.Task.(*v1.Task_AcrossBridgeTask).AcrossBridgeTask
+		e, err = (&task.DefaultBridgeTaskHalper{TaskType: v1.TaskType_AcrossBridge}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_HopBridge:
+		p := t.Task.Task.(*v1.Task_HopBridgeTask).HopBridgeTask
+		e, err = (&task.DefaultBridgeTaskHalper{TaskType: v1.TaskType_HopBridge}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_SynapseBridge:
+		p := t.Task.Task.(*v1.Task_SynapseBridgeTask).SynapseBridgeTask
+		e, err = (&task.DefaultBridgeTaskHalper{TaskType: v1.TaskType_SynapseBridge}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_OfficialBridgeDeposit:
+		p := t.Task.Task.(*v1.Task_OfficialBridgeDepositTask).OfficialBridgeDepositTask
+		e, err = (&task.OfficialBridgeTaskHalper{TaskType: v1.TaskType_OfficialBridgeDeposit}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_OfficialBridgeWithdraw:
+		p := t.Task.Task.(*v1.Task_OfficialBridgeWithdrawTask).OfficialBridgeWithdrawTask
+		e, err = (&task.OfficialBridgeTaskHalper{TaskType: v1.TaskType_OfficialBridgeWithdraw}).EstimateCost(ctx, profile, p, nil)
+	case v1.TaskType_RevokeApprovals:
+		p := t.Task.Task.(*v1.Task_RevokeApprovalsTask).RevokeApprovalsTask
+		e, err = task.EstimateRevokeApprovalsCost(ctx, profile, p, nil)
 	default:
 		return nil, errors.New("task: " + t.Task.TaskType.String() + " can not be estimated")
 	}
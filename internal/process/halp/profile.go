@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	"github.com/hardstylez72/cry/internal/defi/starknet"
 	"github.com/hardstylez72/cry/internal/defi/zksyncera"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
@@ -61,6 +62,19 @@ func (h *Halp) Profile(ctx context.Context, profileId string) (*Profile, error)
 	if err != nil {
 		return nil, err
 	}
+
+	if p.Type == v1.ProfileType_StarkNet {
+		addr, err := h.starknetClient.VerifyAddress(ctx, string(profile.MmskPk), p.SubType, profile.WalletAddr.String)
+		if err != nil {
+			return nil, err
+		}
+		if !profile.WalletAddr.Valid {
+			if err := h.profileRepository.SetProfileWalletAddr(ctx, profileId, addr); err != nil {
+				return nil, err
+			}
+		}
+		p.MmskId = addr
+	}
 	return &Profile{
 		UserAgent:   profile.UserAgent,
 		DB:          profile,
@@ -94,7 +108,7 @@ func (p *Settings) BaseConfig() *uniclient.BaseClientConfig {
 
 	return &uniclient.BaseClientConfig{
 		ProxyString:     p.p.ProxyString,
-		RPCEndpoint:     p.Source.RpcEndpoint,
+		RPCEndpoint:     rpcpool.FromSettings(p.Source),
 		UserAgentHeader: p.p.DB.UserAgent,
 	}
 }
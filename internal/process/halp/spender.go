@@ -0,0 +1,41 @@
+package halp
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/server/repository"
+	"github.com/pkg/errors"
+)
+
+// SpenderRecorder keeps the spenders the tasks of the profile registered
+func SpenderRecorder(rep repository.SpenderRepository, profileId string) defi.SpenderRecorder {
+	return func(ctx context.Context, network v1.Network, s defi.Spender) error {
+		return rep.SpenderAdd(ctx, &repository.Spender{
+			ProfileId: profileId,
+			Network:   network.String(),
+			Address:   s.Address.String(),
+			Name:      s.Name,
+		})
+	}
+}
+
+// Spenders spenders the tasks of the profile registered in the network
+func Spenders(ctx context.Context, rep repository.SpenderRepository, profileId string, network v1.Network) ([]defi.Spender, error) {
+	list, err := rep.SpenderList(ctx, profileId, network.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "SpenderList")
+	}
+	res := make([]defi.Spender, 0, len(list))
+	for _, s := range list {
+		res = append(res, defi.Spender{Name: s.Name, Address: common.HexToAddress(s.Address)})
+	}
+	return res, nil
+}
+
+// Spenders spenders the tasks of the profile registered in the network
+func (p *Profile) Spenders(ctx context.Context, network v1.Network) ([]defi.Spender, error) {
+	return Spenders(ctx, p.h.profileRepository, p.Id, network)
+}
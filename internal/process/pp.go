@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/log"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/hardstylez72/cry/internal/process/halp"
@@ -106,6 +107,14 @@ func (d *Dispatcher) runPP(ctx context.Context, pId processId, ppId string, user
 		return err
 	}
 
+	flow, err := d.r.GetProcessFlow(ctx, pId)
+	if err != nil {
+		return errors.Wrap(err, "GetProcessFlow")
+	}
+	if flow.GetExactApprove() {
+		ctx = defi.WithExactApprove(ctx)
+	}
+
 	result := make(chan ExecutorResult)
 	defer close(result)
 
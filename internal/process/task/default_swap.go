@@ -165,6 +165,8 @@ func (h *DefaultSwapTaskHalper) Execute(ctx context.Context, profile *halp.Profi
 		return nil, nil, errors.New("not enough balance of " + p.FromToken.String())
 	}
 
+	feeToken := getFeeToken(s.Source, h.TaskType, p.Network)
+
 	estimateOnly := estimation == nil
 	var Gas *bozdo.Gas
 	if estimateOnly {
@@ -177,15 +179,18 @@ func (h *DefaultSwapTaskHalper) Execute(ctx context.Context, profile *halp.Profi
 		}
 		Gas = gas
 
-		balanceNative, err := client.GetBalance(ctx, &defi.GetBalanceReq{
-			WalletAddress: profile.Addr,
-			Token:         client.GetNetworkToken(),
-		})
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "client.GetBalance")
-		}
-		if balanceNative.WEI.Cmp(&Gas.TotalGas) <= 0 {
-			return nil, nil, ErrProfileHasInsufficientBalance(v1.Token_ETH, &Gas.TotalGas, balance.WEI)
+		// the paymaster takes the fee token, its balance is checked by the client
+		if feeToken == nil {
+			balanceNative, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+				WalletAddress: profile.Addr,
+				Token:         client.GetNetworkToken(),
+			})
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "client.GetBalance")
+			}
+			if balanceNative.WEI.Cmp(&Gas.TotalGas) <= 0 {
+				return nil, nil, ErrProfileHasInsufficientBalance(v1.Token_ETH, &Gas.TotalGas, balance.WEI)
+			}
 		}
 	}
 
@@ -200,6 +205,7 @@ func (h *DefaultSwapTaskHalper) Execute(ctx context.Context, profile *halp.Profi
 		Debug:        false,
 		Slippage:     getSlippage(s.Source, h.TaskType),
 		SubType:      profile.SubType,
+		FeeToken:     feeToken,
 	}, h.TaskType)
 	if err != nil {
 		return nil, nil, err
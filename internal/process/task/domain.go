@@ -0,0 +1,308 @@
+package task
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/lib"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/process/halp"
+	"github.com/hardstylez72/cry/internal/uniclient"
+	"github.com/pkg/errors"
+)
+
+// domainNameAttempts random names to check for availability before giving up
+const domainNameAttempts = 10
+
+var domainTLD = map[v1.TaskType]string{
+	v1.TaskType_ZkSyncNameServiceMint: ".zks",
+	v1.TaskType_EraNameMint:           ".era",
+	v1.TaskType_StarkIdMint:           ".stark",
+}
+
+func NewZkSyncNameServiceMintTask() *DomainMintTask {
+	return NewDomainMintTask(v1.TaskType_ZkSyncNameServiceMint, func(a *Input) (*v1.DomainMintTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_ZkSyncNameServiceMintTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_ZkSyncNameServiceMintTask) call an ambulance!")
+		}
+		return l.ZkSyncNameServiceMintTask, nil
+	})
+}
+
+func NewEraNameMintTask() *DomainMintTask {
+	return NewDomainMintTask(v1.TaskType_EraNameMint, func(a *Input) (*v1.DomainMintTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_EraNameMintTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_EraNameMintTask) call an ambulance!")
+		}
+		return l.EraNameMintTask, nil
+	})
+}
+
+func NewStarkIdMintTask() *DomainMintTask {
+	return NewDomainMintTask(v1.TaskType_StarkIdMint, func(a *Input) (*v1.DomainMintTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_StarkIdMintTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_StarkIdMintTask) call an ambulance!")
+		}
+		return l.StarkIdMintTask, nil
+	})
+}
+
+type DomainMintTask struct {
+	taskType  v1.TaskType
+	extractor func(a *Input) (*v1.DomainMintTask, error)
+	cancel    func()
+	*DomainMintTaskHalper
+}
+
+func NewDomainMintTask(taskType v1.TaskType, extractor func(a *Input) (*v1.DomainMintTask, error)) *DomainMintTask {
+	return &DomainMintTask{
+		taskType:  taskType,
+		extractor: extractor,
+		cancel:    nil,
+		DomainMintTaskHalper: &DomainMintTaskHalper{
+			TaskType: taskType,
+		},
+	}
+}
+
+type DomainMintTaskHalper struct {
+	TaskType v1.TaskType
+}
+
+func (t *DomainMintTask) Stop() error {
+	t.cancel()
+	return nil
+}
+
+func (t *DomainMintTask) Type() v1.TaskType {
+	return t.taskType
+}
+
+func (t *DomainMintTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask, error) {
+
+	timeout := taskTimeout
+	if t.taskType == v1.TaskType_StarkIdMint {
+		timeout = taskStarkNetTimeout
+	}
+	taskContext, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	t.cancel = cancel
+
+	task := a.Task
+
+	p, err := t.extractor(a)
+	if err != nil {
+		return nil, err
+	}
+
+	switch a.Task.Status {
+	case v1.ProcessStatus_StatusDone, v1.ProcessStatus_StatusError:
+		return a.Task, nil
+	case v1.ProcessStatus_StatusRetry, v1.ProcessStatus_StatusReady, v1.ProcessStatus_StatusRunning:
+
+		task.Status = v1.ProcessStatus_StatusRunning
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.checkProfile(profile); err != nil {
+		return nil, err
+	}
+
+	// profile already owns a domain of the service, it is not minted twice
+	if minted := t.minted(profile); minted != "" {
+		p.Domain = &minted
+		task.Status = v1.ProcessStatus_StatusDone
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+		return task, nil
+	}
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uniclient.NewDomainMinter(p.Network, s.BaseConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxId() == "" {
+
+		if p.GetDomain() == "" {
+			name, err := t.freeName(taskContext, client)
+			if err != nil {
+				return nil, err
+			}
+			p.Domain = &name
+			if err := a.UpdateTask(ctx, task); err != nil {
+				return nil, err
+			}
+		}
+
+		estimation, err := t.EstimateCost(taskContext, profile, p, client)
+		if err != nil {
+			return nil, errors.Wrap(err, "EstimateCost of "+t.taskType.String())
+		}
+		res, gas, err := t.Execute(taskContext, profile, p, client, estimation)
+		if err != nil {
+			return nil, errors.Wrap(err, t.taskType.String())
+		}
+
+		p.Tx = NewTx(res.Tx, gas)
+		if err := a.AddTx2(ctx, p.Tx); err != nil {
+			return nil, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := WaitTxComplete(taskContext, p.Tx, task, client, a); err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxCompleted() {
+		if err := a.ProfileRepository.AppendProfileMeta(ctx, profile.Id, t.metaLine(p.GetDomain())); err != nil {
+			return nil, errors.Wrap(err, "ProfileRepository.AppendProfileMeta")
+		}
+
+		task.Status = v1.ProcessStatus_StatusDone
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	return task, nil
+}
+
+func (h *DomainMintTaskHalper) checkProfile(profile *halp.Profile) error {
+	if h.TaskType == v1.TaskType_StarkIdMint {
+		if profile.Type != v1.ProfileType_StarkNet {
+			return errors.New("task is available only for starknet account")
+		}
+		return nil
+	}
+	if profile.Type != v1.ProfileType_EVM {
+		return errors.New("task is available only for emv account")
+	}
+	return nil
+}
+
+// metaLine profile meta record of the minted domain, e.g. "domain EraNameMint: bokitan.era"
+func (h *DomainMintTaskHalper) metaLine(name string) string {
+	return "domain " + h.TaskType.String() + ": " + name + domainTLD[h.TaskType]
+}
+
+// minted domain of the service found in the profile meta
+func (h *DomainMintTaskHalper) minted(profile *halp.Profile) string {
+	if profile.DB == nil || !profile.DB.Meta.Valid {
+		return ""
+	}
+	prefix := "domain " + h.TaskType.String() + ": "
+	for _, line := range strings.Split(profile.DB.Meta.String, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, prefix) {
+			return strings.TrimSuffix(strings.TrimPrefix(line, prefix), domainTLD[h.TaskType])
+		}
+	}
+	return ""
+}
+
+// freeName generates random names until one of them is not registered yet
+func (h *DomainMintTaskHalper) freeName(ctx context.Context, client defi.DomainMinter) (string, error) {
+	for i := 0; i < domainNameAttempts; i++ {
+		name := lib.RandName()
+		available, err := client.DomainAvailable(ctx, name, h.TaskType)
+		if err != nil {
+			return "", errors.Wrap(err, "client.DomainAvailable")
+		}
+		if available {
+			return name, nil
+		}
+		time.Sleep(time.Second)
+	}
+	return "", errors.New("failed to find an available domain name")
+}
+
+func (h *DomainMintTaskHalper) Execute(ctx context.Context, profile *halp.Profile, p *v1.DomainMintTask, client defi.DomainMinter, estimation *v1.EstimationTx) (*bozdo.DefaultRes, *bozdo.Gas, error) {
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client == nil {
+		client, err = uniclient.NewDomainMinter(p.Network, s.BaseConfig())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// estimation before the name is chosen
+	name := p.GetDomain()
+	if name == "" {
+		name = lib.RandName()
+	}
+
+	estimateOnly := estimation == nil
+	var Gas *bozdo.Gas
+	if !estimateOnly {
+		gas, err := GasManager(estimation, s.Source, p.Network)
+		if err != nil {
+			return nil, nil, err
+		}
+		Gas = gas
+
+		balanceNative, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+			WalletAddress: profile.Addr,
+			Token:         client.GetNetworkToken(),
+		})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "client.GetBalance")
+		}
+		price, err := client.DomainPrice(ctx, h.TaskType)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "client.DomainPrice")
+		}
+		need := new(big.Int).Add(&Gas.TotalGas, price)
+		if balanceNative.WEI.Cmp(need) <= 0 {
+			return nil, nil, ErrProfileHasInsufficientBalance(client.GetNetworkToken(), need, balanceNative.WEI)
+		}
+	}
+
+	res, err := client.MintDomain(ctx, &defi.MintDomainReq{
+		Name:         name,
+		WalletPK:     profile.WalletPK,
+		SubType:      profile.SubType,
+		EstimateOnly: estimateOnly,
+		Gas:          Gas,
+	}, h.TaskType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, Gas, nil
+}
+
+func (h *DomainMintTaskHalper) EstimateCost(ctx context.Context, profile *halp.Profile, p *v1.DomainMintTask, client defi.DomainMinter) (*v1.EstimationTx, error) {
+	res, _, err := h.Execute(ctx, profile, p, client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return GasStation(res.ECost, p.Network), nil
+}
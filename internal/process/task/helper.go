@@ -5,12 +5,16 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/zksyncera"
+	"github.com/hardstylez72/cry/internal/lzscan"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/hardstylez72/cry/internal/process/halp"
 	"github.com/hardstylez72/cry/internal/server/repository"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func GasStation(ecost *bozdo.EstimatedGasCost, network v1.Network) *v1.EstimationTx {
@@ -34,6 +38,13 @@ func GasStation(ecost *bozdo.EstimatedGasCost, network v1.Network) *v1.Estimatio
 		}
 		result.Gas = defi.AmountUni(totalFee, network)
 
+		if ecost.BaseFee != nil {
+			result.BaseFee = defi.AmountUni(ecost.BaseFee, network)
+		}
+		if ecost.PriorityFee != nil {
+			result.PriorityFee = defi.AmountUni(ecost.PriorityFee, network)
+		}
+
 		result.GasValuePercent = ""
 		result.Name = ecost.Name
 		result.Details = CastDetails(ecost.Details)
@@ -54,8 +65,43 @@ func ResolveNetworkTokenAmount(balance, gas, value *big.Int) *big.Int {
 func WaitTxComplete(ctx context.Context, ptx *v1.TaskTx, task *v1.ProcessTask, networker defi.Networker, updater TaskUpdater) error {
 	if ptx != nil && ptx.TxId != "" {
 		tx := ptx
-		if err := networker.WaitTxComplete(ctx, tx.TxId); err != nil {
-			if errors.Is(err, defi.ErrTxStatusFailed) {
+		replacer := newTxReplacer(networker, updater)
+		if replaced, err := replacer.requested(ctx, tx, task); replaced || err != nil {
+			if err != nil {
+				return err
+			}
+			return ErrTransactionIsNotReady
+		}
+
+		waitCtx, cancel := replacer.waitContext(ctx, tx)
+		err := waitTx(waitCtx, ctx, networker, tx, task, updater)
+		cancel()
+		if err != nil {
+			// the replacement requested during the wait is made right away
+			if replaced, err := replacer.requested(ctx, tx, task); replaced || err != nil {
+				if err != nil {
+					return err
+				}
+				return ErrTransactionIsNotReady
+			}
+			if errors.Is(err, defi.ErrTxPending) {
+				if err := replacer.speedUpPending(ctx, tx, task); err != nil {
+					return err
+				}
+				return ErrTransactionIsNotReady
+			}
+			if errors.Is(err, defi.ErrTxNotConfirmed) {
+				return ErrTransactionIsNotReady
+			}
+			if errors.Is(err, defi.ErrTxNotFound) || errors.Is(err, defi.ErrTxReplaced) {
+				if found, err := replacer.mined(ctx, tx, task); found || err != nil {
+					if err != nil {
+						return err
+					}
+					return ErrTransactionIsNotReady
+				}
+			}
+			if errors.Is(err, defi.ErrTxStatusFailed) || errors.Is(err, defi.ErrTxReplaced) {
 				tx.TxCompleted = false
 				tx.RetryCount = 0
 				tx.TxId = ""
@@ -82,6 +128,17 @@ func WaitTxComplete(ctx context.Context, ptx *v1.TaskTx, task *v1.ProcessTask, n
 			}
 			return err
 		}
+		txReplaceRequests.Delete(tx.TxId)
+
+		if cancelled(tx) {
+			tx.TxCompleted = false
+			tx.RetryCount = 0
+			tx.TxId = ""
+			if err := updater.UpdateTask(ctx, task); err != nil {
+				return err
+			}
+			return ErrTxCancelled
+		}
 
 		if tx.CompleteTs == 0 {
 			tx.CompleteTs = time.Now().Unix()
@@ -203,61 +260,72 @@ func NewStarkNetApproveTx(id string) *v1.TaskTx {
 }
 
 func BalanceSnapshotBefore(ctx context.Context, client defi.Networker, token v1.Token, profile *halp.Profile) ([]bozdo.TxDetail, error) {
+	b, err := snapshotBalances(ctx, client, token, profile)
+	if err != nil {
+		return nil, err
+	}
 
 	result := make([]bozdo.TxDetail, 0)
-
-	if client.GetNetworkToken().String() != token.String() {
-		bToken, err := client.GetBalance(ctx, &defi.GetBalanceReq{
-			WalletAddress: profile.Addr,
-			Token:         token,
-		})
-		if err != nil {
-			return nil, err
-		}
-
-		result = append(result, bozdo.NewTokenBalanceBefore(bToken.WEI, client.Network(), token))
+	if client.GetNetworkToken() != token {
+		result = append(result, bozdo.NewTokenBalanceBefore(b[token], client.Network(), token))
 	}
+	result = append(result, bozdo.NewNativeBalanceBefore(b[client.GetNetworkToken()], client.Network(), client.GetNetworkToken()))
 
-	bToken, err := client.GetBalance(ctx, &defi.GetBalanceReq{
-		WalletAddress: profile.Addr,
-		Token:         client.GetNetworkToken(),
-	})
+	return result, nil
+}
+
+func BalanceSnapshotAfter(ctx context.Context, client defi.Networker, token v1.Token, profile *halp.Profile) ([]bozdo.TxDetail, error) {
+	b, err := snapshotBalances(ctx, client, token, profile)
 	if err != nil {
 		return nil, err
 	}
 
-	result = append(result, bozdo.NewNativeBalanceBefore(bToken.WEI, client.Network(), client.GetNetworkToken()))
+	result := make([]bozdo.TxDetail, 0)
+	if client.GetNetworkToken() != token {
+		result = append(result, bozdo.NewTokenBalanceAfter(b[token], client.Network(), token))
+	}
+	result = append(result, bozdo.NewNativeBalanceAfter(b[client.GetNetworkToken()], client.Network(), client.GetNetworkToken()))
 
 	return result, nil
 }
 
-func BalanceSnapshotAfter(ctx context.Context, client defi.Networker, token v1.Token, profile *halp.Profile) ([]bozdo.TxDetail, error) {
+// snapshotBalances balances of the token and the network token, in one request along with the other profiles of the
+// user going through the same proxy if the network batches them
+func snapshotBalances(ctx context.Context, client defi.Networker, token v1.Token, profile *halp.Profile) (map[v1.Token]*big.Int, error) {
+	tokens := []v1.Token{client.GetNetworkToken()}
+	if client.GetNetworkToken() != token {
+		tokens = append(tokens, token)
+	}
 
-	result := make([]bozdo.TxDetail, 0)
+	res := make(map[v1.Token]*big.Int)
+	if batcher, ok := client.(defi.BatchBalancer); ok {
+		wallet := common.HexToAddress(profile.Addr)
+		key := client.Network().String() + "/" + profile.UserId + "/" + profile.ProxyString
+		balances, err := defi.BatchBalances(ctx, key, batcher, wallet, tokens)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tokens {
+			b, err := balances.Get(wallet, t)
+			if err != nil {
+				return nil, err
+			}
+			res[t] = b.Balance.WEI
+		}
+		return res, nil
+	}
 
-	if client.GetNetworkToken().String() != token.String() {
-		bToken, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+	for _, t := range tokens {
+		b, err := client.GetBalance(ctx, &defi.GetBalanceReq{
 			WalletAddress: profile.Addr,
-			Token:         token,
+			Token:         t,
 		})
 		if err != nil {
 			return nil, err
 		}
-
-		result = append(result, bozdo.NewTokenBalanceAfter(bToken.WEI, client.Network(), token))
-	}
-
-	bToken, err := client.GetBalance(ctx, &defi.GetBalanceReq{
-		WalletAddress: profile.Addr,
-		Token:         client.GetNetworkToken(),
-	})
-	if err != nil {
-		return nil, err
+		res[t] = b.WEI
 	}
-
-	result = append(result, bozdo.NewNativeBalanceAfter(bToken.WEI, client.Network(), client.GetNetworkToken()))
-
-	return result, nil
+	return res, nil
 }
 
 func NewTx(tx *bozdo.Transaction, gas *bozdo.Gas) *v1.TaskTx {
@@ -314,6 +382,19 @@ func getSlippage(s *v1.NetworkSettings, taskType v1.TaskType) defi.SlippagePerce
 	return v.GetSlippage()
 }
 
+// getFeeToken token the fee of the task is paid in instead of eth, only the zkSync Era swaps have the paymaster
+func getFeeToken(s *v1.NetworkSettings, taskType v1.TaskType, network v1.Network) *v1.Token {
+	if network != v1.Network_ZKSYNCERA || !zksyncera.PaymasterTasks[taskType] || s == nil || s.TaskSettings == nil {
+		return nil
+	}
+
+	v, ok := s.TaskSettings[taskType.String()]
+	if !ok || v.FeeToken == nil || v.GetFeeToken() == v1.Token_ETH {
+		return nil
+	}
+	return v.FeeToken
+}
+
 func getSlippageFromConst(taskType v1.TaskType) defi.SlippagePercent {
 	v, ok := defi.SlippageMap[taskType]
 	if !ok {
@@ -321,3 +402,70 @@ func getSlippageFromConst(taskType v1.TaskType) defi.SlippagePercent {
 	}
 	return v
 }
+
+// LayerZeroDelivery task fields the layerzero message status is kept in
+type LayerZeroDelivery struct {
+	Status  **string
+	Url     **string
+	DstTxId **string
+}
+
+// waitDelivery the layerzero tasks wait for the delivery unless wait_delivery is set to false
+func waitDelivery(flag *bool) bool {
+	return flag == nil || *flag
+}
+
+// WaitLayerZeroDelivery waits for the message of the completed source tx, the task stays running while the message is not indexed yet
+// and is done only when the message is delivered
+func WaitLayerZeroDelivery(ctx, taskContext context.Context, ptx *v1.TaskTx, d *LayerZeroDelivery, task *v1.ProcessTask, updater TaskUpdater) error {
+	if !ptx.GetTxCompleted() {
+		return nil
+	}
+
+	if *d.Status == nil || **d.Status != lzscan.StatusDELIVERED {
+		s := lzscan.NewService()
+		if *d.Url == nil {
+			lzUrl, err := s.GetTxUrl(taskContext, ptx.GetTxId())
+			if err != nil {
+				if err == lzscan.ErrNotFound {
+					return nil
+				}
+				return err
+			}
+			*d.Url = &lzUrl
+			if err := updater.UpdateTask(ctx, task); err != nil {
+				return err
+			}
+		}
+
+		delivery, err := s.WaitConfirm(taskContext, ptx.GetTxId())
+		if err != nil {
+			return err
+		}
+
+		*d.Status = &delivery.Status
+		if delivery.DstTxHash != "" {
+			*d.DstTxId = &delivery.DstTxHash
+		}
+		if delivery.Status == lzscan.StatusFAILED {
+			msg := "layerzero message failed, destination tx: " + delivery.DstTxHash + " " + delivery.Error
+			task.Error = &msg
+		}
+		if err := updater.UpdateTask(ctx, task); err != nil {
+			return err
+		}
+	}
+
+	switch **d.Status {
+	case lzscan.StatusDELIVERED:
+		task.Status = v1.ProcessStatus_StatusDone
+		task.FinishedAt = timestamppb.Now()
+	case lzscan.StatusFAILED:
+		task.Status = v1.ProcessStatus_StatusError
+	case lzscan.StatusINFLIGHT:
+		// stuck message is retried and reported as the task error
+		return errors.New("layerzero message is not delivered yet: " + **d.Url)
+	}
+
+	return updater.UpdateTask(ctx, task)
+}
@@ -0,0 +1,208 @@
+package task
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/process/halp"
+	"github.com/hardstylez72/cry/internal/uniclient"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type LayerZeroBridgeTask struct {
+	cancel func()
+	*LayerZeroBridgeTaskHalper
+}
+
+func NewLayerZeroBridgeTask() *LayerZeroBridgeTask {
+	return &LayerZeroBridgeTask{
+		cancel:                    nil,
+		LayerZeroBridgeTaskHalper: &LayerZeroBridgeTaskHalper{},
+	}
+}
+
+type LayerZeroBridgeTaskHalper struct {
+}
+
+func (t *LayerZeroBridgeTask) Stop() error {
+	t.cancel()
+	return nil
+}
+
+func (t *LayerZeroBridgeTask) Type() v1.TaskType {
+	return v1.TaskType_LayerZeroBridge
+}
+
+func (t *LayerZeroBridgeTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask, error) {
+
+	taskContext, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	t.cancel = cancel
+
+	task := a.Task
+	l, ok := a.Task.Task.Task.(*v1.Task_LayerZeroBridgeTask)
+	if !ok {
+		return nil, errors.New("Task.(*v1.Task_LayerZeroBridgeTask) call an ambulance!")
+	}
+	p := l.LayerZeroBridgeTask
+
+	switch a.Task.Status {
+	case v1.ProcessStatus_StatusDone, v1.ProcessStatus_StatusError:
+		return a.Task, nil
+	case v1.ProcessStatus_StatusRetry, v1.ProcessStatus_StatusReady, v1.ProcessStatus_StatusRunning:
+
+		task.Status = v1.ProcessStatus_StatusRunning
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if profile.Type != v1.ProfileType_EVM {
+		return nil, errors.New("task is available only for emv account")
+	}
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uniclient.NewLayerZeroBridger(p.Network, s.BaseConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxId() == "" {
+
+		estimation, err := t.EstimateCost(taskContext, profile, p, client)
+		if err != nil {
+			return nil, errors.Wrap(err, "EstimateCost")
+		}
+		res, gas, err := t.Execute(taskContext, profile, p, client, estimation)
+		if err != nil {
+			return nil, errors.Wrap(err, "LayerZeroBridge")
+		}
+
+		p.ApproveTx = NewTx(res.ApproveTx, nil)
+		if err := a.AddTx2(ctx, p.ApproveTx); err != nil {
+			return nil, err
+		}
+
+		p.Tx = NewTx(res.Tx, gas)
+		if err := a.AddTx2(ctx, p.Tx); err != nil {
+			return nil, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := WaitTxComplete(taskContext, p.Tx, task, client, a); err != nil {
+		return nil, err
+	}
+
+	if !waitDelivery(p.WaitDelivery) {
+		if p.GetTx().GetTxCompleted() {
+			task.Status = v1.ProcessStatus_StatusDone
+			task.FinishedAt = timestamppb.Now()
+			if err := a.UpdateTask(ctx, task); err != nil {
+				return nil, err
+			}
+		}
+		return task, nil
+	}
+
+	if err := WaitLayerZeroDelivery(ctx, taskContext, p.GetTx(), &LayerZeroDelivery{
+		Status:  &p.LayerZeroStatus,
+		Url:     &p.LzscanUrl,
+		DstTxId: &p.DstTxId,
+	}, task, a); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (h *LayerZeroBridgeTaskHalper) Execute(ctx context.Context, profile *halp.Profile, p *v1.LayerZeroBridgeTask, client defi.LayerZeroBridger, estimation *v1.EstimationTx) (*bozdo.DefaultRes, *bozdo.Gas, error) {
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client == nil {
+		client, err = uniclient.NewLayerZeroBridger(p.Network, s.BaseConfig())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !common.IsHexAddress(p.Contract) {
+		return nil, nil, errors.New("invalid contract address: " + p.Contract)
+	}
+	if p.DstChainId == 0 || p.DstChainId > 65535 {
+		return nil, nil, errors.New("invalid layerzero destination chain id")
+	}
+
+	var tokenId *big.Int
+	if p.GetTokenId() != "" {
+		id, ok := new(big.Int).SetString(p.GetTokenId(), 10)
+		if !ok {
+			return nil, nil, errors.New("invalid token id: " + p.GetTokenId())
+		}
+		tokenId = id
+	}
+
+	estimateOnly := estimation == nil
+	var Gas *bozdo.Gas
+	if !estimateOnly {
+		gas, err := GasManager(estimation, s.Source, p.Network)
+		if err != nil {
+			return nil, nil, err
+		}
+		Gas = gas
+
+		balanceNative, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+			WalletAddress: profile.Addr,
+			Token:         client.GetNetworkToken(),
+		})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "client.GetBalance")
+		}
+		if balanceNative.WEI.Cmp(&Gas.TotalGas) <= 0 {
+			return nil, nil, ErrProfileHasInsufficientBalance(client.GetNetworkToken(), &Gas.TotalGas, balanceNative.WEI)
+		}
+	}
+
+	res, err := client.LayerZeroBridge(ctx, &defi.LayerZeroBridgeReq{
+		Kind:         p.Kind,
+		Contract:     common.HexToAddress(p.Contract),
+		DstChainId:   uint16(p.DstChainId),
+		Amount:       p.Amount,
+		TokenId:      tokenId,
+		WalletPK:     profile.WalletPK,
+		EstimateOnly: estimateOnly,
+		Gas:          Gas,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, Gas, nil
+}
+
+func (h *LayerZeroBridgeTaskHalper) EstimateCost(ctx context.Context, profile *halp.Profile, p *v1.LayerZeroBridgeTask, client defi.LayerZeroBridger) (*v1.EstimationTx, error) {
+	res, _, err := h.Execute(ctx, profile, p, client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return GasStation(res.ECost, p.Network), nil
+}
@@ -0,0 +1,264 @@
+package task
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/process/halp"
+	"github.com/hardstylez72/cry/internal/uniclient"
+	"github.com/pkg/errors"
+)
+
+func NewLendSupplyTask() *LendTask {
+	return NewLendTask(v1.TaskType_LendSupply, func(a *Input) (*v1.LendTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_LendSupplyTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_LendSupplyTask) call an ambulance!")
+		}
+		return l.LendSupplyTask, nil
+	})
+}
+
+func NewLendWithdrawTask() *LendTask {
+	return NewLendTask(v1.TaskType_LendWithdraw, func(a *Input) (*v1.LendTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_LendWithdrawTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_LendWithdrawTask) call an ambulance!")
+		}
+		return l.LendWithdrawTask, nil
+	})
+}
+
+type LendTask struct {
+	taskType  v1.TaskType
+	extractor func(a *Input) (*v1.LendTask, error)
+	cancel    func()
+	*LendTaskHalper
+}
+
+func NewLendTask(taskType v1.TaskType, extractor func(a *Input) (*v1.LendTask, error)) *LendTask {
+	return &LendTask{
+		taskType:  taskType,
+		extractor: extractor,
+		cancel:    nil,
+		LendTaskHalper: &LendTaskHalper{
+			TaskType: taskType,
+		},
+	}
+}
+
+type LendTaskHalper struct {
+	TaskType v1.TaskType
+}
+
+func (t *LendTask) Stop() error {
+	t.cancel()
+	return nil
+}
+
+func (t *LendTask) Type() v1.TaskType {
+	return t.taskType
+}
+
+func (t *LendTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask, error) {
+
+	taskContext, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	t.cancel = cancel
+
+	task := a.Task
+
+	p, err := t.extractor(a)
+	if err != nil {
+		return nil, err
+	}
+
+	switch a.Task.Status {
+	case v1.ProcessStatus_StatusDone, v1.ProcessStatus_StatusError:
+		return a.Task, nil
+	case v1.ProcessStatus_StatusRetry, v1.ProcessStatus_StatusReady, v1.ProcessStatus_StatusRunning:
+
+		task.Status = v1.ProcessStatus_StatusRunning
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if profile.Type != v1.ProfileType_EVM {
+		return nil, errors.New("task is available only for emv account")
+	}
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uniclient.NewLender(p.Network, s.BaseConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxId() == "" {
+
+		before, err := BalanceSnapshotBefore(taskContext, client, p.Token, profile)
+		if err != nil {
+			return nil, errors.Wrap(err, "BalanceSnapshotBefore")
+		}
+
+		estimation, err := t.EstimateCost(taskContext, profile, p, client)
+		if err != nil {
+			return nil, errors.Wrap(err, "EstimateCost of "+t.taskType.String())
+		}
+		res, gas, err := t.Execute(taskContext, profile, p, client, estimation)
+		if err != nil {
+			return nil, errors.Wrap(err, t.taskType.String())
+		}
+
+		p.ApproveTx = NewTx(res.ApproveTx, gas)
+		if err := a.AddTx2(ctx, p.ApproveTx); err != nil {
+			return nil, err
+		}
+
+		p.Tx = NewTx(res.Tx, gas)
+		if p.Tx != nil {
+			p.Tx.Details = append(p.Tx.Details, CastDetails(before)...)
+		}
+		if err := a.AddTx2(ctx, p.Tx); err != nil {
+			return nil, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := WaitTxComplete(taskContext, p.Tx, task, client, a); err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxCompleted() {
+		after, err := BalanceSnapshotAfter(taskContext, client, p.Token, profile)
+		if err != nil {
+			return nil, errors.Wrap(err, "BalanceSnapshotAfter")
+		}
+		p.Tx.Details = append(p.Tx.Details, CastDetails(after)...)
+
+		task.Status = v1.ProcessStatus_StatusDone
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	return task, nil
+}
+
+func (h *LendTaskHalper) Execute(ctx context.Context, profile *halp.Profile, p *v1.LendTask, client defi.Lender, estimation *v1.EstimationTx) (*bozdo.DefaultRes, *bozdo.Gas, error) {
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client == nil {
+		client, err = uniclient.NewLender(p.Network, s.BaseConfig())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	available, err := h.available(ctx, profile, p, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	am, err := defi.ResolveAmount(p.Amount, available)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if am == nil || am.Cmp(big.NewInt(0)) == 0 {
+		return nil, nil, errors.New("not enough balance of " + p.Token.String())
+	}
+
+	estimateOnly := estimation == nil
+	var Gas *bozdo.Gas
+	if estimateOnly {
+		am = bozdo.Percent(am, 90)
+		Gas = nil
+	} else {
+		gas, err := GasManager(estimation, s.Source, p.Network)
+		if err != nil {
+			return nil, nil, err
+		}
+		Gas = gas
+
+		balanceNative, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+			WalletAddress: profile.Addr,
+			Token:         client.GetNetworkToken(),
+		})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "client.GetBalance")
+		}
+		if balanceNative.WEI.Cmp(&Gas.TotalGas) <= 0 {
+			return nil, nil, ErrProfileHasInsufficientBalance(client.GetNetworkToken(), &Gas.TotalGas, balanceNative.WEI)
+		}
+		if h.TaskType != v1.TaskType_LendWithdraw && p.Token == client.GetNetworkToken() {
+			am = ResolveNetworkTokenAmount(balanceNative.WEI, &Gas.TotalGas, am)
+		}
+	}
+
+	res, err := client.Lend(ctx, &defi.LendReq{
+		Network:      p.Network,
+		Protocol:     p.Protocol,
+		Token:        p.Token,
+		Amount:       am,
+		WalletPK:     profile.WalletPK,
+		EstimateOnly: estimateOnly,
+		Gas:          Gas,
+		Debug:        false,
+	}, h.TaskType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, Gas, nil
+}
+
+// available wallet balance for supply and health factor safe supplied amount for withdraw
+func (h *LendTaskHalper) available(ctx context.Context, profile *halp.Profile, p *v1.LendTask, client defi.Lender) (*big.Int, error) {
+	if h.TaskType == v1.TaskType_LendWithdraw {
+		am, err := client.LendWithdrawable(ctx, &defi.LendWithdrawableReq{
+			Protocol:      p.Protocol,
+			Token:         p.Token,
+			WalletAddress: common.HexToAddress(profile.Addr),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "client.LendWithdrawable")
+		}
+		return am, nil
+	}
+
+	balance, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+		WalletAddress: profile.Addr,
+		Token:         p.Token,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "client.GetBalance")
+	}
+	return balance.WEI, nil
+}
+
+func (h *LendTaskHalper) EstimateCost(ctx context.Context, profile *halp.Profile, p *v1.LendTask, client defi.Lender) (*v1.EstimationTx, error) {
+	res, _, err := h.Execute(ctx, profile, p, client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return GasStation(res.ECost, p.Network), nil
+}
@@ -0,0 +1,384 @@
+package task
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/zksyncera"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/process/halp"
+	"github.com/hardstylez72/cry/internal/server/repository"
+	"github.com/pkg/errors"
+)
+
+func NewMuteioLPTask() *LPTask {
+	return NewLPTask(v1.TaskType_MuteioLP, func(a *Input) (*v1.DefaultLP, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_MuteioLPTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_MuteioLPTask) call an ambulance!")
+		}
+		return l.MuteioLPTask, nil
+	})
+}
+
+func NewSpaceFiLPTask() *LPTask {
+	return NewLPTask(v1.TaskType_SpaceFiLP, func(a *Input) (*v1.DefaultLP, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_SpaceFiLPTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_SpaceFiLPTask) call an ambulance!")
+		}
+		return l.SpaceFiLPTask, nil
+	})
+}
+
+func NewVelocoreLPTask() *LPTask {
+	return NewLPTask(v1.TaskType_VelocoreLP, func(a *Input) (*v1.DefaultLP, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_VelocoreLPTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_VelocoreLPTask) call an ambulance!")
+		}
+		return l.VelocoreLPTask, nil
+	})
+}
+
+func NewIzumiLPTask() *LPTask {
+	return NewLPTask(v1.TaskType_IzumiLP, func(a *Input) (*v1.DefaultLP, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_IzumiLPTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_IzumiLPTask) call an ambulance!")
+		}
+		return l.IzumiLPTask, nil
+	})
+}
+
+func NewMaverickLPTask() *LPTask {
+	return NewLPTask(v1.TaskType_MaverickLP, func(a *Input) (*v1.DefaultLP, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_MaverickLPTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_MaverickLPTask) call an ambulance!")
+		}
+		return l.MaverickLPTask, nil
+	})
+}
+
+type LPTask struct {
+	taskType  v1.TaskType
+	extractor func(a *Input) (*v1.DefaultLP, error)
+	cancel    func()
+	*LPTaskHalper
+}
+
+func NewLPTask(taskType v1.TaskType, extractor func(a *Input) (*v1.DefaultLP, error)) *LPTask {
+	return &LPTask{
+		taskType:  taskType,
+		extractor: extractor,
+		cancel:    nil,
+		LPTaskHalper: &LPTaskHalper{
+			TaskType: taskType,
+		},
+	}
+}
+
+type LPTaskHalper struct {
+	TaskType v1.TaskType
+}
+
+func (t *LPTask) Stop() error {
+	t.cancel()
+	return nil
+}
+
+func (t *LPTask) Type() v1.TaskType {
+	return t.taskType
+}
+
+func (t *LPTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask, error) {
+
+	taskContext, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	t.cancel = cancel
+
+	task := a.Task
+
+	p, err := t.extractor(a)
+	if err != nil {
+		return nil, err
+	}
+
+	switch a.Task.Status {
+	case v1.ProcessStatus_StatusDone, v1.ProcessStatus_StatusError:
+		return a.Task, nil
+	case v1.ProcessStatus_StatusRetry, v1.ProcessStatus_StatusReady, v1.ProcessStatus_StatusRunning:
+
+		task.Status = v1.ProcessStatus_StatusRunning
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+
+	client, _, err := NewZkSyncClient(profile, p.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxId() == "" {
+
+		if p.Add {
+			before, err := client.LiquidityPosition(taskContext, t.positionReq(profile, p, nil), t.taskType)
+			if err != nil {
+				return nil, errors.Wrap(err, "client.LiquidityPosition")
+			}
+			p.Position = lpPositionToPB(before, before.Liquidity)
+		}
+
+		estimation, err := t.EstimateCost(taskContext, profile, p, client, a.ProfileRepository)
+		if err != nil {
+			return nil, errors.Wrap(err, "EstimateCost of "+t.taskType.String())
+		}
+		res, gas, err := t.Execute(taskContext, profile, p, client, a.ProfileRepository, estimation)
+		if err != nil {
+			return nil, errors.Wrap(err, t.taskType.String())
+		}
+
+		if err := a.AddTx(ctx, res.ApproveTx...); err != nil {
+			return nil, err
+		}
+
+		p.Tx = NewTx(res.Tx, gas)
+		if err := a.AddTx2(ctx, p.Tx); err != nil {
+			return nil, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := WaitTxComplete(taskContext, p.Tx, task, client, a); err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxCompleted() {
+		if err := t.track(taskContext, a, profile, p, client); err != nil {
+			return nil, errors.Wrap(err, "track lp position")
+		}
+
+		task.Status = v1.ProcessStatus_StatusDone
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	return task, nil
+}
+
+func (h *LPTaskHalper) Execute(ctx context.Context, profile *halp.Profile, p *v1.DefaultLP, client zksyncera.LP, positions repository.LPPositionRepository, estimation *v1.EstimationTx) (*zksyncera.LiquidityRes, *bozdo.Gas, error) {
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client == nil {
+		client, _, err = NewZkSyncClient(profile, p.Network)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var am *big.Int
+	var position *zksyncera.LiquidityPosition
+	if p.Add {
+		balance, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+			WalletAddress: profile.Addr,
+			Token:         p.A,
+		})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "client.GetBalance")
+		}
+		am, err = defi.ResolveAmount(p.Amount, balance.WEI)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		position, err = h.trackedPosition(ctx, profile, p, client, positions)
+		if err != nil {
+			return nil, nil, err
+		}
+		am, err = defi.ResolveAmount(p.Amount, position.Liquidity)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if am == nil || am.Cmp(big.NewInt(0)) == 0 {
+		if p.Add {
+			return nil, nil, errors.New("not enough balance of " + p.A.String())
+		}
+		return nil, nil, errors.New("no liquidity added by tasks of the profile")
+	}
+
+	estimateOnly := estimation == nil
+	var Gas *bozdo.Gas
+	if estimateOnly {
+		am = bozdo.Percent(am, 90)
+		Gas = nil
+	} else {
+		gas, err := GasManager(estimation, s.Source, p.Network)
+		if err != nil {
+			return nil, nil, err
+		}
+		Gas = gas
+	}
+
+	res, err := client.Liquidity(ctx, &zksyncera.LiquidityReq{
+		A:            p.A,
+		B:            p.B,
+		Stable:       p.Stable,
+		Add:          p.Add,
+		Amount:       am,
+		Position:     position,
+		WalletPK:     profile.WalletPK,
+		Slippage:     getSlippage(s.Source, h.TaskType),
+		EstimateOnly: estimateOnly,
+		Gas:          Gas,
+	}, h.TaskType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !p.Add && !estimateOnly {
+		p.Position = lpPositionToPB(position, am)
+	}
+
+	return res, Gas, nil
+}
+
+func (h *LPTaskHalper) EstimateCost(ctx context.Context, profile *halp.Profile, p *v1.DefaultLP, client zksyncera.LP, positions repository.LPPositionRepository) (*v1.EstimationTx, error) {
+	res, _, err := h.Execute(ctx, profile, p, client, positions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return GasStation(res.ECost, p.Network), nil
+}
+
+// trackedPosition position added by tasks of the profile, capped by its liquidity on chain
+func (h *LPTaskHalper) trackedPosition(ctx context.Context, profile *halp.Profile, p *v1.DefaultLP, client zksyncera.LP, positions repository.LPPositionRepository) (*zksyncera.LiquidityPosition, error) {
+	tracked, err := positions.LPPositionGet(ctx, profile.Id, h.TaskType.String(), lpPair(p))
+	if err != nil {
+		return nil, errors.Wrap(err, "no liquidity added by tasks of the profile")
+	}
+
+	onchain, err := client.LiquidityPosition(ctx, h.positionReq(profile, p, tracked), h.TaskType)
+	if err != nil {
+		return nil, errors.Wrap(err, "client.LiquidityPosition")
+	}
+
+	liquidity, ok := new(big.Int).SetString(tracked.Liquidity, 10)
+	if !ok {
+		return nil, errors.New("invalid tracked liquidity: " + tracked.Liquidity)
+	}
+	if onchain.Liquidity.Cmp(liquidity) < 0 {
+		liquidity = onchain.Liquidity
+	}
+	onchain.Liquidity = liquidity
+	return onchain, nil
+}
+
+// track adds liquidity received by the add task or subtracts withdrawn by the remove one
+func (h *LPTaskHalper) track(ctx context.Context, a *Input, profile *halp.Profile, p *v1.DefaultLP, client zksyncera.LP) error {
+
+	if !p.Add {
+		if p.Position == nil {
+			return nil
+		}
+		return a.ProfileRepository.LPPositionAdd(ctx, &repository.LPPosition{
+			ProfileId:  a.ProfileId,
+			TaskType:   h.TaskType.String(),
+			Pair:       lpPair(p),
+			Pool:       p.Position.Pool,
+			PositionId: p.Position.GetPositionId(),
+			BinId:      p.Position.GetBinId(),
+			Liquidity:  "-" + p.Position.Liquidity,
+		})
+	}
+
+	after, err := client.LiquidityPosition(ctx, h.positionReq(profile, p, nil), h.TaskType)
+	if err != nil {
+		return errors.Wrap(err, "client.LiquidityPosition")
+	}
+
+	delta := new(big.Int).Set(after.Liquidity)
+	if p.Position != nil && p.Position.GetPositionId() == bigString(after.PositionId) {
+		before, ok := new(big.Int).SetString(p.Position.Liquidity, 10)
+		if ok {
+			delta.Sub(delta, before)
+		}
+	}
+	if delta.Sign() <= 0 {
+		return nil
+	}
+
+	return a.ProfileRepository.LPPositionAdd(ctx, &repository.LPPosition{
+		ProfileId:  a.ProfileId,
+		TaskType:   h.TaskType.String(),
+		Pair:       lpPair(p),
+		Pool:       after.Pool.String(),
+		PositionId: bigString(after.PositionId),
+		BinId:      bigString(after.BinId),
+		Liquidity:  delta.String(),
+	})
+}
+
+func (h *LPTaskHalper) positionReq(profile *halp.Profile, p *v1.DefaultLP, tracked *repository.LPPosition) *zksyncera.LiquidityPositionReq {
+	req := &zksyncera.LiquidityPositionReq{
+		A:          p.A,
+		B:          p.B,
+		Stable:     p.Stable,
+		WalletAddr: common.HexToAddress(profile.Addr),
+	}
+	if tracked != nil {
+		req.PositionId, _ = new(big.Int).SetString(tracked.PositionId, 10)
+		req.BinId, _ = new(big.Int).SetString(tracked.BinId, 10)
+	}
+	return req
+}
+
+func lpPair(p *v1.DefaultLP) string {
+	pair := p.A.String() + "-" + p.B.String()
+	if p.Stable {
+		pair += "-stable"
+	}
+	return pair
+}
+
+func lpPositionToPB(position *zksyncera.LiquidityPosition, liquidity *big.Int) *v1.LPPosition {
+	out := &v1.LPPosition{
+		Pool:      position.Pool.String(),
+		Liquidity: liquidity.String(),
+	}
+	if position.PositionId != nil {
+		id := position.PositionId.String()
+		out.PositionId = &id
+	}
+	if position.BinId != nil {
+		bin := position.BinId.String()
+		out.BinId = &bin
+	}
+	return out
+}
+
+func bigString(v *big.Int) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
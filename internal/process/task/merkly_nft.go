@@ -156,6 +156,17 @@ func (t *MerklyMintAndBridgeNFTTask) Run(ctx context.Context, a *Input) (*v1.Pro
 		}
 	}
 
+	if waitDelivery(p.WaitDelivery) {
+		if err := WaitLayerZeroDelivery(ctx, taskContext, p.GetBridgeTx(), &LayerZeroDelivery{
+			Status:  &p.LayerZeroStatus,
+			Url:     &p.LzscanUrl,
+			DstTxId: &p.DstTxId,
+		}, task, a); err != nil {
+			return nil, err
+		}
+		return task, nil
+	}
+
 	// wait
 	if p.GetBridgeTx().GetTxCompleted() {
 		task.Status = v1.ProcessStatus_StatusDone
@@ -0,0 +1,477 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/process/halp"
+	"github.com/hardstylez72/cry/internal/uniclient"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// officialWithdrawalRecheck withdrawal that is not ready is checked again after, unless the bridge knows when it is ready
+const officialWithdrawalRecheck = time.Hour
+
+func NewOfficialBridgeDepositTask() *OfficialBridgeTask {
+	return NewOfficialBridgeTaskOf(v1.TaskType_OfficialBridgeDeposit, func(a *Input) (*v1.OfficialBridgeTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_OfficialBridgeDepositTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_OfficialBridgeDepositTask) call an ambulance!")
+		}
+		return l.OfficialBridgeDepositTask, nil
+	})
+}
+
+func NewOfficialBridgeWithdrawTask() *OfficialBridgeTask {
+	return NewOfficialBridgeTaskOf(v1.TaskType_OfficialBridgeWithdraw, func(a *Input) (*v1.OfficialBridgeTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_OfficialBridgeWithdrawTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_OfficialBridgeWithdrawTask) call an ambulance!")
+		}
+		return l.OfficialBridgeWithdrawTask, nil
+	})
+}
+
+// OfficialBridgeTask withdrawal keeps the task running from the initiation on l2 to the finalization on ethereum,
+// the steps and the withdrawal message are stored in the task so it is resumed after restarts
+type OfficialBridgeTask struct {
+	taskType  v1.TaskType
+	extractor func(a *Input) (*v1.OfficialBridgeTask, error)
+	cancel    func()
+	*OfficialBridgeTaskHalper
+}
+
+func NewOfficialBridgeTaskOf(taskType v1.TaskType, extractor func(a *Input) (*v1.OfficialBridgeTask, error)) *OfficialBridgeTask {
+	return &OfficialBridgeTask{
+		taskType:  taskType,
+		extractor: extractor,
+		cancel:    nil,
+		OfficialBridgeTaskHalper: &OfficialBridgeTaskHalper{
+			TaskType: taskType,
+		},
+	}
+}
+
+type OfficialBridgeTaskHalper struct {
+	TaskType v1.TaskType
+}
+
+func (t *OfficialBridgeTask) Stop() error {
+	t.cancel()
+	return nil
+}
+
+func (t *OfficialBridgeTask) Type() v1.TaskType {
+	return t.taskType
+}
+
+func (t *OfficialBridgeTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask, error) {
+
+	taskContext, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	t.cancel = cancel
+
+	task := a.Task
+
+	p, err := t.extractor(a)
+	if err != nil {
+		return nil, err
+	}
+
+	switch a.Task.Status {
+	case v1.ProcessStatus_StatusDone, v1.ProcessStatus_StatusError:
+		return a.Task, nil
+	case v1.ProcessStatus_StatusRetry, v1.ProcessStatus_StatusReady, v1.ProcessStatus_StatusRunning:
+
+		task.Status = v1.ProcessStatus_StatusRunning
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if profile.Type != v1.ProfileType_EVM {
+		return nil, errors.New("task is available only for emv account")
+	}
+
+	clients, err := t.clients(ctx, profile, p)
+	if err != nil {
+		return nil, err
+	}
+	source := clients.source(t.TaskType)
+
+	if p.GetTx().GetTxId() == "" {
+
+		estimation, err := t.EstimateCost(taskContext, profile, p, clients)
+		if err != nil {
+			return nil, errors.Wrap(err, "EstimateCost of "+t.taskType.String())
+		}
+		res, gas, err := t.Execute(taskContext, profile, p, clients, estimation)
+		if err != nil {
+			return nil, errors.Wrap(err, t.taskType.String())
+		}
+
+		p.Tx = NewTx(res.Tx, gas)
+		if err := a.AddTx2(ctx, p.Tx); err != nil {
+			return nil, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := WaitTxComplete(taskContext, p.Tx, task, source, a); err != nil {
+		return nil, err
+	}
+	if !p.GetTx().GetTxCompleted() {
+		return task, nil
+	}
+
+	if t.TaskType == v1.TaskType_OfficialBridgeWithdraw {
+		done, err := t.withdrawal(ctx, taskContext, a, profile, p, clients.l1, clients.bridge)
+		if err != nil {
+			return nil, err
+		}
+		if !done {
+			return task, nil
+		}
+	}
+
+	task.Status = v1.ProcessStatus_StatusDone
+	task.FinishedAt = timestamppb.Now()
+	if err := a.UpdateTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// withdrawal moves the initiated withdrawal through prove and finalize, true once it is finalized
+func (t *OfficialBridgeTask) withdrawal(ctx, taskContext context.Context, a *Input, profile *halp.Profile, p *v1.OfficialBridgeTask, l1 defi.EVM, bridge *defi.OfficialBridge) (bool, error) {
+	task := a.Task
+
+	if p.Withdrawal == nil {
+		w, err := bridge.Withdrawal(taskContext, common.HexToHash(p.Tx.TxId))
+		if err != nil {
+			return false, errors.Wrap(err, "bridge.Withdrawal")
+		}
+		b, err := json.Marshal(w)
+		if err != nil {
+			return false, err
+		}
+		withdrawal := string(b)
+		step := v1.OfficialWithdrawalStep_WithdrawalInitiated
+		p.Withdrawal = &withdrawal
+		p.Step = &step
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return false, err
+		}
+	}
+
+	var w defi.Withdrawal
+	if err := json.Unmarshal([]byte(p.GetWithdrawal()), &w); err != nil {
+		return false, errors.Wrap(err, "withdrawal")
+	}
+
+	waitCtx, cancel := context.WithTimeout(taskContext, time.Second*20)
+	defer cancel()
+	if !waitNextCheck(waitCtx, p.NextCheck) {
+		return false, nil
+	}
+
+	if bridge.NeedsProof() && p.GetStep() == v1.OfficialWithdrawalStep_WithdrawalInitiated {
+		if p.GetProveTx().GetTxId() == "" {
+			res, gas, err := sendOnEthereum(taskContext, profile, l1, func(req *defi.OfficialBridgeReq) (*bozdo.DefaultRes, error) {
+				return bridge.Prove(taskContext, &w, req)
+			})
+			if err != nil {
+				return t.notReady(ctx, a, p, err)
+			}
+			p.ProveTx = NewTx(res.Tx, gas)
+			if err := a.AddTx2(ctx, p.ProveTx); err != nil {
+				return false, err
+			}
+			if err := a.UpdateTask(ctx, task); err != nil {
+				return false, err
+			}
+		}
+
+		if err := WaitTxComplete(taskContext, p.ProveTx, task, l1, a); err != nil {
+			return false, err
+		}
+		if !p.GetProveTx().GetTxCompleted() {
+			return false, nil
+		}
+
+		step := v1.OfficialWithdrawalStep_WithdrawalProven
+		p.Step = &step
+		p.NextCheck = nil
+		p.WaitingFor = nil
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return false, err
+		}
+	}
+
+	if p.GetFinalizeTx().GetTxId() == "" {
+		res, gas, err := sendOnEthereum(taskContext, profile, l1, func(req *defi.OfficialBridgeReq) (*bozdo.DefaultRes, error) {
+			return bridge.Finalize(taskContext, &w, req)
+		})
+		if err != nil {
+			return t.notReady(ctx, a, p, err)
+		}
+		p.FinalizeTx = NewTx(res.Tx, gas)
+		if err := a.AddTx2(ctx, p.FinalizeTx); err != nil {
+			return false, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return false, err
+		}
+	}
+
+	if err := WaitTxComplete(taskContext, p.FinalizeTx, task, l1, a); err != nil {
+		return false, err
+	}
+	if !p.GetFinalizeTx().GetTxCompleted() {
+		return false, nil
+	}
+
+	step := v1.OfficialWithdrawalStep_WithdrawalFinalized
+	p.Step = &step
+	p.WaitingFor = nil
+	return true, nil
+}
+
+// notReady postpones the withdrawal step until it can be done, the withdrawal finalized elsewhere is done
+func (t *OfficialBridgeTask) notReady(ctx context.Context, a *Input, p *v1.OfficialBridgeTask, err error) (bool, error) {
+	if errors.Is(err, defi.ErrWithdrawalFinalized) {
+		step := v1.OfficialWithdrawalStep_WithdrawalFinalized
+		p.Step = &step
+		p.WaitingFor = nil
+		return true, nil
+	}
+
+	next, reason, ok := withdrawalNotReady(err)
+	if !ok {
+		return false, err
+	}
+	p.NextCheck = next
+	p.WaitingFor = reason
+	if err := a.UpdateTask(ctx, a.Task); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// withdrawalNotReady time the withdrawal is checked again and the reason it waits for, false if err is not about readiness
+func withdrawalNotReady(err error) (*timestamppb.Timestamp, *string, bool) {
+	var e *defi.WithdrawalNotReady
+	if !errors.As(err, &e) {
+		return nil, nil, false
+	}
+
+	next := time.Now().Add(officialWithdrawalRecheck)
+	if e.ReadyAt.After(time.Now()) {
+		next = e.ReadyAt
+	}
+	reason := e.Reason
+	return timestamppb.New(next), &reason, true
+}
+
+// waitNextCheck sleeps until the next check of the withdrawal, the context limits a single run like in the delay task
+func waitNextCheck(ctx context.Context, next *timestamppb.Timestamp) bool {
+	if next == nil || !time.Now().Before(next.AsTime()) {
+		return true
+	}
+
+	timer := time.NewTimer(time.Until(next.AsTime()))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// sendOnEthereum estimates the prove or finalize transaction, then sends it with the gas of the ethereum settings
+func sendOnEthereum(ctx context.Context, profile *halp.Profile, l1 defi.Networker, fn func(req *defi.OfficialBridgeReq) (*bozdo.DefaultRes, error)) (*bozdo.DefaultRes, *bozdo.Gas, error) {
+	s, err := profile.GetNetworkSettings(ctx, v1.Network_Etherium)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := fn(&defi.OfficialBridgeReq{
+		Amount:       big.NewInt(0),
+		WalletPK:     profile.WalletPK,
+		EstimateOnly: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gas, err := GasManager(GasStation(res.ECost, v1.Network_Etherium), s.Source, v1.Network_Etherium)
+	if err != nil {
+		return nil, nil, err
+	}
+	balanceNative, err := l1.GetBalance(ctx, &defi.GetBalanceReq{
+		WalletAddress: profile.Addr,
+		Token:         l1.GetNetworkToken(),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "client.GetBalance")
+	}
+	if balanceNative.WEI.Cmp(&gas.TotalGas) <= 0 {
+		return nil, nil, ErrProfileHasInsufficientBalance(l1.GetNetworkToken(), &gas.TotalGas, balanceNative.WEI)
+	}
+
+	res, err = fn(&defi.OfficialBridgeReq{
+		Amount:   big.NewInt(0),
+		WalletPK: profile.WalletPK,
+		Gas:      gas,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, gas, nil
+}
+
+type officialBridgeClients struct {
+	l1     defi.EVM
+	l2     defi.EVM
+	bridge *defi.OfficialBridge
+}
+
+// source deposits are sent on ethereum, withdrawals on l2
+func (c *officialBridgeClients) source(taskType v1.TaskType) defi.EVM {
+	if taskType == v1.TaskType_OfficialBridgeDeposit {
+		return c.l1
+	}
+	return c.l2
+}
+
+func (h *OfficialBridgeTaskHalper) clients(ctx context.Context, profile *halp.Profile, p *v1.OfficialBridgeTask) (*officialBridgeClients, error) {
+	l1s, err := profile.GetNetworkSettings(ctx, v1.Network_Etherium)
+	if err != nil {
+		return nil, err
+	}
+	l2s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, err
+	}
+	l1, l2, bridge, err := uniclient.NewOfficialBridge(p.Network, l1s.BaseConfig(), l2s.BaseConfig())
+	if err != nil {
+		return nil, err
+	}
+	return &officialBridgeClients{l1: l1, l2: l2, bridge: bridge}, nil
+}
+
+func (h *OfficialBridgeTaskHalper) sourceNetwork(p *v1.OfficialBridgeTask) v1.Network {
+	if h.TaskType == v1.TaskType_OfficialBridgeDeposit {
+		return v1.Network_Etherium
+	}
+	return p.Network
+}
+
+func (h *OfficialBridgeTaskHalper) Execute(ctx context.Context, profile *halp.Profile, p *v1.OfficialBridgeTask, clients *officialBridgeClients, estimation *v1.EstimationTx) (*bozdo.DefaultRes, *bozdo.Gas, error) {
+
+	network := h.sourceNetwork(p)
+	s, err := profile.GetNetworkSettings(ctx, network)
+	if err != nil {
+		return nil, nil, err
+	}
+	if clients == nil {
+		clients, err = h.clients(ctx, profile, p)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	source := clients.source(h.TaskType)
+
+	balance, err := source.GetBalance(ctx, &defi.GetBalanceReq{
+		WalletAddress: profile.Addr,
+		Token:         source.GetNetworkToken(),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "client.GetBalance")
+	}
+
+	// the message fee of the withdrawal is sent along with the value, it is reserved as the gas is
+	fee := big.NewInt(0)
+	if h.TaskType == v1.TaskType_OfficialBridgeWithdraw {
+		fee, err = clients.bridge.WithdrawFee(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "WithdrawFee")
+		}
+	}
+	available := new(big.Int).Sub(balance.WEI, fee)
+	if available.Sign() <= 0 {
+		return nil, nil, ErrProfileHasInsufficientBalance(source.GetNetworkToken(), fee, balance.WEI)
+	}
+
+	am, err := defi.ResolveAmount(p.Amount, available)
+	if err != nil {
+		return nil, nil, err
+	}
+	if am == nil || am.Cmp(big.NewInt(0)) == 0 {
+		return nil, nil, errors.New("not enough balance of " + source.GetNetworkToken().String())
+	}
+
+	estimateOnly := estimation == nil
+	var Gas *bozdo.Gas
+	if estimateOnly {
+		am = bozdo.Percent(am, 90)
+	} else {
+		gas, err := GasManager(estimation, s.Source, network)
+		if err != nil {
+			return nil, nil, err
+		}
+		Gas = gas
+
+		if available.Cmp(&Gas.TotalGas) <= 0 {
+			need := new(big.Int).Add(&Gas.TotalGas, fee)
+			return nil, nil, ErrProfileHasInsufficientBalance(source.GetNetworkToken(), need, balance.WEI)
+		}
+		am = ResolveNetworkTokenAmount(available, &Gas.TotalGas, am)
+	}
+
+	req := &defi.OfficialBridgeReq{
+		Amount:       am,
+		WalletPK:     profile.WalletPK,
+		EstimateOnly: estimateOnly,
+		Gas:          Gas,
+	}
+
+	var res *bozdo.DefaultRes
+	switch h.TaskType {
+	case v1.TaskType_OfficialBridgeDeposit:
+		res, err = clients.bridge.Deposit(ctx, req)
+	case v1.TaskType_OfficialBridgeWithdraw:
+		res, err = clients.bridge.Withdraw(ctx, req)
+	default:
+		return nil, nil, errors.New("unsupported task type: " + h.TaskType.String())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, Gas, nil
+}
+
+func (h *OfficialBridgeTaskHalper) EstimateCost(ctx context.Context, profile *halp.Profile, p *v1.OfficialBridgeTask, clients *officialBridgeClients) (*v1.EstimationTx, error) {
+	res, _, err := h.Execute(ctx, profile, p, clients, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return GasStation(res.ECost, h.sourceNetwork(p)), nil
+}
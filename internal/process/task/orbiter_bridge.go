@@ -2,6 +2,8 @@ package task
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
@@ -12,6 +14,11 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	orbiterArrivalTimeout = 10 * time.Minute
+	orbiterArrivalPoll    = 15 * time.Second
+)
+
 type OrbiterBridgeTask struct {
 	cancel func()
 }
@@ -66,7 +73,7 @@ func (t *OrbiterBridgeTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask,
 
 	if p.GetTx().GetTxId() == "" {
 
-		estimation, err := EstimateOrbiterBridgeCost(taskContext, a.Orbiter, profile, p)
+		estimation, maker, err := estimateOrbiterBridge(taskContext, a.Orbiter, profile, p)
 		if err != nil {
 			return nil, err
 		}
@@ -97,6 +104,18 @@ func (t *OrbiterBridgeTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask,
 			am = ResolveNetworkTokenAmount(b.WEI, &gas.TotalGas, am)
 		}
 
+		dst, err := orbiterDestination(taskContext, profile, p)
+		if err != nil {
+			return nil, err
+		}
+		before, err := dst.GetBalance(taskContext, &defi.GetBalanceReq{
+			WalletAddress: *walletAddr,
+			Token:         p.ToToken,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "destination GetBalance")
+		}
+
 		res, err := client.OrbiterBridge(taskContext, &defi.OrbiterBridgeReq{
 			OrbiterService: a.Orbiter,
 			FromNetwork:    p.FromNetwork,
@@ -106,12 +125,21 @@ func (t *OrbiterBridgeTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask,
 			Amount:         am,
 			WalletPk:       profile.WalletPK,
 			Gas:            gas,
+			Maker:          maker,
 		})
 		if err != nil {
 			return nil, err
 		}
 
 		p.Tx = NewTx(res.Tx, gas)
+		beforeWei := before.WEI.String()
+		p.DstBalanceBefore = &beforeWei
+		if res.Maker != nil {
+			received := res.Maker.Received.String()
+			p.ReceivedAmount = &received
+			p.OrbiterReceiverAddr = &res.Maker.MakerReceiverAddr
+			p.OrbiterSenderAddr = &res.Maker.MakerSenderAddr
+		}
 		if err := a.UpdateTask(ctx, task); err != nil {
 			return nil, err
 		}
@@ -125,6 +153,10 @@ func (t *OrbiterBridgeTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask,
 	}
 
 	if p.GetTx().GetTxCompleted() {
+		if err := waitOrbiterArrival(ctx, taskContext, profile, p, task, a); err != nil {
+			return nil, err
+		}
+
 		task.Status = v1.ProcessStatus_StatusDone
 		if err := a.UpdateTask(ctx, task); err != nil {
 			return nil, err
@@ -134,35 +166,94 @@ func (t *OrbiterBridgeTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask,
 	return task, nil
 }
 
+func orbiterDestination(ctx context.Context, profile *halp.Profile, p *v1.OrbiterBridgeTask) (defi.Networker, error) {
+	s, err := profile.GetNetworkSettings(ctx, p.ToNetwork)
+	if err != nil {
+		return nil, err
+	}
+	return uniclient.NewBaseClient(p.ToNetwork, s.BaseConfig())
+}
+
+// waitOrbiterArrival waits for the destination balance to grow by the amount the maker sends,
+// the task is retried while the transfer is not there. Tasks created before the check are not waited for
+func waitOrbiterArrival(ctx, taskContext context.Context, profile *halp.Profile, p *v1.OrbiterBridgeTask, task *v1.ProcessTask, updater TaskUpdater) error {
+	if p.DstBalanceBefore == nil || p.ReceivedAmount == nil || p.GetReceived() {
+		return nil
+	}
+
+	before, ok := new(big.Int).SetString(p.GetDstBalanceBefore(), 10)
+	if !ok {
+		return errors.New("invalid destination balance: " + p.GetDstBalanceBefore())
+	}
+	expected, ok := new(big.Int).SetString(p.GetReceivedAmount(), 10)
+	if !ok {
+		return errors.New("invalid orbiter received amount: " + p.GetReceivedAmount())
+	}
+	// maker rounds the amount it sends
+	want := new(big.Int).Add(before, bozdo.Percent(expected, 99))
+
+	dst, err := orbiterDestination(taskContext, profile, p)
+	if err != nil {
+		return err
+	}
+
+	waitCtx, cancel := context.WithTimeout(taskContext, orbiterArrivalTimeout)
+	defer cancel()
+
+	for {
+		b, err := dst.GetBalance(waitCtx, &defi.GetBalanceReq{
+			WalletAddress: profile.Addr,
+			Token:         p.ToToken,
+		})
+		if err == nil && b.WEI.Cmp(want) >= 0 {
+			received := true
+			p.Received = &received
+			return updater.UpdateTask(ctx, task)
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return errors.New("orbiter transfer has not arrived to " + p.ToNetwork.String() + " yet, maker: " + p.GetOrbiterSenderAddr())
+		case <-time.After(orbiterArrivalPoll):
+		}
+	}
+}
+
 func EstimateOrbiterBridgeCost(ctx context.Context, orbiterService *orbiter.Service, profile *halp.Profile, p *v1.OrbiterBridgeTask) (*v1.EstimationTx, error) {
+	estimation, _, err := estimateOrbiterBridge(ctx, orbiterService, profile, p)
+	return estimation, err
+}
+
+// estimateOrbiterBridge estimates the transfer and returns the maker it is made to
+func estimateOrbiterBridge(ctx context.Context, orbiterService *orbiter.Service, profile *halp.Profile, p *v1.OrbiterBridgeTask) (*v1.EstimationTx, *orbiter.Tx, error) {
 
 	s, err := profile.GetNetworkSettings(ctx, p.FromNetwork)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	swapper, err := uniclient.NewOrbiterSwapper(p.FromNetwork, s.BaseConfig())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	w, err := s.GetWalletAddr()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	walletAddr := *w
 
 	b, err := swapper.GetBalance(ctx, &defi.GetBalanceReq{WalletAddress: walletAddr, Token: p.FromToken})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if b.Float == 0 {
-		return nil, errors.New("not enough balance in " + p.FromToken.String())
+		return nil, nil, errors.New("not enough balance in " + p.FromToken.String())
 	}
 
 	am, err := defi.ResolveAmount(p.Amount, b.WEI)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	swap, err := swapper.OrbiterBridge(ctx, &defi.OrbiterBridgeReq{
@@ -177,12 +268,12 @@ func EstimateOrbiterBridgeCost(ctx context.Context, orbiterService *orbiter.Serv
 		EstimateOnly:   true,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if p.FromToken == swapper.GetNetworkToken() {
 		am = ResolveNetworkTokenAmount(b.WEI, swap.ECost.TotalGasWei, am)
 	}
 
-	return GasStation(swap.ECost, p.FromNetwork), nil
+	return GasStation(swap.ECost, p.FromNetwork), swap.Maker, nil
 }
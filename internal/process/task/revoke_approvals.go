@@ -0,0 +1,228 @@
+package task
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/process/halp"
+	"github.com/hardstylez72/cry/internal/uniclient"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RevokeApprovalsTask struct {
+	cancel func()
+}
+
+func (t *RevokeApprovalsTask) Stop() error {
+	t.cancel()
+	return nil
+}
+
+func (t *RevokeApprovalsTask) Type() v1.TaskType {
+	return v1.TaskType_RevokeApprovals
+}
+
+func (t *RevokeApprovalsTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask, error) {
+
+	taskContext, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	t.cancel = cancel
+
+	task := a.Task
+
+	l, ok := a.Task.Task.Task.(*v1.Task_RevokeApprovalsTask)
+	if !ok {
+		return nil, errors.New("Task.(*v1.Task_RevokeApprovalsTask) call an ambulance!")
+	}
+	p := l.RevokeApprovalsTask
+
+	switch a.Task.Status {
+	case v1.ProcessStatus_StatusDone, v1.ProcessStatus_StatusError:
+		return a.Task, nil
+	case v1.ProcessStatus_StatusRetry, v1.ProcessStatus_StatusReady, v1.ProcessStatus_StatusRunning:
+
+		task.Status = v1.ProcessStatus_StatusRunning
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if profile.Type != v1.ProfileType_EVM {
+		return nil, errors.New("task is available only for emv account")
+	}
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uniclient.NewApprover(p.Network, s.BaseConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if tx := pendingRevokeTx(p); tx != nil {
+			if err := WaitTxComplete(taskContext, tx, task, client, a); err != nil {
+				return nil, err
+			}
+		}
+
+		approvals, err := approvalsToRevoke(taskContext, profile, p, client)
+		if err != nil {
+			return nil, err
+		}
+		if len(approvals) == 0 {
+			break
+		}
+
+		estimated, _, err := revokeApproval(taskContext, profile, p, client, approvals[0], nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "EstimateRevokeApprovalsCost")
+		}
+		estimation := GasStation(estimated.ECost, p.Network)
+		res, gas, err := revokeApproval(taskContext, profile, p, client, approvals[0], estimation)
+		if err != nil {
+			return nil, errors.Wrap(err, "RevokeApproval")
+		}
+
+		tx := NewTx(res.Tx, gas)
+		p.Txs = append(p.Txs, tx)
+		if err := a.AddTx2(ctx, tx); err != nil {
+			return nil, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	task.Status = v1.ProcessStatus_StatusDone
+	task.FinishedAt = timestamppb.Now()
+	if err := a.UpdateTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// pendingRevokeTx the last sent revoke tx if it is not completed yet
+func pendingRevokeTx(p *v1.RevokeApprovalsTask) *v1.TaskTx {
+	for i := len(p.Txs) - 1; i >= 0; i-- {
+		tx := p.Txs[i]
+		if tx.GetTxId() == "" {
+			continue
+		}
+		if tx.GetTxCompleted() {
+			return nil
+		}
+		return tx
+	}
+	return nil
+}
+
+// approvalsToRevoke outstanding approvals of the profile matching the task
+func approvalsToRevoke(ctx context.Context, profile *halp.Profile, p *v1.RevokeApprovalsTask, client defi.Approver) ([]*defi.Approval, error) {
+	spenders := make([]defi.Spender, 0, len(p.Spenders))
+	for _, s := range p.Spenders {
+		if !common.IsHexAddress(s) {
+			return nil, errors.New("invalid spender address: " + s)
+		}
+		spenders = append(spenders, defi.Spender{Address: common.HexToAddress(s)})
+	}
+
+	registered, err := profile.Spenders(ctx, p.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Approvals(ctx, &defi.ApprovalsReq{
+		Wallet:        common.HexToAddress(profile.Addr),
+		Tokens:        p.Tokens,
+		Spenders:      spenders,
+		Registered:    registered,
+		UnlimitedOnly: p.GetUnlimitedOnly(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Approvals")
+	}
+	return res.Approvals, nil
+}
+
+func revokeApproval(ctx context.Context, profile *halp.Profile, p *v1.RevokeApprovalsTask, client defi.Approver, approval *defi.Approval, estimation *v1.EstimationTx) (*bozdo.DefaultRes, *bozdo.Gas, error) {
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	estimateOnly := estimation == nil
+	var Gas *bozdo.Gas
+	if !estimateOnly {
+		gas, err := GasManager(estimation, s.Source, p.Network)
+		if err != nil {
+			return nil, nil, err
+		}
+		Gas = gas
+
+		balanceNative, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+			WalletAddress: profile.Addr,
+			Token:         client.GetNetworkToken(),
+		})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "client.GetBalance")
+		}
+		if balanceNative.WEI.Cmp(&Gas.TotalGas) <= 0 {
+			return nil, nil, ErrProfileHasInsufficientBalance(client.GetNetworkToken(), &Gas.TotalGas, balanceNative.WEI)
+		}
+	}
+
+	res, err := client.RevokeApproval(ctx, &defi.RevokeApprovalReq{
+		Token:        approval.Token,
+		Spender:      approval.Spender.Address,
+		WalletPK:     profile.WalletPK,
+		EstimateOnly: estimateOnly,
+		Gas:          Gas,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, Gas, nil
+}
+
+// EstimateRevokeApprovalsCost cost of the revoke of the next outstanding approval
+func EstimateRevokeApprovalsCost(ctx context.Context, profile *halp.Profile, p *v1.RevokeApprovalsTask, client defi.Approver) (*v1.EstimationTx, error) {
+	if client == nil {
+		s, err := profile.GetNetworkSettings(ctx, p.Network)
+		if err != nil {
+			return nil, err
+		}
+		client, err = uniclient.NewApprover(p.Network, s.BaseConfig())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	approvals, err := approvalsToRevoke(ctx, profile, p, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(approvals) == 0 {
+		return nil, defi.ErrNoApprovals
+	}
+
+	res, _, err := revokeApproval(ctx, profile, p, client, approvals[0], nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return GasStation(res.ECost, p.Network), nil
+}
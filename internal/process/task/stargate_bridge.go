@@ -6,8 +6,8 @@ import (
 
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	"github.com/hardstylez72/cry/internal/log"
-	"github.com/hardstylez72/cry/internal/lzscan"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/hardstylez72/cry/internal/process/halp"
 	"github.com/hardstylez72/cry/internal/uniclient"
@@ -93,43 +93,22 @@ func (t *StargateTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask, erro
 		return nil, err
 	}
 
-	if p.LayerZeroStatus == nil || *p.LayerZeroStatus != lzscan.StatusDELIVERED {
-		s := lzscan.NewService()
-		lzUrl, err := s.GetTxUrl(taskContext, p.GetTx().GetTxId())
-		if err != nil {
-			if err == lzscan.ErrNotFound {
-				return task, nil
+	if !waitDelivery(p.WaitDelivery) {
+		if p.GetTx().GetTxCompleted() {
+			task.Status = v1.ProcessStatus_StatusDone
+			task.FinishedAt = timestamppb.Now()
+			if err := a.UpdateTask(ctx, task); err != nil {
+				return nil, err
 			}
-			return nil, err
-		}
-		p.LzscanUrl = &lzUrl
-		if err := a.UpdateTask(ctx, task); err != nil {
-			return nil, err
-		}
-
-		status, err := s.WaitConfirm(taskContext, p.GetTx().GetTxId())
-		if err != nil {
-			return nil, err
-		}
-
-		p.LzscanUrl = &lzUrl
-		p.LayerZeroStatus = &status
-		if err := a.UpdateTask(ctx, task); err != nil {
-			return nil, err
 		}
+		return task, nil
 	}
 
-	switch p.GetLayerZeroStatus() {
-	case lzscan.StatusDELIVERED:
-		task.Status = v1.ProcessStatus_StatusDone
-		task.FinishedAt = timestamppb.Now()
-	case lzscan.StatusINFLIGHT:
-		task.Status = v1.ProcessStatus_StatusRunning
-	case lzscan.StatusFAILED:
-		task.Status = v1.ProcessStatus_StatusError
-	}
-
-	if err := a.UpdateTask(ctx, task); err != nil {
+	if err := WaitLayerZeroDelivery(ctx, taskContext, p.GetTx(), &LayerZeroDelivery{
+		Status:  &p.LayerZeroStatus,
+		Url:     &p.LzscanUrl,
+		DstTxId: &p.DstTxId,
+	}, task, a); err != nil {
 		return nil, err
 	}
 
@@ -166,7 +145,7 @@ func NewSwapper(ctx context.Context, a *Input) (defi.StargateSwapper, error) {
 
 	swapper, err := uniclient.NewStargateSwapper(l.StargateBridgeTask.FromNetwork, &uniclient.BaseClientConfig{
 		ProxyString: proxyString,
-		RPCEndpoint: stgs.RpcEndpoint,
+		RPCEndpoint: rpcpool.FromSettings(stgs),
 	})
 	if err != nil {
 		return nil, err
@@ -275,6 +254,7 @@ func GasManager(e *v1.EstimationTx, s *v1.NetworkSettings, n v1.Network) (*bozdo
 	gas := &bozdo.Gas{
 		Network:             n,
 		MaxGas:              *maxGas,
+		FeeSpeed:            s.GetFeeSpeed(),
 		GasBeforeMultiplier: *beforeMultiplier,
 		GasLimit:            *limit,
 		GasPrice:            *gasPrice,
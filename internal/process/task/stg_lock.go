@@ -0,0 +1,213 @@
+package task
+
+import (
+	"context"
+
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/bozdo"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/process/halp"
+	"github.com/hardstylez72/cry/internal/uniclient"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewStgLockTask() *StgLockTask {
+	return NewStgLockTaskOf(v1.TaskType_StgLock, func(a *Input) (*v1.StgLockTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_StgLockTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_StgLockTask) call an ambulance!")
+		}
+		return l.StgLockTask, nil
+	})
+}
+
+func NewStgExtendLockTask() *StgLockTask {
+	return NewStgLockTaskOf(v1.TaskType_StgExtendLock, func(a *Input) (*v1.StgLockTask, error) {
+		l, ok := a.Task.Task.Task.(*v1.Task_StgExtendLockTask)
+		if !ok {
+			return nil, errors.New("Task.(*v1.Task_StgExtendLockTask) call an ambulance!")
+		}
+		return l.StgExtendLockTask, nil
+	})
+}
+
+type StgLockTask struct {
+	taskType  v1.TaskType
+	extractor func(a *Input) (*v1.StgLockTask, error)
+	cancel    func()
+	*StgLockTaskHalper
+}
+
+func NewStgLockTaskOf(taskType v1.TaskType, extractor func(a *Input) (*v1.StgLockTask, error)) *StgLockTask {
+	return &StgLockTask{
+		taskType:  taskType,
+		extractor: extractor,
+		cancel:    nil,
+		StgLockTaskHalper: &StgLockTaskHalper{
+			TaskType: taskType,
+		},
+	}
+}
+
+type StgLockTaskHalper struct {
+	TaskType v1.TaskType
+}
+
+func (t *StgLockTask) Stop() error {
+	t.cancel()
+	return nil
+}
+
+func (t *StgLockTask) Type() v1.TaskType {
+	return t.taskType
+}
+
+func (t *StgLockTask) Run(ctx context.Context, a *Input) (*v1.ProcessTask, error) {
+
+	taskContext, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	t.cancel = cancel
+
+	task := a.Task
+
+	p, err := t.extractor(a)
+	if err != nil {
+		return nil, err
+	}
+
+	switch a.Task.Status {
+	case v1.ProcessStatus_StatusDone, v1.ProcessStatus_StatusError:
+		return a.Task, nil
+	case v1.ProcessStatus_StatusRetry, v1.ProcessStatus_StatusReady, v1.ProcessStatus_StatusRunning:
+
+		task.Status = v1.ProcessStatus_StatusRunning
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	if profile.Type != v1.ProfileType_EVM {
+		return nil, errors.New("task is available only for emv account")
+	}
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := uniclient.NewStgStaker(p.Network, s.BaseConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxId() == "" {
+
+		estimation, err := t.EstimateCost(taskContext, profile, p, client)
+		if err != nil {
+			return nil, errors.Wrap(err, "EstimateCost of "+t.taskType.String())
+		}
+		res, gas, err := t.Execute(taskContext, profile, p, client, estimation)
+		if err != nil {
+			return nil, errors.Wrap(err, t.taskType.String())
+		}
+
+		p.ApproveTx = NewTx(res.ApproveTx, nil)
+		if err := a.AddTx2(ctx, p.ApproveTx); err != nil {
+			return nil, err
+		}
+
+		p.Tx = NewTx(res.Tx, gas)
+		if err := a.AddTx2(ctx, p.Tx); err != nil {
+			return nil, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := WaitTxComplete(taskContext, p.Tx, task, client, a); err != nil {
+		return nil, err
+	}
+
+	if p.GetTx().GetTxCompleted() {
+		task.Status = v1.ProcessStatus_StatusDone
+		task.FinishedAt = timestamppb.Now()
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
+	return task, nil
+}
+
+func (h *StgLockTaskHalper) Execute(ctx context.Context, profile *halp.Profile, p *v1.StgLockTask, client defi.StgStaker, estimation *v1.EstimationTx) (*bozdo.DefaultRes, *bozdo.Gas, error) {
+
+	s, err := profile.GetNetworkSettings(ctx, p.Network)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client == nil {
+		client, err = uniclient.NewStgStaker(p.Network, s.BaseConfig())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	estimateOnly := estimation == nil
+	var Gas *bozdo.Gas
+	if !estimateOnly {
+		gas, err := GasManager(estimation, s.Source, p.Network)
+		if err != nil {
+			return nil, nil, err
+		}
+		Gas = gas
+
+		balanceNative, err := client.GetBalance(ctx, &defi.GetBalanceReq{
+			WalletAddress: profile.Addr,
+			Token:         client.GetNetworkToken(),
+		})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "client.GetBalance")
+		}
+		if balanceNative.WEI.Cmp(&Gas.TotalGas) <= 0 {
+			return nil, nil, ErrProfileHasInsufficientBalance(client.GetNetworkToken(), &Gas.TotalGas, balanceNative.WEI)
+		}
+	}
+
+	req := &defi.StgLockReq{
+		Amount:       p.Amount,
+		LockDays:     p.GetLockDays(),
+		WalletPK:     profile.WalletPK,
+		EstimateOnly: estimateOnly,
+		Gas:          Gas,
+	}
+
+	var res *bozdo.DefaultRes
+	switch h.TaskType {
+	case v1.TaskType_StgLock:
+		res, err = client.StgLock(ctx, req)
+	case v1.TaskType_StgExtendLock:
+		res, err = client.StgExtendLock(ctx, req)
+	default:
+		return nil, nil, errors.New("unsupported task type: " + h.TaskType.String())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, Gas, nil
+}
+
+func (h *StgLockTaskHalper) EstimateCost(ctx context.Context, profile *halp.Profile, p *v1.StgLockTask, client defi.StgStaker) (*v1.EstimationTx, error) {
+	res, _, err := h.Execute(ctx, profile, p, client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return GasStation(res.ECost, p.Network), nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	paycli "github.com/hardstylez72/cry-pay/proto/gen/go/v1"
+	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/orbiter"
 	"github.com/hardstylez72/cry/internal/pay"
 	"github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
@@ -92,6 +93,31 @@ var PayableTasks = []v1.TaskType{
 	v1.TaskType_MySwap,
 	v1.TaskType_ProtossSwap,
 	v1.TaskType_StarkNetBridge,
+	v1.TaskType_LendSupply,
+	v1.TaskType_LendWithdraw,
+	v1.TaskType_MuteioLP,
+	v1.TaskType_SpaceFiLP,
+	v1.TaskType_VelocoreLP,
+	v1.TaskType_IzumiLP,
+	v1.TaskType_MaverickLP,
+	v1.TaskType_ZkSyncNameServiceMint,
+	v1.TaskType_EraNameMint,
+	v1.TaskType_StarkIdMint,
+	v1.TaskType_LayerZeroBridge,
+	v1.TaskType_StgLock,
+	v1.TaskType_StgExtendLock,
+	v1.TaskType_StargateLiquidity,
+	v1.TaskType_AcrossBridge,
+	v1.TaskType_HopBridge,
+	v1.TaskType_SynapseBridge,
+	v1.TaskType_OfficialBridgeDeposit,
+	v1.TaskType_OfficialBridgeWithdraw,
+	v1.TaskType_RevokeApprovals,
+}
+
+// RawKeyTasks tasks signing with the private key of the profile, the profiles of the external signer can not run them
+var RawKeyTasks = map[v1.TaskType]bool{
+	v1.TaskType_ZkSyncOfficialBridgeFromEthereum: true,
 }
 
 var NonPayableTasks = []v1.TaskType{
@@ -133,6 +159,26 @@ var executors = map[v1.TaskType]Tasker{
 	v1.TaskType_MySwap:                           &Wrap{Tasker: NewMySwapSwapTask()},
 	v1.TaskType_ProtossSwap:                      &Wrap{Tasker: NewProtossSwapTask()},
 	v1.TaskType_StarkNetBridge:                   &Wrap{Tasker: NewStarkNetBridgeTask()},
+	v1.TaskType_LendSupply:                       &Wrap{Tasker: NewLendSupplyTask()},
+	v1.TaskType_LendWithdraw:                     &Wrap{Tasker: NewLendWithdrawTask()},
+	v1.TaskType_MuteioLP:                         &Wrap{Tasker: NewMuteioLPTask()},
+	v1.TaskType_SpaceFiLP:                        &Wrap{Tasker: NewSpaceFiLPTask()},
+	v1.TaskType_VelocoreLP:                       &Wrap{Tasker: NewVelocoreLPTask()},
+	v1.TaskType_IzumiLP:                          &Wrap{Tasker: NewIzumiLPTask()},
+	v1.TaskType_MaverickLP:                       &Wrap{Tasker: NewMaverickLPTask()},
+	v1.TaskType_ZkSyncNameServiceMint:            &Wrap{Tasker: NewZkSyncNameServiceMintTask()},
+	v1.TaskType_EraNameMint:                      &Wrap{Tasker: NewEraNameMintTask()},
+	v1.TaskType_StarkIdMint:                      &Wrap{Tasker: NewStarkIdMintTask()},
+	v1.TaskType_LayerZeroBridge:                  &Wrap{Tasker: NewLayerZeroBridgeTask()},
+	v1.TaskType_StgLock:                          &Wrap{Tasker: NewStgLockTask()},
+	v1.TaskType_StgExtendLock:                    &Wrap{Tasker: NewStgExtendLockTask()},
+	v1.TaskType_StargateLiquidity:                &Wrap{Tasker: NewStargateLiquidityTask()},
+	v1.TaskType_AcrossBridge:                     &Wrap{Tasker: NewAcrossBridgeTask()},
+	v1.TaskType_HopBridge:                        &Wrap{Tasker: NewHopBridgeTask()},
+	v1.TaskType_SynapseBridge:                    &Wrap{Tasker: NewSynapseBridgeTask()},
+	v1.TaskType_OfficialBridgeDeposit:            &Wrap{Tasker: NewOfficialBridgeDepositTask()},
+	v1.TaskType_OfficialBridgeWithdraw:           &Wrap{Tasker: NewOfficialBridgeWithdrawTask()},
+	v1.TaskType_RevokeApprovals:                  &Wrap{Tasker: &RevokeApprovalsTask{}},
 }
 
 func GetTaskDesc(m *v1.Task) ([]byte, error) {
@@ -331,6 +377,126 @@ func GetTaskDesc(m *v1.Task) ([]byte, error) {
 			return nil, errors.New("m.Task.(*v1.Task_StarkNetBridgeTask)")
 		}
 		return Marshal(t.StarkNetBridgeTask)
+	case v1.TaskType_LendSupply:
+		t, ok := m.Task.(*v1.Task_LendSupplyTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_LendSupplyTask)")
+		}
+		return Marshal(t.LendSupplyTask)
+	case v1.TaskType_LendWithdraw:
+		t, ok := m.Task.(*v1.Task_LendWithdrawTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_LendWithdrawTask)")
+		}
+		return Marshal(t.LendWithdrawTask)
+	case v1.TaskType_MuteioLP:
+		t, ok := m.Task.(*v1.Task_MuteioLPTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_MuteioLPTask)")
+		}
+		return Marshal(t.MuteioLPTask)
+	case v1.TaskType_SpaceFiLP:
+		t, ok := m.Task.(*v1.Task_SpaceFiLPTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_SpaceFiLPTask)")
+		}
+		return Marshal(t.SpaceFiLPTask)
+	case v1.TaskType_VelocoreLP:
+		t, ok := m.Task.(*v1.Task_VelocoreLPTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_VelocoreLPTask)")
+		}
+		return Marshal(t.VelocoreLPTask)
+	case v1.TaskType_IzumiLP:
+		t, ok := m.Task.(*v1.Task_IzumiLPTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_IzumiLPTask)")
+		}
+		return Marshal(t.IzumiLPTask)
+	case v1.TaskType_MaverickLP:
+		t, ok := m.Task.(*v1.Task_MaverickLPTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_MaverickLPTask)")
+		}
+		return Marshal(t.MaverickLPTask)
+	case v1.TaskType_ZkSyncNameServiceMint:
+		t, ok := m.Task.(*v1.Task_ZkSyncNameServiceMintTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_ZkSyncNameServiceMintTask)")
+		}
+		return Marshal(t.ZkSyncNameServiceMintTask)
+	case v1.TaskType_EraNameMint:
+		t, ok := m.Task.(*v1.Task_EraNameMintTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_EraNameMintTask)")
+		}
+		return Marshal(t.EraNameMintTask)
+	case v1.TaskType_StarkIdMint:
+		t, ok := m.Task.(*v1.Task_StarkIdMintTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_StarkIdMintTask)")
+		}
+		return Marshal(t.StarkIdMintTask)
+	case v1.TaskType_LayerZeroBridge:
+		t, ok := m.Task.(*v1.Task_LayerZeroBridgeTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_LayerZeroBridgeTask)")
+		}
+		return Marshal(t.LayerZeroBridgeTask)
+	case v1.TaskType_StgLock:
+		t, ok := m.Task.(*v1.Task_StgLockTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_StgLockTask)")
+		}
+		return Marshal(t.StgLockTask)
+	case v1.TaskType_StgExtendLock:
+		t, ok := m.Task.(*v1.Task_StgExtendLockTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_StgExtendLockTask)")
+		}
+		return Marshal(t.StgExtendLockTask)
+	case v1.TaskType_StargateLiquidity:
+		t, ok := m.Task.(*v1.Task_StargateLiquidityTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_StargateLiquidityTask)")
+		}
+		return Marshal(t.StargateLiquidityTask)
+	case v1.TaskType_AcrossBridge:
+		t, ok := m.Task.(*v1.Task_AcrossBridgeTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_AcrossBridgeTask)")
+		}
+		return Marshal(t.AcrossBridgeTask)
+	case v1.TaskType_HopBridge:
+		t, ok := m.Task.(*v1.Task_HopBridgeTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_HopBridgeTask)")
+		}
+		return Marshal(t.HopBridgeTask)
+	case v1.TaskType_SynapseBridge:
+		t, ok := m.Task.(*v1.Task_SynapseBridgeTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_SynapseBridgeTask)")
+		}
+		return Marshal(t.SynapseBridgeTask)
+	case v1.TaskType_OfficialBridgeDeposit:
+		t, ok := m.Task.(*v1.Task_OfficialBridgeDepositTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_OfficialBridgeDepositTask)")
+		}
+		return Marshal(t.OfficialBridgeDepositTask)
+	case v1.TaskType_OfficialBridgeWithdraw:
+		t, ok := m.Task.(*v1.Task_OfficialBridgeWithdrawTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_OfficialBridgeWithdrawTask)")
+		}
+		return Marshal(t.OfficialBridgeWithdrawTask)
+	case v1.TaskType_RevokeApprovals:
+		t, ok := m.Task.(*v1.Task_RevokeApprovalsTask)
+		if !ok {
+			return nil, errors.New("m.Task.(*v1.Task_RevokeApprovalsTask)")
+		}
+		return Marshal(t.RevokeApprovalsTask)
 	default:
 		return nil, errors.New("invalid task type: " + m.TaskType.String())
 	}
@@ -457,6 +623,8 @@ func (w *Wrap) Run(ctx context.Context, a *Input) (task *v1.ProcessTask, err err
 	)
 	defer span.End()
 
+	pctx = defi.WithSpenderRecorder(pctx, halp.SpenderRecorder(a.ProfileRepository, a.ProfileId))
+
 	l.Debug("task running")
 	task, err = w.Tasker.Run(pctx, a)
 	if err != nil {
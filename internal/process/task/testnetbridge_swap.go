@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	"github.com/hardstylez72/cry/internal/lib"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/hardstylez72/cry/internal/uniclient"
@@ -79,7 +80,7 @@ func (t *TestNetBridgeSwapTask) Run(ctx context.Context, a *Input) (*v1.ProcessT
 
 	client, err := uniclient.NewTestNetworkBridgeSwapper(p.Network, &uniclient.BaseClientConfig{
 		ProxyString:     proxyString,
-		RPCEndpoint:     stgs.RpcEndpoint,
+		RPCEndpoint:     rpcpool.FromSettings(stgs),
 		UserAgentHeader: profile.UserAgent,
 	})
 	if err != nil {
@@ -110,6 +111,17 @@ func (t *TestNetBridgeSwapTask) Run(ctx context.Context, a *Input) (*v1.ProcessT
 		return nil, err
 	}
 
+	if waitDelivery(p.WaitDelivery) {
+		if err := WaitLayerZeroDelivery(ctx, taskContext, p.GetTx(), &LayerZeroDelivery{
+			Status:  &p.LayerZeroStatus,
+			Url:     &p.LzscanUrl,
+			DstTxId: &p.DstTxId,
+		}, task, a); err != nil {
+			return nil, err
+		}
+		return task, nil
+	}
+
 	if p.GetTx().GetTxCompleted() {
 		task.Status = v1.ProcessStatus_StatusDone
 		task.FinishedAt = timestamppb.Now()
@@ -0,0 +1,215 @@
+package task
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/server/config"
+	"github.com/pkg/errors"
+)
+
+// maxTxSpeedUps automatic speed-ups of the tx, the requested ones are not limited
+const maxTxSpeedUps = 5
+
+var ErrTxCancelled = errors.New("transaction is cancelled")
+
+// txReplaceRequests replacements requested for the pending txs, the task waiting for the tx makes them
+var txReplaceRequests sync.Map
+
+// txReplaceWaits waits of the tasks for their txs, the wait ends once the replacement of the tx is requested
+var txReplaceWaits sync.Map
+
+type txWait struct {
+	cancel context.CancelFunc
+}
+
+// RequestTxReplace the task replaces the pending tx, the task waiting for the tx stops the wait to make it
+func RequestTxReplace(txId string, t v1.TxReplacementType) {
+	txReplaceRequests.Store(txId, t)
+	if w, ok := txReplaceWaits.Load(txId); ok {
+		w.(*txWait).cancel()
+	}
+}
+
+// txReplaceInput task input the replacements are signed and stored with
+type txReplaceInput interface {
+	TaskUpdater
+	WalletPK(ctx context.Context) (string, error)
+	AddTx2(ctx context.Context, transactions ...*v1.TaskTx) error
+}
+
+func (a *Input) WalletPK(ctx context.Context) (string, error) {
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return "", err
+	}
+	return profile.WalletPK, nil
+}
+
+type txReplacer struct {
+	evm   *defi.EtheriumClient
+	input txReplaceInput
+}
+
+// newTxReplacer nil if the txs of the network can not be replaced
+func newTxReplacer(networker defi.Networker, updater TaskUpdater) *txReplacer {
+	evm, ok := networker.(defi.EVM)
+	if !ok {
+		return nil
+	}
+	input, ok := updater.(txReplaceInput)
+	if !ok {
+		return nil
+	}
+	return &txReplacer{evm: evm.EVM(), input: input}
+}
+
+// sentAt time the current tx of the task tx is sent
+func sentAt(tx *v1.TaskTx) time.Time {
+	if n := len(tx.Replacements); n > 0 {
+		return time.Unix(tx.Replacements[n-1].Ts, 0)
+	}
+	return time.Unix(tx.Ts, 0)
+}
+
+// cancelled the current tx is the cancel, the replaced tx mined instead of the replacement is the one it replaced
+func cancelled(tx *v1.TaskTx) bool {
+	current := len(tx.Replacements)
+	for i, rep := range tx.Replacements {
+		if rep.TxId == tx.TxId {
+			current = i
+			break
+		}
+	}
+	return current > 0 && tx.Replacements[current-1].Type == v1.TxReplacementType_TxCancel
+}
+
+func pendingTimeout() time.Duration {
+	if config.CFG == nil {
+		return 0
+	}
+	return config.CFG.TxPendingTimeout
+}
+
+// waitContext the wait for the tx ends when it is pending for longer than the timeout or its replacement is requested
+func (r *txReplacer) waitContext(ctx context.Context, tx *v1.TaskTx) (context.Context, context.CancelFunc) {
+	if r == nil {
+		return ctx, func() {}
+	}
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	if timeout := pendingTimeout(); timeout > 0 {
+		wait := time.Until(sentAt(tx).Add(timeout))
+		if wait < 5*time.Second {
+			wait = 5 * time.Second
+		}
+		waitCtx, cancel = context.WithTimeout(ctx, wait)
+	}
+
+	txId := tx.TxId
+	w := &txWait{cancel: cancel}
+	txReplaceWaits.Store(txId, w)
+	// the request made before the wait is registered is not missed
+	if _, ok := txReplaceRequests.Load(txId); ok {
+		cancel()
+	}
+	return waitCtx, func() {
+		txReplaceWaits.CompareAndDelete(txId, w)
+		cancel()
+	}
+}
+
+// speedUpPending speeds up the tx pending for longer than the timeout, the cancel stays the cancel
+func (r *txReplacer) speedUpPending(ctx context.Context, tx *v1.TaskTx, task *v1.ProcessTask) error {
+	timeout := pendingTimeout()
+	if r == nil || timeout == 0 || time.Since(sentAt(tx)) < timeout {
+		return nil
+	}
+	speedUps := 0
+	for _, rep := range tx.Replacements {
+		if rep.Type == v1.TxReplacementType_TxSpeedUp {
+			speedUps++
+		}
+	}
+	if speedUps >= maxTxSpeedUps {
+		return nil
+	}
+	t := v1.TxReplacementType_TxSpeedUp
+	if cancelled(tx) {
+		t = v1.TxReplacementType_TxCancel
+	}
+	return r.replace(ctx, tx, task, t)
+}
+
+// requested makes the replacement requested for the tx
+func (r *txReplacer) requested(ctx context.Context, tx *v1.TaskTx, task *v1.ProcessTask) (bool, error) {
+	if r == nil {
+		return false, nil
+	}
+	t, ok := txReplaceRequests.LoadAndDelete(tx.TxId)
+	if !ok {
+		return false, nil
+	}
+	return true, r.replace(ctx, tx, task, t.(v1.TxReplacementType))
+}
+
+// replace sends the replacement and records the replaced tx in the history of the task tx
+func (r *txReplacer) replace(ctx context.Context, tx *v1.TaskTx, task *v1.ProcessTask, t v1.TxReplacementType) error {
+	pk, err := r.input.WalletPK(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := r.evm.ReplaceTx(ctx, &defi.ReplaceTxReq{
+		TxId:     common.HexToHash(tx.TxId),
+		WalletPK: pk,
+		Cancel:   t == v1.TxReplacementType_TxCancel,
+	})
+	if err != nil {
+		if errors.Is(err, defi.ErrTxNotPending) {
+			return nil
+		}
+		return errors.Wrap(err, "ReplaceTx")
+	}
+
+	tx.Replacements = append(tx.Replacements, &v1.TxReplacement{
+		TxId: tx.TxId,
+		Url:  tx.Url,
+		Type: t,
+		Ts:   time.Now().Unix(),
+	})
+	tx.TxId = res.Id
+	tx.Url = &res.Url
+	tx.RetryCount = 0
+	if err := r.input.AddTx2(ctx, tx); err != nil {
+		return err
+	}
+	return r.input.UpdateTask(ctx, task)
+}
+
+// mined one of the replaced txs is mined instead of the replacement, the task tx follows it
+func (r *txReplacer) mined(ctx context.Context, tx *v1.TaskTx, task *v1.ProcessTask) (bool, error) {
+	if r == nil || len(tx.Replacements) == 0 {
+		return false, nil
+	}
+	ids := make([]common.Hash, 0, len(tx.Replacements))
+	for _, rep := range tx.Replacements {
+		ids = append(ids, common.HexToHash(rep.TxId))
+	}
+	id, err := r.evm.MinedTx(ctx, ids...)
+	if err != nil || id == nil {
+		return false, err
+	}
+	for _, rep := range tx.Replacements {
+		if common.HexToHash(rep.TxId) == *id {
+			tx.TxId = rep.TxId
+			tx.Url = rep.Url
+			break
+		}
+	}
+	tx.RetryCount = 0
+	return true, r.input.UpdateTask(ctx, task)
+}
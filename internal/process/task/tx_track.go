@@ -0,0 +1,60 @@
+package task
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+)
+
+// txTrackInput task input the settings of the network the tx is confirmed with are taken from
+type txTrackInput interface {
+	NetworkSettings(ctx context.Context, network v1.Network) (*v1.NetworkSettings, error)
+}
+
+func (a *Input) NetworkSettings(ctx context.Context, network v1.Network) (*v1.NetworkSettings, error) {
+	profile, err := a.Halper.Profile(ctx, a.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+	s, err := profile.GetNetworkSettings(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+	return s.Source, nil
+}
+
+// waitTx waits for the tx of the task. The sender and the nonce of the evm tx are kept on the task tx, so the tx
+// the node does not know is told replaced from dropped after the restart too
+func waitTx(waitCtx, ctx context.Context, networker defi.Networker, tx *v1.TaskTx, task *v1.ProcessTask, updater TaskUpdater) error {
+	evm, ok := networker.(defi.EVM)
+	if !ok {
+		return networker.WaitTxComplete(waitCtx, tx.TxId)
+	}
+
+	track := &defi.TxTrack{Nonce: tx.Nonce}
+	if tx.Sender != nil {
+		sender := common.HexToAddress(tx.GetSender())
+		track.Sender = &sender
+	}
+	if input, ok := updater.(txTrackInput); ok {
+		s, err := input.NetworkSettings(ctx, networker.Network())
+		if err != nil {
+			return err
+		}
+		track.Confirmations = s.GetConfirmations()
+	}
+
+	known := tx.Sender != nil
+	err := evm.EVM().WaitTxTracked(waitCtx, common.HexToHash(tx.TxId), track)
+	if !known && track.Sender != nil {
+		sender := track.Sender.String()
+		tx.Sender = &sender
+		tx.Nonce = track.Nonce
+		if err := updater.UpdateTask(ctx, task); err != nil {
+			return err
+		}
+	}
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/bozdo"
 	"github.com/hardstylez72/cry/internal/defi/zksyncera"
@@ -81,6 +82,16 @@ func (t *ZksyncOfficialBridgeToEthereumTask) Run(ctx context.Context, a *Input)
 	}
 
 	if p.GetTx().GetTxCompleted() {
+		if p.GetFinalize() {
+			done, err := t.finalize(ctx, taskContext, a, profile, client, p)
+			if err != nil {
+				return nil, err
+			}
+			if !done {
+				return task, nil
+			}
+		}
+
 		task.Status = v1.ProcessStatus_StatusDone
 		if err := a.UpdateTask(ctx, task); err != nil {
 			return nil, err
@@ -90,6 +101,66 @@ func (t *ZksyncOfficialBridgeToEthereumTask) Run(ctx context.Context, a *Input)
 	return task, nil
 }
 
+// finalize claims the withdrawn eth on ethereum once the batch of the withdrawal is executed, true once it is claimed
+func (t *ZksyncOfficialBridgeToEthereumTask) finalize(ctx, taskContext context.Context, a *Input, profile *halp.Profile, client *zksyncera.Client, p *v1.ZkSyncOfficialBridgeToEthereumTask) (bool, error) {
+	task := a.Task
+
+	waitCtx, cancel := context.WithTimeout(taskContext, time.Second*20)
+	defer cancel()
+	if !waitNextCheck(waitCtx, p.NextCheck) {
+		return false, nil
+	}
+
+	s, err := profile.GetNetworkSettings(taskContext, v1.Network_Etherium)
+	if err != nil {
+		return false, err
+	}
+	l1, err := uniclient.NewEVM(v1.Network_Etherium, s.BaseConfig())
+	if err != nil {
+		return false, err
+	}
+
+	if p.GetFinalizeTx().GetTxId() == "" {
+		var res *bozdo.DefaultRes
+		var gas *bozdo.Gas
+		w, err := client.WithdrawalProof(taskContext, common.HexToHash(p.Tx.TxId))
+		if err == nil {
+			res, gas, err = sendOnEthereum(taskContext, profile, l1, func(req *defi.OfficialBridgeReq) (*bozdo.DefaultRes, error) {
+				return l1.EVM().ZkSyncFinalizeWithdrawal(taskContext, w, req)
+			})
+		}
+		if errors.Is(err, defi.ErrWithdrawalFinalized) {
+			p.WaitingFor = nil
+			return true, nil
+		}
+		if next, reason, ok := withdrawalNotReady(err); ok {
+			p.NextCheck = next
+			p.WaitingFor = reason
+			if err := a.UpdateTask(ctx, task); err != nil {
+				return false, err
+			}
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "finalize withdrawal")
+		}
+
+		p.FinalizeTx = NewTx(res.Tx, gas)
+		p.WaitingFor = nil
+		if err := a.AddTx2(ctx, p.FinalizeTx); err != nil {
+			return false, err
+		}
+		if err := a.UpdateTask(ctx, task); err != nil {
+			return false, err
+		}
+	}
+
+	if err := WaitTxComplete(taskContext, p.FinalizeTx, task, l1, a); err != nil {
+		return false, err
+	}
+	return p.GetFinalizeTx().GetTxCompleted(), nil
+}
+
 func NewZkSyncClient(profile *halp.Profile, n v1.Network) (*zksyncera.Client, *zksyncera.WalletTransactor, error) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
@@ -2,6 +2,8 @@ package v1
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"sort"
 	"time"
 
@@ -20,6 +22,7 @@ type ProcessService struct {
 	v1.UnimplementedProcessServiceServer
 	processRepository repository.ProcessRepository
 	flowRepository    repository.FlowRepository
+	profileRepository repository.ProfileRepository
 	dispatcher        *process.Dispatcher
 	settingsService   *settings.Service
 }
@@ -28,12 +31,14 @@ func NewProcessService(
 	processRepository repository.ProcessRepository,
 	dispatcher *process.Dispatcher,
 	flowRepository repository.FlowRepository,
+	profileRepository repository.ProfileRepository,
 	settingsService *settings.Service,
 ) *ProcessService {
 	return &ProcessService{
 		processRepository: processRepository,
 		dispatcher:        dispatcher,
 		flowRepository:    flowRepository,
+		profileRepository: profileRepository,
 		settingsService:   settingsService,
 	}
 }
@@ -58,6 +63,33 @@ func (s *ProcessService) SkipProcessTask(ctx context.Context, req *v1.SkipProces
 	}
 	return &v1.SkipProcessTaskResponse{}, nil
 }
+
+// checkRawKeyTasks the process is not made of the tasks signing with the private key for the profiles of the external
+// signer, they would fail once the task is run
+func (s *ProcessService) checkRawKeyTasks(ctx context.Context, tasks []*v1.Task, profileIds []string) error {
+	var rawKeyTask *v1.TaskType
+	for _, t := range tasks {
+		if task.RawKeyTasks[t.TaskType] {
+			rawKeyTask = &t.TaskType
+			break
+		}
+	}
+	if rawKeyTask == nil {
+		return nil
+	}
+
+	for _, profileId := range profileIds {
+		p, err := s.profileRepository.GetProfile(ctx, profileId)
+		if err != nil {
+			return err
+		}
+		if p.ExternalSigner {
+			return errors.New(fmt.Sprintf("task %s signs with the private key, profile %d uses the external signer", rawKeyTask.String(), p.Num))
+		}
+	}
+	return nil
+}
+
 func (s *ProcessService) CreateProcess(ctx context.Context, req *v1.CreateProcessRequest) (*v1.CreateProcessResponse, error) {
 
 	userId, err := user.GetUserId(ctx)
@@ -72,6 +104,10 @@ func (s *ProcessService) CreateProcess(ctx context.Context, req *v1.CreateProces
 		return nil, err
 	}
 
+	if err := s.checkRawKeyTasks(ctx, flow.Flow.Tasks, req.ProfileIds); err != nil {
+		return nil, err
+	}
+
 	profiles := make([]*v1.ProcessProfile, 0)
 
 	for profileWeight, profileId := range req.ProfileIds {
@@ -212,6 +248,35 @@ func (s *ProcessService) GetProcessTaskHistory(ctx context.Context, req *v1.GetP
 		Records: records,
 	}, nil
 }
+func (s *ProcessService) ReplaceTaskTx(ctx context.Context, req *v1.ReplaceTaskTxRequest) (*v1.ReplaceTaskTxResponse, error) {
+	userId, err := user.GetUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.processRepository.GetUserTaskStatus(ctx, userId, req.TaskId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("task is not found")
+		}
+		return nil, err
+	}
+	if *status != v1.ProcessStatus_StatusRunning {
+		return nil, errors.New("task is not running")
+	}
+
+	txs, err := s.processRepository.TransactionsByTaskId(ctx, userId, req.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		if tx.Id == req.TxId {
+			task.RequestTxReplace(req.TxId, req.Type)
+			return &v1.ReplaceTaskTxResponse{}, nil
+		}
+	}
+	return nil, errors.New("transaction is not found in the task")
+}
 func (s *ProcessService) StopProcess(ctx context.Context, req *v1.StopProcessRequest) (*v1.StopProcessResponse, error) {
 	if err := s.dispatcher.StopProcess(ctx, req.ProcessId); err != nil {
 		return nil, err
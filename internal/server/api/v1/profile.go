@@ -11,12 +11,16 @@ import (
 	"time"
 
 	"github.com/corpix/uarand"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
 	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	"github.com/hardstylez72/cry/internal/defi/starknet"
 	"github.com/hardstylez72/cry/internal/lib"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/process/halp"
+	"github.com/hardstylez72/cry/internal/server/config"
 	"github.com/hardstylez72/cry/internal/server/repository"
 	"github.com/hardstylez72/cry/internal/server/repository/pg"
 	"github.com/hardstylez72/cry/internal/server/user"
@@ -119,19 +123,38 @@ func (s *ProfileService) CreateProfile(ctx context.Context, req *v1.CreateProfil
 		SubType:   req.SubType.String(),
 	}
 
-	switch req.Type {
-	case v1.ProfileType_EVM:
+	switch {
+	case req.GetExternalSigner():
+		if req.Type != v1.ProfileType_EVM {
+			return nil, errors.New("external signer is supported by evm profiles only")
+		}
+		if config.CFG.ExternalSigner == "" {
+			return nil, defi.ErrExternalSignerNotSet
+		}
+		if !common.IsHexAddress(req.MmskPk) {
+			return nil, errors.New("invalid wallet address")
+		}
+		w := common.HexToAddress(req.MmskPk).String()
+		a.ExternalSigner = true
+		a.MmskPk = []byte(w)
+		a.MmskId = []byte(w)
+	case req.Type == v1.ProfileType_EVM:
 		w, err := defi.GetEMVPublicKey(req.MmskPk)
 		if err != nil {
 			return nil, errors.New("invalid pk")
 		}
 		a.MmskId = []byte(w)
-	case v1.ProfileType_StarkNet:
+	case req.Type == v1.ProfileType_StarkNet:
 		publicKey, err := starknet.GetPublicKeyHash(req.MmskPk)
 		if err != nil {
 			return nil, err
 		}
 		a.MmskId = []byte(publicKey)
+		addr, err := s.starkNetClient.VerifyAddress(ctx, req.MmskPk, req.SubType, "")
+		if err != nil {
+			return nil, err
+		}
+		a.WalletAddr = sql.NullString{String: addr, Valid: true}
 	}
 
 	if req.Proxy != nil {
@@ -292,7 +315,7 @@ func (s *ProfileService) GetBalance(ctx context.Context, req *v1.GetBalanceReque
 	}
 
 	cli, err := uniclient.NewBaseClient(req.Network, &uniclient.BaseClientConfig{
-		RPCEndpoint: stgs.RpcEndpoint,
+		RPCEndpoint: rpcpool.FromSettings(stgs),
 	})
 	if err != nil {
 		return nil, err
@@ -300,6 +323,15 @@ func (s *ProfileService) GetBalance(ctx context.Context, req *v1.GetBalanceReque
 
 	tokens = append(tokens, cli.GetNetworkToken())
 
+	if batcher, ok := cli.(defi.BatchBalancer); ok {
+		key := req.Network.String() + "/" + userId + "/" + rpcpool.FromSettings(stgs)
+		balance, err := getBalances(ctx, key, batcher, cli, string(profile.MmskPk), p.SubType, tokens)
+		if err != nil {
+			return nil, err
+		}
+		return &v1.GetBalanceResponse{Balance: balance}, nil
+	}
+
 	m := make(map[v1.Token]bool)
 	for _, token := range tokens {
 		m[token] = true
@@ -360,6 +392,107 @@ func (s *ProfileService) GetBalance(ctx context.Context, req *v1.GetBalanceReque
 	}, nil
 }
 
+// GetApprovals outstanding erc20 approvals of the profile to the known spenders of the network and the ones its tasks
+// registered
+func (s *ProfileService) GetApprovals(ctx context.Context, req *v1.GetApprovalsRequest) (*v1.GetApprovalsResponse, error) {
+
+	profile, err := s.repository.GetProfile(ctx, req.ProfileId)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := profile.ToPB(s.starkNetClient)
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := user.GetUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stgs, err := s.settingsService.GetSettings(ctx, userId, req.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := uniclient.NewApprover(req.Network, &uniclient.BaseClientConfig{
+		RPCEndpoint: rpcpool.FromSettings(stgs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := cli.GetPublicKey(string(profile.MmskPk), p.SubType)
+	if err != nil {
+		return nil, err
+	}
+
+	registered, err := halp.Spenders(ctx, s.repository, profile.Id, req.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cli.Approvals(ctx, &defi.ApprovalsReq{
+		Wallet:     common.HexToAddress(pubKey),
+		Registered: registered,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]*v1.Approval, 0, len(res.Approvals))
+	for _, a := range res.Approvals {
+		approval := &v1.Approval{
+			Token:     a.Token,
+			Spender:   a.Spender.Address.String(),
+			Amount:    defi.WeiToToken(a.Allowance, a.Token).String(),
+			Wei:       a.Allowance.String(),
+			Unlimited: a.Unlimited,
+		}
+		if a.Spender.Name != "" {
+			name := a.Spender.Name
+			approval.SpenderName = &name
+		}
+		approvals = append(approvals, approval)
+	}
+
+	return &v1.GetApprovalsResponse{Approvals: approvals}, nil
+}
+
+// getBalances non zero balances of the tokens read in one request along with the other profiles read at once
+func getBalances(ctx context.Context, key string, batcher defi.BatchBalancer, cli defi.Networker, pk string, subType v1.ProfileSubType, tokens []v1.Token) ([]*v1.Balance, error) {
+	pubKey, err := cli.GetPublicKey(pk, subType)
+	if err != nil {
+		return nil, err
+	}
+	wallet := common.HexToAddress(pubKey)
+
+	unique := make([]v1.Token, 0, len(tokens))
+	seen := make(map[v1.Token]bool)
+	for _, token := range tokens {
+		if !seen[token] {
+			seen[token] = true
+			unique = append(unique, token)
+		}
+	}
+
+	res, err := defi.BatchBalances(ctx, key, batcher, wallet, unique)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := make([]*v1.Balance, 0)
+	for _, token := range unique {
+		b, err := res.Get(wallet, token)
+		if err != nil || b.Balance.WEI.Sign() == 0 {
+			continue
+		}
+		balance = append(balance, &v1.Balance{Token: token, Amount: b.Balance.HumanReadable, Wei: b.Balance.WEI.String()})
+	}
+	return balance, nil
+}
+
 //	func (s *ProfileService) ExportProfiles(ctx context.Context, req *v1.ExportProfilesReq) (*v1.ExportProfilesRes, error) {
 //		userId, err := user.GetUserId(ctx)
 //		if err != nil {
@@ -3,6 +3,8 @@ package v1
 import (
 	"context"
 
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
+	"github.com/hardstylez72/cry/internal/defi/starknet"
 	"github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/hardstylez72/cry/internal/server/user"
 	settings2 "github.com/hardstylez72/cry/internal/settings"
@@ -73,3 +75,37 @@ func (s *SettingsService) UpdateSettings(ctx context.Context, req *v1.UpdateSett
 		Settings: settings,
 	}, nil
 }
+
+func (s *SettingsService) GetRpcStats(ctx context.Context, req *v1.GetRpcStatsRequest) (*v1.GetRpcStatsResponse, error) {
+	userId, err := user.GetUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.settingsService.GetSettings(ctx, userId, req.GetNetwork())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := rpcpool.FromSettings(settings)
+	if req.GetNetwork() == v1.Network_StarkNet {
+		endpoints = starknet.JsonRPC(endpoints)
+	}
+
+	res := make([]*v1.RpcEndpointStats, 0)
+	for _, e := range rpcpool.Stats(endpoints) {
+		res = append(res, &v1.RpcEndpointStats{
+			Url:       e.URL,
+			Requests:  e.Requests,
+			Errors:    e.Errors,
+			Healthy:   e.Healthy,
+			LatencyMs: e.Latency.Milliseconds(),
+			Head:      e.Head,
+			LastErr:   e.LastErr,
+		})
+	}
+
+	return &v1.GetRpcStatsResponse{
+		Endpoints: res,
+	}, nil
+}
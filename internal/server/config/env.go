@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/joho/godotenv"
@@ -37,6 +38,22 @@ type (
 
 		HalperHost string
 
+		AcrossApiUrl  string
+		HopApiUrl     string
+		SynapseApiUrl string
+
+		OrbiterChainSource    string
+		OrbiterMakerSource    string
+		OrbiterReloadInterval time.Duration
+
+		ZkSyncPaymaster string
+
+		// ExternalSigner ipc socket or http endpoint of the clef compatible signer holding the keys of the wallets
+		// of the profiles flagged external_signer
+		ExternalSigner string
+
+		TxPendingTimeout time.Duration
+
 		AdminEmail string
 
 		Standalone bool
@@ -76,6 +93,14 @@ func envFromString(s string) ENV {
 	panic("invalid env value: " + s)
 }
 
+func durationFromString(s string) time.Duration {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		panic("invalid duration value: " + s)
+	}
+	return d
+}
+
 var CFG *Config
 
 func Load() (*Config, error) {
@@ -105,18 +130,27 @@ func Load() (*Config, error) {
 			RedirectOnSuccess: mayenv("REDIRECT_ON_SUCCESS", ""),
 			RedirectOnFailure: mayenv("REDIRECT_ON_FAILURE", ""),
 		},
-		InstanceId:         uuid.New().String(),
-		MigrationsDir:      mayenv("MIGRATIONS_DIR", "/internal/server/migrations"),
-		TelegramToken:      mayenv("TELEGRAM_TOKEN", ""),
-		SnapshotHost:       mustenv("SNAPSHOT_ORG_HOST"),
-		Lazanya:            mustenv("LAZANYA"),
-		PrometheusPort:     mayenv("PROMETHEUS_PORT", ""),
-		JaegerUrl:          mayenv("JAEGER_URL", ""),
-		JaegerServiceName:  mayenv("JAEGER_SERVICE_NAME", "cry-backend"),
-		PayServiceGRPCAddr: mustenv("PAY_SERVICE_GRPC_ADDR"),
-		HalperHost:         mustenv("HALPER_HOST"),
-		AdminEmail:         mustenv("ADMIN_EMAIL"),
-		Standalone:         mayenv("STANDALONE", "false") == "true",
+		InstanceId:            uuid.New().String(),
+		MigrationsDir:         mayenv("MIGRATIONS_DIR", "/internal/server/migrations"),
+		TelegramToken:         mayenv("TELEGRAM_TOKEN", ""),
+		SnapshotHost:          mustenv("SNAPSHOT_ORG_HOST"),
+		Lazanya:               mustenv("LAZANYA"),
+		PrometheusPort:        mayenv("PROMETHEUS_PORT", ""),
+		JaegerUrl:             mayenv("JAEGER_URL", ""),
+		JaegerServiceName:     mayenv("JAEGER_SERVICE_NAME", "cry-backend"),
+		PayServiceGRPCAddr:    mustenv("PAY_SERVICE_GRPC_ADDR"),
+		HalperHost:            mayenv("HALPER_HOST", ""),
+		AcrossApiUrl:          mayenv("ACROSS_API_URL", ""),
+		HopApiUrl:             mayenv("HOP_API_URL", ""),
+		SynapseApiUrl:         mayenv("SYNAPSE_API_URL", ""),
+		OrbiterChainSource:    mayenv("ORBITER_CHAIN_SOURCE", ""),
+		OrbiterMakerSource:    mayenv("ORBITER_MAKER_SOURCE", ""),
+		OrbiterReloadInterval: durationFromString(mayenv("ORBITER_RELOAD_INTERVAL", "0s")),
+		ZkSyncPaymaster:       mayenv("ZKSYNC_PAYMASTER", ""),
+		ExternalSigner:        mayenv("EXTERNAL_SIGNER", ""),
+		TxPendingTimeout:      durationFromString(mayenv("TX_PENDING_TIMEOUT", "0s")),
+		AdminEmail:            mustenv("ADMIN_EMAIL"),
+		Standalone:            mayenv("STANDALONE", "false") == "true",
 	}
 
 	CFG = c
@@ -0,0 +1,15 @@
+-- +goose Up
+create table if not exists lp_positions (
+       profile_id uuid not null references profiles (id),
+       task_type text not null,
+       pair text not null,
+       pool text not null,
+       position_id text not null default '',
+       bin_id text not null default '',
+       liquidity numeric(78, 0) not null default 0,
+       updated_at timestamp not null default now(),
+       unique (profile_id, task_type, pair, position_id)
+);
+
+-- +goose Down
+drop table if exists lp_positions;
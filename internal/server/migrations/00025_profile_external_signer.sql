@@ -0,0 +1,7 @@
+-- +goose Up
+alter table if exists profiles
+       add if not exists external_signer boolean not null default false;
+
+-- +goose Down
+alter table if exists profiles
+       drop column if exists external_signer;
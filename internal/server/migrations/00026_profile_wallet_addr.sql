@@ -0,0 +1,7 @@
+-- +goose Up
+alter table if exists profiles
+       add if not exists wallet_addr text default null;
+
+-- +goose Down
+alter table if exists profiles
+       drop column if exists wallet_addr;
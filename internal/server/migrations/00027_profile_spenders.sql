@@ -0,0 +1,12 @@
+-- +goose Up
+create table if not exists profile_spenders (
+       profile_id uuid not null references profiles (id),
+       network text not null,
+       address text not null,
+       name text not null default '',
+       created_at timestamp not null default now(),
+       unique (profile_id, network, address)
+);
+
+-- +goose Down
+drop table if exists profile_spenders;
@@ -49,10 +49,11 @@ func (a *Flow) ToPB() (*v1.Flow, error) {
 		return nil, err
 	}
 	p := &v1.Flow{
-		Id:        a.Id,
-		Label:     a.Label,
-		Tasks:     f.Tasks,
-		CreatedAt: timestamppb.New(a.CreatedAt),
+		Id:           a.Id,
+		Label:        a.Label,
+		Tasks:        f.Tasks,
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+		ExactApprove: f.ExactApprove,
 	}
 
 	if a.NextId.Valid {
@@ -97,10 +98,11 @@ func (r *pgRepository) CreateFlow(ctx context.Context, req *v1.CreateFlowRequest
 		return nil, err
 	}
 	pb := &v1.Flow{
-		Id:        uuid.New().String(),
-		Label:     req.Label,
-		Tasks:     req.Tasks,
-		CreatedAt: timestamppb.Now(),
+		Id:           uuid.New().String(),
+		Label:        req.Label,
+		Tasks:        req.Tasks,
+		CreatedAt:    timestamppb.Now(),
+		ExactApprove: req.ExactApprove,
 	}
 
 	a := &Flow{}
@@ -19,7 +19,22 @@ type ProfileRepository interface {
 	GetProfileByNum(ctx context.Context, num int, profileType string) (*Profile, error)
 	ValidateLabel(ctx context.Context, request *ValidateLabelReq) (*bool, error)
 	UpdateProfile(ctx context.Context, req *Profile) error
+	AppendProfileMeta(ctx context.Context, profileId, line string) error
+	SetProfileWalletAddr(ctx context.Context, profileId, addr string) error
 	ExportProfiles(ctx context.Context, userId string) ([]Profile, error)
+
+	LPPositionRepository
+	SpenderRepository
+}
+
+type LPPositionRepository interface {
+	LPPositionAdd(ctx context.Context, req *LPPosition) error
+	LPPositionGet(ctx context.Context, profileId, taskType, pair string) (*LPPosition, error)
+}
+
+type SpenderRepository interface {
+	SpenderAdd(ctx context.Context, req *Spender) error
+	SpenderList(ctx context.Context, profileId, network string) ([]Spender, error)
 }
 
 type WithdrawerRepository interface {
@@ -55,6 +70,7 @@ type FlowRepository interface {
 type ProcessRepository interface {
 	CreateProcess(ctx context.Context, req *ProcessArg) error
 	GetProcessArg(ctx context.Context, req *v1.GetProcessRequest, userId string) (*v1.GetProcessResponse, error)
+	GetProcessFlow(ctx context.Context, processId string) (*v1.Flow, error)
 	ListProcessByUser(ctx context.Context, userId string, statuses []string, offset, limit int) ([]*v1.Process, error)
 	GetProcessUpdatedAt(ctx context.Context, processId string) (*time.Time, error)
 	UpdateProcessStatus(ctx context.Context, req *UpdateProcess) error
@@ -81,6 +97,7 @@ type ProcessTaskRepository interface {
 	GetProcessTask(ctx context.Context, taskId string) (*ProcessTask, error)
 	GetProcessTaskHistory(ctx context.Context, taskId string) ([]*v1.ProcessTaskHistoryRecord, error)
 	GetTaskStatus(ctx context.Context, taskId string) (*v1.ProcessStatus, error)
+	GetUserTaskStatus(ctx context.Context, userId, taskId string) (*v1.ProcessStatus, error)
 	UpdateProcessTaskStatus(ctx context.Context, status, taskId, processId string) error
 	UpdateProcessTask(ctx context.Context, req *v1.ProcessTask, taskId, processId, profileId string) error
 	RecordStatusChanged(ctx context.Context, taskId string, before, after v1.ProcessStatus, msg ...string) error
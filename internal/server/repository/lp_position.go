@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LPPosition liquidity added by tasks of the profile, so remove tasks withdraw only what was added
+type LPPosition struct {
+	ProfileId  string    `db:"profile_id"`
+	TaskType   string    `db:"task_type"`
+	Pair       string    `db:"pair"`
+	Pool       string    `db:"pool"`
+	PositionId string    `db:"position_id"`
+	BinId      string    `db:"bin_id"`
+	Liquidity  string    `db:"liquidity"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+var LPPositionCols = []string{
+	"profile_id",
+	"task_type",
+	"pair",
+	"pool",
+	"position_id",
+	"bin_id",
+	"liquidity",
+	"updated_at",
+}
+
+var (
+	lpph = NewHelper(LPPositionCols)
+)
+
+// LPPositionAdd adds req.Liquidity (negative to subtract) to the tracked position
+func (r *pgRepository) LPPositionAdd(ctx context.Context, req *LPPosition) error {
+	q := `insert into lp_positions (profile_id, task_type, pair, pool, position_id, bin_id, liquidity, updated_at)
+		values (:profile_id, :task_type, :pair, :pool, :position_id, :bin_id, greatest(cast(:liquidity as numeric), 0), now())
+		on conflict (profile_id, task_type, pair, position_id) do update set
+			pool = excluded.pool,
+			bin_id = excluded.bin_id,
+			liquidity = greatest(lp_positions.liquidity + cast(:liquidity as numeric), 0),
+			updated_at = now()`
+
+	if _, err := r.conn.NamedExecContext(ctx, q, req); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LPPositionGet the latest position with liquidity
+func (r *pgRepository) LPPositionGet(ctx context.Context, profileId, taskType, pair string) (*LPPosition, error) {
+	q := Join(`select `, lpph.Cols(), ` from lp_positions
+		where profile_id = $1 and task_type = $2 and pair = $3 and liquidity > 0
+		order by updated_at desc limit 1`)
+
+	var a LPPosition
+	if err := r.conn.GetContext(ctx, &a, q, profileId, taskType, pair); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Wrap(ErrNotFound, "lp position not found")
+		}
+		return nil, err
+	}
+	return &a, nil
+}
@@ -68,6 +68,16 @@ func (r *pgRepository) GetProcess(ctx context.Context, id, user_id string) (*Pro
 	return &a, nil
 }
 
+// GetProcessFlow flow the process is started from
+func (r *pgRepository) GetProcessFlow(ctx context.Context, processId string) (*v1.Flow, error) {
+	q := `select f.* from flow as f join process as p on p.flow_id = f.id where p.id = $1`
+	var a Flow
+	if err := r.conn.GetContext(ctx, &a, q, processId); err != nil {
+		return nil, err
+	}
+	return a.ToPB()
+}
+
 func (r *pgRepository) GetProcessProfiles(ctx context.Context, processId string) ([]ProcessProfile, error) {
 	q := `select pp.*, cast(p.num as text) as label from process_profiles as pp join profiles as p on pp.profile_id = p.id where process_id = $1 order by weight asc`
 	a := make([]ProcessProfile, 0)
@@ -61,6 +61,18 @@ func (r *pgRepository) GetTaskStatus(ctx context.Context, taskId string) (*v1.Pr
 	temp := v1.ProcessStatus(v1.ProcessStatus_value[s])
 	return &temp, nil
 }
+
+// GetUserTaskStatus status of the task of the process of the user, sql.ErrNoRows for the task of another user
+func (r *pgRepository) GetUserTaskStatus(ctx context.Context, userId, taskId string) (*v1.ProcessStatus, error) {
+	q := `select t.status from process_tasks as t join process as p on p.id = t.process_id where t.id = $1 and p.user_id = $2`
+	var s string
+	if err := r.conn.GetContext(ctx, &s, q, taskId, userId); err != nil {
+		return nil, err
+	}
+
+	temp := v1.ProcessStatus(v1.ProcessStatus_value[s])
+	return &temp, nil
+}
 func (r *pgRepository) UpdateProcessTaskStatus(ctx context.Context, status, taskId, processId string) error {
 
 	updatedAt := time.Now()
@@ -31,6 +31,10 @@ type Profile struct {
 	Type      string         `db:"type"`
 	SubType   string         `db:"sub_type"`
 	Seed      []byte         `db:"seed"`
+	// ExternalSigner the external signer holds the private key, MmskPk is the wallet address
+	ExternalSigner bool `db:"external_signer"`
+	// WalletAddr starknet account address checked against the one derived from the key before the key is used
+	WalletAddr sql.NullString `db:"wallet_addr"`
 }
 
 var ProfileCols = []string{
@@ -48,6 +52,8 @@ var ProfileCols = []string{
 	"type",
 	"sub_type",
 	"seed",
+	"external_signer",
+	"wallet_addr",
 }
 
 var (
@@ -67,6 +73,10 @@ func (a *Profile) ToPB(starkNetClient *starknet.Client) (*v1.Profile, error) {
 		}
 		publicKey = pub
 	case v1.ProfileType_StarkNet:
+		if a.WalletAddr.Valid {
+			publicKey = a.WalletAddr.String
+			break
+		}
 		pub, err := starkNetClient.GetPublicKey(string(a.MmskPk), SubType)
 		if err != nil {
 			return nil, err
@@ -75,16 +85,17 @@ func (a *Profile) ToPB(starkNetClient *starknet.Client) (*v1.Profile, error) {
 	}
 
 	p := &v1.Profile{
-		Id:        a.Id,
-		Label:     a.Label,
-		Proxy:     nil,
-		MmskId:    publicKey,
-		Meta:      nil,
-		CreatedAt: timestamppb.New(a.CreatedAt),
-		UserAgent: a.UserAgent,
-		Num:       a.Num,
-		Type:      pType,
-		SubType:   SubType,
+		Id:             a.Id,
+		Label:          a.Label,
+		Proxy:          nil,
+		MmskId:         publicKey,
+		Meta:           nil,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		UserAgent:      a.UserAgent,
+		Num:            a.Num,
+		Type:           pType,
+		SubType:        SubType,
+		ExternalSigner: a.ExternalSigner,
 	}
 
 	if a.Proxy.Valid {
@@ -174,6 +185,28 @@ func (r *pgRepository) UpdateProfile(ctx context.Context, req *Profile) error {
 	return nil
 }
 
+// SetProfileWalletAddr stores the verified account address of the profile
+func (r *pgRepository) SetProfileWalletAddr(ctx context.Context, profileId, addr string) error {
+	q := `update profiles set wallet_addr = $2 where id = $1`
+
+	if _, err := r.conn.ExecContext(ctx, q, profileId, addr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AppendProfileMeta appends the line to the profile meta keeping what the user wrote there
+func (r *pgRepository) AppendProfileMeta(ctx context.Context, profileId, line string) error {
+	q := `update profiles set
+			meta = case when meta is null or meta = '' then $2 else meta || E'\n' || $2 end
+		where id = $1`
+
+	if _, err := r.conn.ExecContext(ctx, q, profileId, line); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ValidateLabelReq struct {
 	ProfileId *string
 	Label     string
@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/sha256"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/lib"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 )
@@ -22,6 +24,14 @@ func NewProfileRepositoryCrypto(source ProfileRepository, userRepository UserRep
 	}
 }
 
+// walletKey the address of the profile flagged external_signer is passed to the clients as the external key, so they
+// sign through the external signer
+func walletKey(p *Profile) {
+	if p.ExternalSigner {
+		p.MmskPk = []byte(defi.ExternalKey(common.HexToAddress(string(p.MmskPk))))
+	}
+}
+
 func (c *ProfileRepositoryCrypto) CreateProfile(ctx context.Context, req *Profile) error {
 
 	pk, err := lib.Encrypt(req.UserId, c.lazanya, req.MmskPk)
@@ -56,6 +66,7 @@ func (c *ProfileRepositoryCrypto) SearchNotConnectedOkexDepositProfile(ctx conte
 		if err != nil {
 			return nil, err
 		}
+		walletKey(&list[i])
 
 		list[i].Seed, err = lib.Decrypt(userId, c.lazanya, list[i].Seed)
 		if err != nil {
@@ -78,6 +89,7 @@ func (c *ProfileRepositoryCrypto) ListProfiles(ctx context.Context, userId strin
 		if err != nil {
 			return nil, err
 		}
+		walletKey(&list[i])
 		list[i].Seed, err = lib.Decrypt(userId, c.lazanya, list[i].Seed)
 		if err != nil {
 			return nil, err
@@ -99,6 +111,7 @@ func (c *ProfileRepositoryCrypto) ExportProfiles(ctx context.Context, userId str
 		if err != nil {
 			return nil, err
 		}
+		walletKey(&list[i])
 
 		list[i].Seed, err = lib.Decrypt(userId, c.lazanya, list[i].Seed)
 		if err != nil {
@@ -109,6 +122,22 @@ func (c *ProfileRepositoryCrypto) ExportProfiles(ctx context.Context, userId str
 	return list, nil
 }
 
+func (c *ProfileRepositoryCrypto) LPPositionAdd(ctx context.Context, req *LPPosition) error {
+	return c.source.LPPositionAdd(ctx, req)
+}
+
+func (c *ProfileRepositoryCrypto) LPPositionGet(ctx context.Context, profileId, taskType, pair string) (*LPPosition, error) {
+	return c.source.LPPositionGet(ctx, profileId, taskType, pair)
+}
+
+func (c *ProfileRepositoryCrypto) SpenderAdd(ctx context.Context, req *Spender) error {
+	return c.source.SpenderAdd(ctx, req)
+}
+
+func (c *ProfileRepositoryCrypto) SpenderList(ctx context.Context, profileId, network string) ([]Spender, error) {
+	return c.source.SpenderList(ctx, profileId, network)
+}
+
 func (c *ProfileRepositoryCrypto) DeleteProfile(ctx context.Context, req *v1.DeleteProfileRequest) (*v1.DeleteProfileResponse, error) {
 	return c.source.DeleteProfile(ctx, req)
 }
@@ -123,6 +152,7 @@ func (c *ProfileRepositoryCrypto) SearchProfile(ctx context.Context, pattern, us
 		if err != nil {
 			return nil, err
 		}
+		walletKey(&list[i])
 
 		list[i].Seed, err = lib.Decrypt(userId, c.lazanya, list[i].Seed)
 		if err != nil {
@@ -143,6 +173,7 @@ func (c *ProfileRepositoryCrypto) GetProfile(ctx context.Context, id string) (*P
 	if err != nil {
 		return nil, err
 	}
+	walletKey(p)
 
 	p.Seed, err = lib.Decrypt(p.UserId, c.lazanya, p.Seed)
 	if err != nil {
@@ -162,6 +193,7 @@ func (c *ProfileRepositoryCrypto) GetProfileByNum(ctx context.Context, num int,
 	if err != nil {
 		return nil, err
 	}
+	walletKey(p)
 
 	p.Seed, err = lib.Decrypt(p.UserId, c.lazanya, p.Seed)
 	if err != nil {
@@ -178,3 +210,11 @@ func (c *ProfileRepositoryCrypto) ValidateLabel(ctx context.Context, request *Va
 func (c *ProfileRepositoryCrypto) UpdateProfile(ctx context.Context, req *Profile) error {
 	return c.source.UpdateProfile(ctx, req)
 }
+
+func (c *ProfileRepositoryCrypto) SetProfileWalletAddr(ctx context.Context, profileId, addr string) error {
+	return c.source.SetProfileWalletAddr(ctx, profileId, addr)
+}
+
+func (c *ProfileRepositoryCrypto) AppendProfileMeta(ctx context.Context, profileId, line string) error {
+	return c.source.AppendProfileMeta(ctx, profileId, line)
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// Spender contract the tasks of the profile approved the tokens of the network to, the ones quoted by the bridge
+// apis are not known ahead and are listed by the approvals from here
+type Spender struct {
+	ProfileId string    `db:"profile_id"`
+	Network   string    `db:"network"`
+	Address   string    `db:"address"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+var SpenderCols = []string{
+	"profile_id",
+	"network",
+	"address",
+	"name",
+	"created_at",
+}
+
+var (
+	spdh = NewHelper(SpenderCols)
+)
+
+// SpenderAdd keeps the spender of the profile, the one kept already is left as is
+func (r *pgRepository) SpenderAdd(ctx context.Context, req *Spender) error {
+	q := `insert into profile_spenders (profile_id, network, address, name, created_at)
+		values (:profile_id, :network, :address, :name, now())
+		on conflict (profile_id, network, address) do nothing`
+
+	if _, err := r.conn.NamedExecContext(ctx, q, req); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SpenderList spenders of the profile in the network in the order they were kept
+func (r *pgRepository) SpenderList(ctx context.Context, profileId, network string) ([]Spender, error) {
+	q := Join(`select `, spdh.Cols(), ` from profile_spenders
+		where profile_id = $1 and network = $2
+		order by created_at`)
+
+	res := make([]Spender, 0)
+	if err := r.conn.SelectContext(ctx, &res, q, profileId, network); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -120,6 +120,7 @@ export const humanDuration = (s?: string | Number): string => {
 
 export interface TaskSettings {
   slippage?: string
+  feeToken?: Token
 }
 
 export const castTaskSettingsMap = (a: Object): Map<TaskType, TaskSettings> => {
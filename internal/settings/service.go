@@ -8,10 +8,14 @@ import (
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/arbitrum"
 	"github.com/hardstylez72/cry/internal/defi/avalanche"
+	"github.com/hardstylez72/cry/internal/defi/base"
 	"github.com/hardstylez72/cry/internal/defi/bnb"
 	"github.com/hardstylez72/cry/internal/defi/etherium"
+	"github.com/hardstylez72/cry/internal/defi/linea"
+	"github.com/hardstylez72/cry/internal/defi/metis"
 	"github.com/hardstylez72/cry/internal/defi/optimism"
 	"github.com/hardstylez72/cry/internal/defi/poligon"
+	"github.com/hardstylez72/cry/internal/defi/rpcpool"
 	"github.com/hardstylez72/cry/internal/defi/starknet"
 	"github.com/hardstylez72/cry/internal/defi/zksyncera"
 	"github.com/hardstylez72/cry/internal/defi/zksynclite"
@@ -34,6 +38,8 @@ type ResolveNet struct {
 	Network v1.Network
 	GasMax  string
 	RPC     string
+	// Fallbacks endpoint list of the rpc fallbacks
+	Fallbacks string
 }
 
 var Networks = []v1.Network{
@@ -46,6 +52,9 @@ var Networks = []v1.Network{
 	v1.Network_AVALANCHE,
 	v1.Network_POLIGON,
 	v1.Network_StarkNet,
+	v1.Network_Base,
+	v1.Network_Linea,
+	v1.Network_Metis,
 }
 
 func NewService(rep repository.SettingsRepository) *Service {
@@ -56,8 +65,24 @@ func (s *Service) GetSettings(ctx context.Context, userId string, network v1.Net
 	return s.rep.GetSettings(ctx, userId, network)
 }
 func (s *Service) UpdateSettings(ctx context.Context, userId string, in *v1.NetworkSettings) error {
+	if err := validateFeeTokens(in); err != nil {
+		return err
+	}
 	return s.rep.UpdateSettings(ctx, userId, in)
 }
+
+// validateFeeTokens the fee is paid in a token only by the tasks the paymaster is used in
+func validateFeeTokens(in *v1.NetworkSettings) error {
+	for taskType, ts := range in.GetTaskSettings() {
+		if ts.FeeToken == nil || ts.GetFeeToken() == v1.Token_ETH {
+			continue
+		}
+		if in.GetNetwork() != v1.Network_ZKSYNCERA || !zksyncera.PaymasterTasks[v1.TaskType(v1.TaskType_value[taskType])] {
+			return errors.New("fee can not be paid in " + ts.GetFeeToken().String() + " by task " + taskType)
+		}
+	}
+	return nil
+}
 func (s *Service) ResolveAllSettings(ctx context.Context, userId string, lastUpdate time.Time) error {
 	for _, n := range Networks {
 
@@ -124,6 +149,7 @@ func resolveSettings(in *v1.NetworkSettings, network v1.Network, force bool) (*v
 	poligonMax := "40000000000000000000"
 	avalancheMax := "1000000000000000000"
 	bnbGas := "100000000000000000"
+	metisMax := "100000000000000000"
 
 	switch network {
 	case v1.Network_POLIGON:
@@ -152,7 +178,17 @@ func resolveSettings(in *v1.NetworkSettings, network v1.Network, force bool) (*v
 		s.GasMax = avalancheMax
 	case v1.Network_StarkNet:
 		s.RPC = starknet.MainnetRPC
+		s.Fallbacks = starknet.JsonRPCEndpoints
+		s.GasMax = eth
+	case v1.Network_Base:
+		s.RPC = base.MainNetURL
 		s.GasMax = eth
+	case v1.Network_Linea:
+		s.RPC = linea.MainNetURL
+		s.GasMax = eth
+	case v1.Network_Metis:
+		s.RPC = metis.MainNetURL
+		s.GasMax = metisMax
 	}
 
 	return resolveNetworkSettings(in, s, force)
@@ -186,6 +222,9 @@ func resolveNetworkSettings(in *v1.NetworkSettings, s ResolveNet, force bool) (*
 		out.RpcEndpoint = s.RPC
 		out.MaxGas = s.GasMax
 		out.GasMultiplier = 1
+		for _, e := range rpcpool.Parse(s.Fallbacks) {
+			out.RpcFallbacks = append(out.RpcFallbacks, &v1.RpcEndpoint{Url: e.URL, Weight: e.Weight})
+		}
 	}
 
 	for taskType, slippage := range defi.SlippageMap {
@@ -118,6 +118,7 @@ func NewSock5Proxy(c *Config) (*Proxy, error) {
 	p.Cli.Transport = &Client{
 		source:          NewJaegerRoundTripper(p.Cli.Transport),
 		UserAgentHeader: c.UserAgentHeader,
+		host:            c.Host,
 	}
 	return p, nil
 }
@@ -131,6 +132,13 @@ func NewJaegerRoundTripper(source http.RoundTripper) http.RoundTripper {
 type Client struct {
 	source          http.RoundTripper
 	UserAgentHeader string
+	// host of the proxy, empty if the proxy is disabled
+	host string
+}
+
+// ProxyHost host of the proxy the requests go through
+func (c *Client) ProxyHost() string {
+	return c.host
 }
 
 func (c *Client) RoundTrip(req *http.Request) (*http.Response, error) {
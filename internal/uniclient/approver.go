@@ -0,0 +1,19 @@
+package uniclient
+
+import (
+	"github.com/hardstylez72/cry/internal/defi"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/pkg/errors"
+)
+
+func NewApprover(network v1.Network, c *BaseClientConfig) (defi.Approver, error) {
+	cli, err := NewBaseClient(network, c)
+	if err != nil {
+		return nil, err
+	}
+	approver, ok := cli.(defi.Approver)
+	if !ok {
+		return nil, errors.New("network is not supported for Approver")
+	}
+	return approver, nil
+}
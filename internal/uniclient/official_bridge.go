@@ -0,0 +1,55 @@
+package uniclient
+
+import (
+	"github.com/hardstylez72/cry/internal/defi"
+	"github.com/hardstylez72/cry/internal/defi/arbitrum"
+	"github.com/hardstylez72/cry/internal/defi/base"
+	"github.com/hardstylez72/cry/internal/defi/etherium"
+	"github.com/hardstylez72/cry/internal/defi/linea"
+	"github.com/hardstylez72/cry/internal/defi/optimism"
+	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
+	"github.com/hardstylez72/cry/internal/socks5"
+	"github.com/pkg/errors"
+)
+
+func NewEVM(network v1.Network, c *BaseClientConfig) (defi.EVM, error) {
+
+	proxy, err := socks5.NewSock5ProxyString(c.ProxyString, c.UserAgentHeader)
+	if err != nil {
+		return nil, errors.Wrap(err, "socks5.NewSock5ProxyString")
+	}
+
+	var cli defi.EVM
+	switch network {
+	case v1.Network_Etherium:
+		cli, err = etherium.NewClient(&etherium.ClientConfig{HttpCli: proxy.Cli, RPCEndpoint: c.RPCEndpoint})
+	case v1.Network_ARBITRUM:
+		cli, err = arbitrum.NewClient(&arbitrum.ClientConfig{HttpCli: proxy.Cli, RPCEndpoint: c.RPCEndpoint})
+	case v1.Network_OPTIMISM:
+		cli, err = optimism.NewClient(&optimism.ClientConfig{HttpCli: proxy.Cli, RPCEndpoint: c.RPCEndpoint})
+	case v1.Network_Base:
+		cli, err = base.NewClient(&base.ClientConfig{HttpCli: proxy.Cli, RPCEndpoint: c.RPCEndpoint})
+	case v1.Network_Linea:
+		cli, err = linea.NewClient(&linea.ClientConfig{HttpCli: proxy.Cli, RPCEndpoint: c.RPCEndpoint})
+	default:
+		return nil, errors.New("network is not supported for EVM")
+	}
+	return cli, err
+}
+
+// NewOfficialBridge clients of ethereum and the rollup the official bridge works with
+func NewOfficialBridge(network v1.Network, l1, l2 *BaseClientConfig) (defi.EVM, defi.EVM, *defi.OfficialBridge, error) {
+	l1Cli, err := NewEVM(v1.Network_Etherium, l1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	l2Cli, err := NewEVM(network, l2)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	b, err := defi.NewOfficialBridge(l1Cli.EVM(), l2Cli.EVM())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return l1Cli, l2Cli, b, nil
+}
@@ -3,6 +3,7 @@ package uniclient
 import (
 	"github.com/hardstylez72/cry/internal/defi"
 	"github.com/hardstylez72/cry/internal/defi/arbitrum"
+	"github.com/hardstylez72/cry/internal/defi/avalanche"
 	v1 "github.com/hardstylez72/cry/internal/pb/gen/proto/go/v1"
 	"github.com/hardstylez72/cry/internal/socks5"
 	"github.com/pkg/errors"
@@ -21,6 +22,8 @@ func NewSwapper(network v1.Network, c *BaseClientConfig, taskType v1.TaskType) (
 		switch network {
 		case v1.Network_ARBITRUM:
 			return arbitrum.NewClient(&arbitrum.ClientConfig{HttpCli: proxy.Cli, RPCEndpoint: c.RPCEndpoint})
+		case v1.Network_AVALANCHE:
+			return avalanche.NewClient(&avalanche.ClientConfig{HttpCli: proxy.Cli, RPCEndpoint: c.RPCEndpoint})
 		default:
 			return nil, errors.New("network is not supported for Transfer")
 		}
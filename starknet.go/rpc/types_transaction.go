@@ -282,10 +282,10 @@ func (b BroadcastedInvokeV1Transaction) MarshalJSON() ([]byte, error) {
 	output := map[string]interface{}{}
 	output["type"] = b.Type
 	if b.MaxFee != nil {
-		output["max_fee"] = fmt.Sprintf("0x%x", b.MaxFee)
+		output["max_fee"] = b.MaxFee.String()
 	}
 	if b.Nonce != nil {
-		output["nonce"] = fmt.Sprintf("0x%x", b.Nonce)
+		output["nonce"] = b.Nonce.String()
 	}
 	output["version"] = b.Version
 	signature := b.Signature
@@ -305,10 +305,10 @@ func (b BroadcastedDeclareTransaction) MarshalJSON() ([]byte, error) {
 	output := map[string]interface{}{}
 	output["type"] = "DECLARE"
 	if b.MaxFee != nil {
-		output["max_fee"] = fmt.Sprintf("0x%x", b.MaxFee)
+		output["max_fee"] = b.MaxFee.String()
 	}
 	if b.Nonce != nil {
-		output["nonce"] = fmt.Sprintf("0x%x", b.Nonce)
+		output["nonce"] = b.Nonce.String()
 	}
 	output["version"] = b.Version
 	signature := b.Signature
@@ -342,5 +342,18 @@ type BroadcastedDeployAccountTransaction struct {
 }
 
 func (b BroadcastedDeployAccountTransaction) MarshalJSON() ([]byte, error) {
-	return json.Marshal(b)
+	output := map[string]interface{}{}
+	output["type"] = TransactionType_DeployAccount
+	if b.MaxFee != nil {
+		output["max_fee"] = b.MaxFee.String()
+	}
+	if b.Nonce != nil {
+		output["nonce"] = b.Nonce.String()
+	}
+	output["version"] = b.Version
+	output["signature"] = b.Signature
+	output["contract_address_salt"] = b.ContractAddressSalt
+	output["constructor_calldata"] = b.ConstructorCalldata
+	output["class_hash"] = b.ClassHash
+	return json.Marshal(output)
 }